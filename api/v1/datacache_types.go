@@ -0,0 +1,101 @@
+// Package v1 contains API Schema definitions for the apollo v1 API group
+// DataCache: 선언적 글로벌 캐싱 CRD
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=dcache
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourcePVC`
+// +kubebuilder:printcolumn:name="Tier",type=string,JSONPath=`.status.currentTier`
+// +kubebuilder:printcolumn:name="HitRatio",type=integer,JSONPath=`.status.hitRatioPercent`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// DataCache는 PVC 데이터를 빠른 스토리지 티어에 캐싱
+// 소스 PVC는 DataCache와 같은 네임스페이스에 있어야 하며, 리소스 삭제 시 캐시도 삭제됨
+// targetTier 변경 시 캐시 티어 마이그레이션이 수행됨
+type DataCache struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataCacheSpec   `json:"spec,omitempty"`
+	Status DataCacheStatus `json:"status,omitempty"`
+}
+
+// DataCacheSpec defines the desired state of DataCache
+type DataCacheSpec struct {
+	// SourcePVC는 캐싱할 데이터가 있는 PVC
+	// +kubebuilder:validation:Required
+	SourcePVC string `json:"sourcePVC"`
+
+	// SourcePath는 PVC 내 캐싱할 경로 (기본 "/")
+	// +optional
+	SourcePath string `json:"sourcePath,omitempty"`
+
+	// TargetTier는 캐시 스토리지 티어
+	// +kubebuilder:validation:Enum=nvme;ssd;hdd;s3;auto
+	// +kubebuilder:validation:Required
+	TargetTier string `json:"targetTier"`
+
+	// CacheSize는 최대 캐시 크기 (예: "100Gi")
+	// +optional
+	CacheSize string `json:"cacheSize,omitempty"`
+
+	// CachePolicy는 캐시 축출 정책
+	// +kubebuilder:validation:Enum=lru;lfu;fifo;ttl
+	// +optional
+	CachePolicy string `json:"cachePolicy,omitempty"`
+
+	// TTLSeconds는 ttl 정책에서 사용하는 캐시 유효 시간
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSeconds int64 `json:"ttlSeconds,omitempty"`
+
+	// Priority는 캐시 우선순위 (높을수록 중요)
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// Prefetch는 데이터 선제 로딩 여부
+	// +optional
+	Prefetch bool `json:"prefetch,omitempty"`
+
+	// WorkloadLabels는 이 캐시를 사용할 워크로드의 레이블
+	// +optional
+	WorkloadLabels map[string]string `json:"workloadLabels,omitempty"`
+
+	// WorkloadNames는 이 캐시를 사용할 워크로드 이름 목록
+	// +optional
+	WorkloadNames []string `json:"workloadNames,omitempty"`
+
+	// Reason은 캐싱 사유 (감사용)
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// DataCacheStatus defines the observed state of DataCache
+type DataCacheStatus struct {
+	JobStatus `json:",inline"`
+
+	// CurrentTier는 현재 캐시 티어
+	// +optional
+	CurrentTier string `json:"currentTier,omitempty"`
+
+	// CachedDataBytes는 현재 캐시된 데이터 크기
+	CachedDataBytes int64 `json:"cachedDataBytes,omitempty"`
+
+	// HitRatioPercent는 캐시 적중률 (%)
+	HitRatioPercent int32 `json:"hitRatioPercent,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DataCacheList contains a list of DataCache
+type DataCacheList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataCache `json:"items"`
+}
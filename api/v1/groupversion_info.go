@@ -21,4 +21,9 @@ var (
 
 func init() {
 	SchemeBuilder.Register(&StorageHPA{}, &StorageHPAList{})
+	SchemeBuilder.Register(&PodMigration{}, &PodMigrationList{})
+	SchemeBuilder.Register(&LoadbalancingPolicy{}, &LoadbalancingPolicyList{})
+	SchemeBuilder.Register(&PreemptionRequest{}, &PreemptionRequestList{})
+	SchemeBuilder.Register(&StorageProvisioning{}, &StorageProvisioningList{})
+	SchemeBuilder.Register(&DataCache{}, &DataCacheList{})
 }
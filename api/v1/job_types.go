@@ -0,0 +1,58 @@
+// Package v1 contains API Schema definitions for the apollo v1 API group
+// 오케스트레이터 작업(Job)을 선언적으로 생성하는 CRD들의 공통 타입
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AllNamespaces는 LoadbalancingPolicy/PreemptionRequest의 spec.namespace에서 전체 네임스페이스를 뜻함
+// 오케스트레이터에서 허용한 네임스페이스(CROSS_NAMESPACE_JOB_NAMESPACES)의 리소스만 사용 가능
+const AllNamespaces = "*"
+
+// JobStatus는 오케스트레이터 작업을 구동하는 CRD들의 공통 상태
+// PodMigration, LoadbalancingPolicy, PreemptionRequest, StorageProvisioning, DataCache의
+// Status에 inline으로 포함되며, Reconciler가 내부 컨트롤러의 작업 상태를 반영함
+type JobStatus struct {
+	// JobID는 오케스트레이터 내부 작업 ID (REST API에서 사용하는 ID와 동일)
+	// +optional
+	JobID string `json:"jobID,omitempty"`
+
+	// Phase는 작업 단계 (Pending, Running, Completed, Failed, Ready, Active ...)
+	// 내부 컨트롤러 상태를 그대로 반영
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Message는 상태 메시지
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration은 마지막으로 처리한 spec의 generation
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// StartTime은 작업 시작 시간
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime은 작업이 종료 상태에 도달한 시간
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// LastUpdated는 마지막 업데이트 시간
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	// Conditions는 상세 조건들 (Ready, Progressing)
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Condition Types (작업 기반 CRD)
+const (
+	// ConditionTypeProgressing은 작업이 아직 진행 중
+	ConditionTypeProgressing = "Progressing"
+)
+
+// JobFinalizer는 삭제 시 내부 작업/리소스 정리를 위한 finalizer
+const JobFinalizer = "apollo.keti.re.kr/job-cleanup"
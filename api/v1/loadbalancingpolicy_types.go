@@ -0,0 +1,114 @@
+// Package v1 contains API Schema definitions for the apollo v1 API group
+// LoadbalancingPolicy: 선언적 노드 부하 분산 CRD
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=lbp
+// +kubebuilder:printcolumn:name="Strategy",type=string,JSONPath=`.spec.strategy`
+// +kubebuilder:printcolumn:name="Interval",type=integer,JSONPath=`.spec.intervalSeconds`
+// +kubebuilder:printcolumn:name="Migrated",type=integer,JSONPath=`.status.successfulMigrations`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// LoadbalancingPolicy는 노드 간 부하 분산 정책
+// intervalSeconds가 0이면 1회 실행, 0보다 크면 주기적으로 실행
+// spec이 변경되면 기존 작업을 취소하고 새 작업을 시작함
+type LoadbalancingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadbalancingPolicySpec   `json:"spec,omitempty"`
+	Status LoadbalancingPolicyStatus `json:"status,omitempty"`
+}
+
+// LoadbalancingPolicySpec defines the desired state of LoadbalancingPolicy
+type LoadbalancingPolicySpec struct {
+	// Namespace는 부하 분산 대상 네임스페이스 (비어있으면 리소스와 같은 네임스페이스, "*"는 전체)
+	// 다른 네임스페이스와 "*"는 오케스트레이터에서 허용한 네임스페이스의 리소스만 지정 가능
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// TargetNodes는 고려할 노드 목록 (비어있으면 전체 노드)
+	// +optional
+	TargetNodes []string `json:"targetNodes,omitempty"`
+
+	// Strategy는 부하 분산 전략
+	// +kubebuilder:validation:Enum=least_loaded;load_spreading;storage_aware
+	// +kubebuilder:validation:Required
+	Strategy string `json:"strategy"`
+
+	// CPUThreshold는 CPU 사용률 임계값 (%)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	CPUThreshold int32 `json:"cpuThreshold,omitempty"`
+
+	// MemoryThreshold는 메모리 사용률 임계값 (%)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MemoryThreshold int32 `json:"memoryThreshold,omitempty"`
+
+	// GPUThreshold는 GPU 사용률 임계값 (%)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	GPUThreshold int32 `json:"gpuThreshold,omitempty"`
+
+	// StorageReadThreshold는 스토리지 읽기 임계값 (MB/s)
+	// +optional
+	StorageReadThreshold int64 `json:"storageReadThreshold,omitempty"`
+
+	// StorageWriteThreshold는 스토리지 쓰기 임계값 (MB/s)
+	// +optional
+	StorageWriteThreshold int64 `json:"storageWriteThreshold,omitempty"`
+
+	// StorageIOPSThreshold는 스토리지 IOPS 임계값
+	// +optional
+	StorageIOPSThreshold int64 `json:"storageIOPSThreshold,omitempty"`
+
+	// MaxMigrationsPerCycle은 한 사이클당 최대 마이그레이션 수
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxMigrationsPerCycle int32 `json:"maxMigrationsPerCycle,omitempty"`
+
+	// IntervalSeconds는 주기 실행 간격 (0이면 1회 실행)
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+
+	// PreservePV는 마이그레이션 시 PV 보존 여부
+	// +optional
+	PreservePV bool `json:"preservePV,omitempty"`
+}
+
+// LoadbalancingPolicyStatus defines the observed state of LoadbalancingPolicy
+type LoadbalancingPolicyStatus struct {
+	JobStatus `json:",inline"`
+
+	// TotalPodsAnalyzed는 분석된 Pod 수
+	TotalPodsAnalyzed int32 `json:"totalPodsAnalyzed,omitempty"`
+
+	// PodsToMigrate는 마이그레이션 계획된 Pod 수
+	PodsToMigrate int32 `json:"podsToMigrate,omitempty"`
+
+	// SuccessfulMigrations는 성공한 마이그레이션 수
+	SuccessfulMigrations int32 `json:"successfulMigrations,omitempty"`
+
+	// FailedMigrations는 실패한 마이그레이션 수
+	FailedMigrations int32 `json:"failedMigrations,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadbalancingPolicyList contains a list of LoadbalancingPolicy
+type LoadbalancingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadbalancingPolicy `json:"items"`
+}
@@ -0,0 +1,77 @@
+// Package v1 contains API Schema definitions for the apollo v1 API group
+// PodMigration: 선언적 Pod 마이그레이션 CRD
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=pmig
+// +kubebuilder:printcolumn:name="Pod",type=string,JSONPath=`.spec.podName`
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceNode`
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.targetNode`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// PodMigration은 Pod를 다른 노드로 마이그레이션하는 1회성 작업
+// 대상 Pod는 PodMigration과 같은 네임스페이스에 있어야 함
+type PodMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PodMigrationSpec   `json:"spec,omitempty"`
+	Status PodMigrationStatus `json:"status,omitempty"`
+}
+
+// PodMigrationSpec defines the desired state of PodMigration
+// 작업 시작 후 spec 변경은 반영되지 않음
+type PodMigrationSpec struct {
+	// PodName은 마이그레이션할 Pod 이름
+	// +kubebuilder:validation:Required
+	PodName string `json:"podName"`
+
+	// SourceNode는 현재 Pod가 실행 중인 노드
+	// +kubebuilder:validation:Required
+	SourceNode string `json:"sourceNode"`
+
+	// TargetNode는 마이그레이션 대상 노드
+	// +kubebuilder:validation:Required
+	TargetNode string `json:"targetNode"`
+
+	// PreservePV는 PV 기반 체크포인트 사용 여부
+	// +optional
+	PreservePV bool `json:"preservePV,omitempty"`
+
+	// ForceRestart는 모든 컨테이너 강제 재시작 여부
+	// +optional
+	ForceRestart bool `json:"forceRestart,omitempty"`
+
+	// TimeoutSeconds는 마이그레이션 제한 시간 (기본 600초)
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// PodMigrationStatus defines the observed state of PodMigration
+type PodMigrationStatus struct {
+	JobStatus `json:",inline"`
+
+	// NewPodName은 마이그레이션 후 생성된 Pod 이름
+	// +optional
+	NewPodName string `json:"newPodName,omitempty"`
+
+	// CheckpointPVC는 체크포인트용 PVC 이름
+	// +optional
+	CheckpointPVC string `json:"checkpointPVC,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PodMigrationList contains a list of PodMigration
+type PodMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PodMigration `json:"items"`
+}
@@ -0,0 +1,102 @@
+// Package v1 contains API Schema definitions for the apollo v1 API group
+// PreemptionRequest: 선언적 Pod 선점(preemption) CRD
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=preq
+// +kubebuilder:printcolumn:name="Node",type=string,JSONPath=`.spec.nodeName`
+// +kubebuilder:printcolumn:name="Resource",type=string,JSONPath=`.spec.resourceType`
+// +kubebuilder:printcolumn:name="Amount",type=string,JSONPath=`.spec.targetAmount`
+// +kubebuilder:printcolumn:name="Preempted",type=integer,JSONPath=`.status.successfulPreemptions`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// PreemptionRequest는 노드의 리소스를 확보하기 위해 Pod를 선점하는 1회성 작업
+type PreemptionRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PreemptionRequestSpec   `json:"spec,omitempty"`
+	Status PreemptionRequestStatus `json:"status,omitempty"`
+}
+
+// PreemptionRequestSpec defines the desired state of PreemptionRequest
+// 작업 시작 후 spec 변경은 반영되지 않음
+type PreemptionRequestSpec struct {
+	// NodeName은 리소스를 확보할 노드
+	// +kubebuilder:validation:Required
+	NodeName string `json:"nodeName"`
+
+	// Namespace는 선점 대상 네임스페이스 (비어있으면 리소스와 같은 네임스페이스, "*"는 전체)
+	// 다른 네임스페이스와 "*"는 오케스트레이터에서 허용한 네임스페이스의 리소스만 지정 가능
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// ResourceType은 확보할 리소스 종류
+	// +kubebuilder:validation:Enum=cpu;memory;gpu;storage;all
+	// +kubebuilder:validation:Required
+	ResourceType string `json:"resourceType"`
+
+	// TargetAmount는 확보할 리소스 양 (예: "4000m", "8Gi")
+	// +kubebuilder:validation:Required
+	TargetAmount string `json:"targetAmount"`
+
+	// Strategy는 선점 대상 Pod 선택 전략
+	// +kubebuilder:validation:Enum=lowest_priority;youngest;largest_resource;weighted_score
+	// +optional
+	Strategy string `json:"strategy,omitempty"`
+
+	// MinPriority보다 낮은 우선순위의 Pod만 선점 대상
+	// +optional
+	MinPriority int32 `json:"minPriority,omitempty"`
+
+	// MaxPodsToPreempt는 최대 선점 Pod 수
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxPodsToPreempt int32 `json:"maxPodsToPreempt,omitempty"`
+
+	// GracePeriodSeconds는 Pod 종료 유예 시간
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	GracePeriodSeconds int64 `json:"gracePeriodSeconds,omitempty"`
+
+	// ProtectedNamespaces는 선점하지 않을 네임스페이스 목록
+	// +optional
+	ProtectedNamespaces []string `json:"protectedNamespaces,omitempty"`
+
+	// Reason은 선점 사유 (감사용)
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// PreemptionRequestStatus defines the observed state of PreemptionRequest
+type PreemptionRequestStatus struct {
+	JobStatus `json:",inline"`
+
+	// PreemptedPods는 선점된 Pod 목록 (namespace/name)
+	// +optional
+	PreemptedPods []string `json:"preemptedPods,omitempty"`
+
+	// SuccessfulPreemptions는 성공한 선점 수
+	SuccessfulPreemptions int32 `json:"successfulPreemptions,omitempty"`
+
+	// FailedPreemptions는 실패한 선점 수
+	FailedPreemptions int32 `json:"failedPreemptions,omitempty"`
+
+	// TargetAchieved는 목표 리소스 확보 여부
+	TargetAchieved bool `json:"targetAchieved,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PreemptionRequestList contains a list of PreemptionRequest
+type PreemptionRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PreemptionRequest `json:"items"`
+}
@@ -3,6 +3,7 @@
 package v1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -85,6 +86,17 @@ type StorageHPASpec struct {
 	// +optional
 	TargetStorageIOPS *int64 `json:"targetStorageIOPS,omitempty"`
 
+	// ============================================
+	// 커스텀 메트릭 타겟
+	// ============================================
+
+	// CustomMetrics는 Prometheus 쿼리 기반 타겟 (예: 학습 큐 길이, 초당 토큰 수)
+	// 기본 메트릭과 함께 평가되어 가장 큰 추천 레플리카 수가 사용됨
+	// 쿼리가 다른 네임스페이스를 읽을 수 있으므로 CROSS_NAMESPACE_JOB_NAMESPACES에 포함된 네임스페이스에서만 평가됨
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	CustomMetrics []CustomMetricSpec `json:"customMetrics,omitempty"`
+
 	// ============================================
 	// 스케일링 정책
 	// ============================================
@@ -96,6 +108,23 @@ type StorageHPASpec struct {
 	// ScaleDownPolicy는 스케일 다운 정책
 	// +optional
 	ScaleDownPolicy *ScalingPolicySpec `json:"scaleDownPolicy,omitempty"`
+
+	// ============================================
+	// 예측 스케일링
+	// ============================================
+
+	// Predictive는 메트릭 이력으로 GPU/스토리지 읽기 수요를 예측해 버스트 전에 미리 스케일하는 설정
+	// +optional
+	Predictive *PredictiveScalingSpec `json:"predictive,omitempty"`
+
+	// ============================================
+	// Scale to zero
+	// ============================================
+
+	// ScaleToZero는 트래픽/IO가 없는 워크로드를 0 레플리카로 줄여 GPU를 반환하는 설정
+	// 깨우기: apollo.keti.re.kr/wake 또는 apollo.keti.re.kr/queue-depth 어노테이션
+	// +optional
+	ScaleToZero *ScaleToZeroSpec `json:"scaleToZero,omitempty"`
 }
 
 // WorkloadReference는 대상 워크로드 참조
@@ -123,6 +152,97 @@ type ScalingPolicySpec struct {
 	// +kubebuilder:validation:Minimum=1
 	// +optional
 	MaxScaleChange *int32 `json:"maxScaleChange,omitempty"`
+
+	// Policies는 기간당 변경 가능한 레플리카 수/비율 제한 (HPA v2 behavior와 동일)
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	Policies []ScalingPolicyRuleSpec `json:"policies,omitempty"`
+
+	// SelectPolicy는 여러 Policies 중 적용할 정책
+	// Max: 가장 큰 변경 허용 (기본값), Min: 가장 작은 변경 허용, Disabled: 이 방향으로 스케일하지 않음
+	// +kubebuilder:validation:Enum=Max;Min;Disabled
+	// +optional
+	SelectPolicy string `json:"selectPolicy,omitempty"`
+
+	// Tolerance는 무시할 레플리카 변경 비율, 예: "0.1" (10% 이내 변경은 스케일하지 않음)
+	// +optional
+	Tolerance *resource.Quantity `json:"tolerance,omitempty"`
+}
+
+// ScalingPolicyRuleSpec는 PeriodSeconds 동안 추가/제거할 수 있는 레플리카 제한
+type ScalingPolicyRuleSpec struct {
+	// Type은 Value의 단위: Pods(레플리카 수) 또는 Percent(기간 시작 시점 레플리카 대비 비율)
+	// +kubebuilder:validation:Enum=Pods;Percent
+	// +kubebuilder:validation:Required
+	Type string `json:"type"`
+
+	// Value는 기간당 변경 가능한 레플리카 수 또는 비율
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Required
+	Value int32 `json:"value"`
+
+	// PeriodSeconds는 제한을 적용할 기간 (초)
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1800
+	// +kubebuilder:validation:Required
+	PeriodSeconds int32 `json:"periodSeconds"`
+}
+
+// PredictiveScalingSpec는 예측 스케일링 설정
+type PredictiveScalingSpec struct {
+	// Mode는 예측 방식
+	// +kubebuilder:validation:Enum=holt_winters;seasonal_moving_average
+	// +kubebuilder:validation:Required
+	Mode string `json:"mode"`
+
+	// SeasonLengthSeconds는 수요 패턴의 주기 (초), 예: 학습 에폭 길이
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=28800
+	// +kubebuilder:validation:Required
+	SeasonLengthSeconds int32 `json:"seasonLengthSeconds"`
+
+	// HorizonSeconds는 얼마나 앞서 스케일할지 (초), 기본값: 60초
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HorizonSeconds *int32 `json:"horizonSeconds,omitempty"`
+}
+
+// CustomMetricSpec는 PromQL 쿼리 결과를 타겟으로 하는 커스텀 메트릭
+type CustomMetricSpec struct {
+	// Name은 메트릭 이름 (status에서 구분용)
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Query는 단일 샘플을 반환하는 PromQL 쿼리
+	// ${namespace}, ${workload}는 StorageHPA의 네임스페이스와 워크로드 이름으로 치환됨
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Query string `json:"query"`
+
+	// TargetType은 타겟 종류
+	// AverageValue: 쿼리 값(워크로드 전체)을 레플리카당 목표값으로 나눔
+	// Value: 쿼리 값과 목표값의 비율로 현재 레플리카 수를 조정
+	// +kubebuilder:validation:Enum=AverageValue;Value
+	// +kubebuilder:validation:Required
+	TargetType string `json:"targetType"`
+
+	// TargetValue는 목표값 (0보다 커야 함)
+	// +kubebuilder:validation:Required
+	TargetValue resource.Quantity `json:"targetValue"`
+}
+
+// ScaleToZeroSpec는 유휴 워크로드의 scale to zero 설정
+type ScaleToZeroSpec struct {
+	// IdleTimeoutSeconds는 트래픽/IO가 없는 상태가 이 시간 지속되면 0으로 스케일 (초)
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Required
+	IdleTimeoutSeconds int32 `json:"idleTimeoutSeconds"`
+
+	// ActivationQueueDepth는 워크로드를 깨우는 큐 깊이, 기본값: 1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ActivationQueueDepth *int64 `json:"activationQueueDepth,omitempty"`
 }
 
 // StorageHPAStatus defines the observed state of StorageHPA
@@ -174,6 +294,18 @@ type StorageHPAStatus struct {
 	// ScaleDownCount는 스케일 다운 횟수
 	ScaleDownCount int64 `json:"scaleDownCount,omitempty"`
 
+	// Forecast는 예측 스케일링의 예측값과 실제값 비교 (predictive 설정 시)
+	// +optional
+	Forecast *ForecastStatus `json:"forecast,omitempty"`
+
+	// ScaleToZero는 유휴/깨우기 상태 (scaleToZero 설정 시)
+	// +optional
+	ScaleToZero *ScaleToZeroStatus `json:"scaleToZero,omitempty"`
+
+	// CustomMetrics는 커스텀 메트릭의 현재 값과 추천 레플리카 수
+	// +optional
+	CustomMetrics []CustomMetricStatus `json:"customMetrics,omitempty"`
+
 	// ============================================
 	// 상태 정보
 	// ============================================
@@ -195,6 +327,98 @@ type StorageHPAStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// ForecastStatus는 예측값과 실제 메트릭의 비교
+// 값은 현재 레플리카 수 기준 Pod당 값으로, current* 메트릭과 같은 단위
+type ForecastStatus struct {
+	// Mode는 예측 방식
+	Mode string `json:"mode"`
+
+	// HorizonSeconds는 예측 시점 (초)
+	HorizonSeconds int32 `json:"horizonSeconds"`
+
+	// Samples는 메트릭 이력의 샘플 수
+	Samples int32 `json:"samples"`
+
+	// Ready는 예측에 충분한 이력이 쌓였는지 여부 (그 전까지는 반응형 스케일링)
+	Ready bool `json:"ready"`
+
+	// PredictedGPUPercent는 horizon 후의 예측 GPU 사용률 (%)
+	PredictedGPUPercent int32 `json:"predictedGPUPercent,omitempty"`
+
+	// PredictedStorageReadThroughput는 horizon 후의 예측 스토리지 읽기 처리량 (MB/s)
+	PredictedStorageReadThroughput int64 `json:"predictedStorageReadThroughput,omitempty"`
+
+	// ExpectedGPUPercent는 horizon 전에 현재 시점을 예측한 GPU 사용률 (%)
+	// +optional
+	ExpectedGPUPercent *int32 `json:"expectedGPUPercent,omitempty"`
+
+	// ExpectedStorageReadThroughput는 horizon 전에 현재 시점을 예측한 스토리지 읽기 처리량 (MB/s)
+	// +optional
+	ExpectedStorageReadThroughput *int64 `json:"expectedStorageReadThroughput,omitempty"`
+
+	// GPUErrorPercent는 과거 GPU 예측의 지수 가중 평균 절대 오차율 (%)
+	GPUErrorPercent int32 `json:"gpuErrorPercent"`
+
+	// StorageReadErrorPercent는 과거 스토리지 읽기 예측의 지수 가중 평균 절대 오차율 (%)
+	StorageReadErrorPercent int32 `json:"storageReadErrorPercent"`
+}
+
+// ScaleToZeroStatus는 scale to zero의 유휴/깨우기 상태
+type ScaleToZeroStatus struct {
+	// ScaledToZero는 워크로드가 유휴로 0 레플리카인지 여부
+	ScaledToZero bool `json:"scaledToZero"`
+
+	// Waking은 깨운 뒤 첫 레플리카가 Ready가 되기를 기다리는 중인지 여부
+	Waking bool `json:"waking"`
+
+	// IdleSince는 현재 유휴 구간의 시작 시간
+	// +optional
+	IdleSince *metav1.Time `json:"idleSince,omitempty"`
+
+	// ScaledToZeroAt은 마지막으로 0으로 스케일한 시간
+	// +optional
+	ScaledToZeroAt *metav1.Time `json:"scaledToZeroAt,omitempty"`
+
+	// ScaleToZeroCount는 0으로 스케일한 횟수
+	ScaleToZeroCount int64 `json:"scaleToZeroCount,omitempty"`
+
+	// WakeCount는 깨운 횟수
+	WakeCount int64 `json:"wakeCount,omitempty"`
+
+	// LastWakeTime은 마지막으로 깨운 시간
+	// +optional
+	LastWakeTime *metav1.Time `json:"lastWakeTime,omitempty"`
+
+	// LastWakeReason은 마지막으로 깨운 이유
+	// +optional
+	LastWakeReason string `json:"lastWakeReason,omitempty"`
+
+	// ObservedWakeRequest는 마지막으로 처리한 wake 어노테이션 값
+	// +optional
+	ObservedWakeRequest string `json:"observedWakeRequest,omitempty"`
+
+	// LastColdStartMilliseconds는 깨운 뒤 첫 레플리카가 Ready가 되기까지 걸린 시간 (ms)
+	// +optional
+	LastColdStartMilliseconds int64 `json:"lastColdStartMilliseconds,omitempty"`
+}
+
+// CustomMetricStatus는 커스텀 메트릭의 마지막 평가 결과
+type CustomMetricStatus struct {
+	// Name은 메트릭 이름
+	Name string `json:"name"`
+
+	// CurrentValue는 쿼리 결과
+	// +optional
+	CurrentValue *resource.Quantity `json:"currentValue,omitempty"`
+
+	// DesiredReplicas는 이 메트릭의 추천 레플리카 수
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+
+	// Error는 값을 사용할 수 없는 이유; 이 경우 스케일 다운을 보류함
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
 // StorageHPAPhase는 오토스케일러의 단계
 type StorageHPAPhase string
 
@@ -219,6 +443,18 @@ const (
 
 	// ConditionTypeMetricsAvailable은 메트릭이 사용 가능함
 	ConditionTypeMetricsAvailable = "MetricsAvailable"
+
+	// ConditionTypeScalingPolicyValid는 스케일링 정책이 유효함 (False이면 해당 방향 스케일링 중지)
+	ConditionTypeScalingPolicyValid = "ScalingPolicyValid"
+)
+
+// Scale to zero 깨우기 어노테이션
+const (
+	// WakeAnnotation은 값이 바뀔 때마다 0 레플리카의 워크로드를 깨움 (예: 현재 시각)
+	WakeAnnotation = "apollo.keti.re.kr/wake"
+
+	// QueueDepthAnnotation은 큐 깊이 신호; activationQueueDepth 이상이면 워크로드를 깨움
+	QueueDepthAnnotation = "apollo.keti.re.kr/queue-depth"
 )
 
 // +kubebuilder:object:root=true
@@ -266,6 +502,22 @@ func (s *StorageHPASpec) GetMaxScaleChangeForScaleDown() int32 {
 	return 0 // 0은 제한 없음
 }
 
+// GetForecastHorizonSeconds returns how far ahead predictive scaling looks (0: default)
+func (s *StorageHPASpec) GetForecastHorizonSeconds() int32 {
+	if s.Predictive != nil && s.Predictive.HorizonSeconds != nil {
+		return *s.Predictive.HorizonSeconds
+	}
+	return 0
+}
+
+// GetActivationQueueDepth returns the queue depth that wakes a workload scaled to zero
+func (s *StorageHPASpec) GetActivationQueueDepth() int64 {
+	if s.ScaleToZero != nil && s.ScaleToZero.ActivationQueueDepth != nil {
+		return *s.ScaleToZero.ActivationQueueDepth
+	}
+	return 1
+}
+
 // HasAnyTarget returns true if any target metric is set
 func (s *StorageHPASpec) HasAnyTarget() bool {
 	return s.TargetCPUPercent != nil ||
@@ -273,7 +525,8 @@ func (s *StorageHPASpec) HasAnyTarget() bool {
 		s.TargetGPUPercent != nil ||
 		s.TargetStorageReadThroughput != nil ||
 		s.TargetStorageWriteThroughput != nil ||
-		s.TargetStorageIOPS != nil
+		s.TargetStorageIOPS != nil ||
+		len(s.CustomMetrics) > 0
 }
 
 func init() {
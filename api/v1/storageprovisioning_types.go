@@ -0,0 +1,120 @@
+// Package v1 contains API Schema definitions for the apollo v1 API group
+// StorageProvisioning: 선언적 AI/ML 워크로드 스토리지 프로비저닝 CRD
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=sprov
+// +kubebuilder:printcolumn:name="Workload",type=string,JSONPath=`.spec.workloadName`
+// +kubebuilder:printcolumn:name="PVC",type=string,JSONPath=`.status.pvcName`
+// +kubebuilder:printcolumn:name="Size",type=string,JSONPath=`.status.actualSize`
+// +kubebuilder:printcolumn:name="Class",type=string,JSONPath=`.status.actualClass`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// StorageProvisioning은 워크로드용 스토리지(PVC)를 프로비저닝
+// PVC는 StorageProvisioning과 같은 네임스페이스에 생성되며, 리소스 삭제 시 함께 정리됨
+type StorageProvisioning struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StorageProvisioningSpec   `json:"spec,omitempty"`
+	Status StorageProvisioningStatus `json:"status,omitempty"`
+}
+
+// StorageProvisioningSpec defines the desired state of StorageProvisioning
+// 작업 시작 후 spec 변경은 반영되지 않음
+type StorageProvisioningSpec struct {
+	// WorkloadName은 스토리지를 사용할 워크로드 이름
+	// +kubebuilder:validation:Required
+	WorkloadName string `json:"workloadName"`
+
+	// WorkloadType은 워크로드 종류
+	// +kubebuilder:validation:Enum=training;inference;data-pipeline
+	// +kubebuilder:validation:Required
+	WorkloadType string `json:"workloadType"`
+
+	// StorageSize는 스토리지 크기 (예: "500Gi")
+	// +optional
+	StorageSize string `json:"storageSize,omitempty"`
+
+	// StorageClass는 논리 스토리지 클래스 (high-throughput, high-iops, balanced, standard)
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// AccessMode는 PVC 접근 모드
+	// +kubebuilder:validation:Enum=ReadWriteOnce;ReadWriteMany;ReadOnlyMany
+	// +optional
+	AccessMode string `json:"accessMode,omitempty"`
+
+	// AutoSize는 워크로드 종류에 따른 자동 크기 결정 여부
+	// +optional
+	AutoSize bool `json:"autoSize,omitempty"`
+
+	// RequiredReadThroughput는 필요한 읽기 처리량 (MB/s)
+	// +optional
+	RequiredReadThroughput int64 `json:"requiredReadThroughput,omitempty"`
+
+	// RequiredWriteThroughput는 필요한 쓰기 처리량 (MB/s)
+	// +optional
+	RequiredWriteThroughput int64 `json:"requiredWriteThroughput,omitempty"`
+
+	// RequiredIOPS는 필요한 IOPS
+	// +optional
+	RequiredIOPS int64 `json:"requiredIOPS,omitempty"`
+
+	// MountPath는 컨테이너 내 마운트 경로
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// VolumeMode는 볼륨 모드
+	// +kubebuilder:validation:Enum=Filesystem;Block
+	// +optional
+	VolumeMode string `json:"volumeMode,omitempty"`
+
+	// Labels는 PVC에 추가할 레이블
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations는 PVC에 추가할 어노테이션
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// StorageProvisioningStatus defines the observed state of StorageProvisioning
+type StorageProvisioningStatus struct {
+	JobStatus `json:",inline"`
+
+	// PVCName은 생성된 PVC 이름
+	// +optional
+	PVCName string `json:"pvcName,omitempty"`
+
+	// PVName은 바인딩된 PV 이름
+	// +optional
+	PVName string `json:"pvName,omitempty"`
+
+	// ActualSize는 실제 프로비저닝된 크기
+	// +optional
+	ActualSize string `json:"actualSize,omitempty"`
+
+	// ActualClass는 실제 적용된 스토리지 클래스
+	// +optional
+	ActualClass string `json:"actualClass,omitempty"`
+
+	// MountedPods는 PVC를 마운트한 Pod 목록
+	// +optional
+	MountedPods []string `json:"mountedPods,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StorageProvisioningList contains a list of StorageProvisioning
+type StorageProvisioningList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StorageProvisioning `json:"items"`
+}
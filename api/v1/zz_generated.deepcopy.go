@@ -103,6 +103,13 @@ func (in *StorageHPASpec) DeepCopyInto(out *StorageHPASpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.CustomMetrics != nil {
+		in, out := &in.CustomMetrics, &out.CustomMetrics
+		*out = make([]CustomMetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ScaleUpPolicy != nil {
 		in, out := &in.ScaleUpPolicy, &out.ScaleUpPolicy
 		*out = new(ScalingPolicySpec)
@@ -113,6 +120,16 @@ func (in *StorageHPASpec) DeepCopyInto(out *StorageHPASpec) {
 		*out = new(ScalingPolicySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Predictive != nil {
+		in, out := &in.Predictive, &out.Predictive
+		*out = new(PredictiveScalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleToZero != nil {
+		in, out := &in.ScaleToZero, &out.ScaleToZero
+		*out = new(ScaleToZeroSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy creates a deep copy of StorageHPASpec
@@ -132,6 +149,23 @@ func (in *StorageHPAStatus) DeepCopyInto(out *StorageHPAStatus) {
 		in, out := &in.LastScaleTime, &out.LastScaleTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Forecast != nil {
+		in, out := &in.Forecast, &out.Forecast
+		*out = new(ForecastStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleToZero != nil {
+		in, out := &in.ScaleToZero, &out.ScaleToZero
+		*out = new(ScaleToZeroStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomMetrics != nil {
+		in, out := &in.CustomMetrics, &out.CustomMetrics
+		*out = make([]CustomMetricStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.LastUpdated != nil {
 		in, out := &in.LastUpdated, &out.LastUpdated
 		*out = (*in).DeepCopy()
@@ -168,6 +202,16 @@ func (in *ScalingPolicySpec) DeepCopyInto(out *ScalingPolicySpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]ScalingPolicyRuleSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tolerance != nil {
+		in, out := &in.Tolerance, &out.Tolerance
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 }
 
 // DeepCopy creates a deep copy of ScalingPolicySpec
@@ -180,6 +224,149 @@ func (in *ScalingPolicySpec) DeepCopy() *ScalingPolicySpec {
 	return out
 }
 
+// DeepCopyInto copies all properties of ScalingPolicyRuleSpec
+func (in *ScalingPolicyRuleSpec) DeepCopyInto(out *ScalingPolicyRuleSpec) {
+	*out = *in
+}
+
+// DeepCopy creates a deep copy of ScalingPolicyRuleSpec
+func (in *ScalingPolicyRuleSpec) DeepCopy() *ScalingPolicyRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingPolicyRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of PredictiveScalingSpec
+func (in *PredictiveScalingSpec) DeepCopyInto(out *PredictiveScalingSpec) {
+	*out = *in
+	if in.HorizonSeconds != nil {
+		in, out := &in.HorizonSeconds, &out.HorizonSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy creates a deep copy of PredictiveScalingSpec
+func (in *PredictiveScalingSpec) DeepCopy() *PredictiveScalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PredictiveScalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of ForecastStatus
+func (in *ForecastStatus) DeepCopyInto(out *ForecastStatus) {
+	*out = *in
+	if in.ExpectedGPUPercent != nil {
+		in, out := &in.ExpectedGPUPercent, &out.ExpectedGPUPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExpectedStorageReadThroughput != nil {
+		in, out := &in.ExpectedStorageReadThroughput, &out.ExpectedStorageReadThroughput
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy creates a deep copy of ForecastStatus
+func (in *ForecastStatus) DeepCopy() *ForecastStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ForecastStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of CustomMetricSpec
+func (in *CustomMetricSpec) DeepCopyInto(out *CustomMetricSpec) {
+	*out = *in
+	out.TargetValue = in.TargetValue.DeepCopy()
+}
+
+// DeepCopy creates a deep copy of CustomMetricSpec
+func (in *CustomMetricSpec) DeepCopy() *CustomMetricSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomMetricSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of CustomMetricStatus
+func (in *CustomMetricStatus) DeepCopyInto(out *CustomMetricStatus) {
+	*out = *in
+	if in.CurrentValue != nil {
+		in, out := &in.CurrentValue, &out.CurrentValue
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy creates a deep copy of CustomMetricStatus
+func (in *CustomMetricStatus) DeepCopy() *CustomMetricStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomMetricStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of ScaleToZeroSpec
+func (in *ScaleToZeroSpec) DeepCopyInto(out *ScaleToZeroSpec) {
+	*out = *in
+	if in.ActivationQueueDepth != nil {
+		in, out := &in.ActivationQueueDepth, &out.ActivationQueueDepth
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy creates a deep copy of ScaleToZeroSpec
+func (in *ScaleToZeroSpec) DeepCopy() *ScaleToZeroSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleToZeroSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of ScaleToZeroStatus
+func (in *ScaleToZeroStatus) DeepCopyInto(out *ScaleToZeroStatus) {
+	*out = *in
+	if in.IdleSince != nil {
+		in, out := &in.IdleSince, &out.IdleSince
+		*out = (*in).DeepCopy()
+	}
+	if in.ScaledToZeroAt != nil {
+		in, out := &in.ScaledToZeroAt, &out.ScaledToZeroAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastWakeTime != nil {
+		in, out := &in.LastWakeTime, &out.LastWakeTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy creates a deep copy of ScaleToZeroStatus
+func (in *ScaleToZeroStatus) DeepCopy() *ScaleToZeroStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleToZeroStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto copies all properties of WorkloadReference
 func (in *WorkloadReference) DeepCopyInto(out *WorkloadReference) {
 	*out = *in
@@ -194,3 +381,533 @@ func (in *WorkloadReference) DeepCopy() *WorkloadReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto copies all properties of JobStatus
+func (in *JobStatus) DeepCopyInto(out *JobStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy creates a deep copy of JobStatus
+func (in *JobStatus) DeepCopy() *JobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type
+func (in *PodMigration) DeepCopyInto(out *PodMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy creates a deep copy of PodMigration
+func (in *PodMigration) DeepCopy() *PodMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(PodMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject creates a deep copy as runtime.Object
+func (in *PodMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies all properties of PodMigrationList
+func (in *PodMigrationList) DeepCopyInto(out *PodMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PodMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy creates a deep copy of PodMigrationList
+func (in *PodMigrationList) DeepCopy() *PodMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(PodMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject creates a deep copy as runtime.Object
+func (in *PodMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies all properties of PodMigrationSpec
+func (in *PodMigrationSpec) DeepCopyInto(out *PodMigrationSpec) {
+	*out = *in
+}
+
+// DeepCopy creates a deep copy of PodMigrationSpec
+func (in *PodMigrationSpec) DeepCopy() *PodMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of PodMigrationStatus
+func (in *PodMigrationStatus) DeepCopyInto(out *PodMigrationStatus) {
+	*out = *in
+	in.JobStatus.DeepCopyInto(&out.JobStatus)
+}
+
+// DeepCopy creates a deep copy of PodMigrationStatus
+func (in *PodMigrationStatus) DeepCopy() *PodMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PodMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type
+func (in *LoadbalancingPolicy) DeepCopyInto(out *LoadbalancingPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy creates a deep copy of LoadbalancingPolicy
+func (in *LoadbalancingPolicy) DeepCopy() *LoadbalancingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadbalancingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject creates a deep copy as runtime.Object
+func (in *LoadbalancingPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies all properties of LoadbalancingPolicyList
+func (in *LoadbalancingPolicyList) DeepCopyInto(out *LoadbalancingPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadbalancingPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy creates a deep copy of LoadbalancingPolicyList
+func (in *LoadbalancingPolicyList) DeepCopy() *LoadbalancingPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadbalancingPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject creates a deep copy as runtime.Object
+func (in *LoadbalancingPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies all properties of LoadbalancingPolicySpec
+func (in *LoadbalancingPolicySpec) DeepCopyInto(out *LoadbalancingPolicySpec) {
+	*out = *in
+	if in.TargetNodes != nil {
+		in, out := &in.TargetNodes, &out.TargetNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a deep copy of LoadbalancingPolicySpec
+func (in *LoadbalancingPolicySpec) DeepCopy() *LoadbalancingPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadbalancingPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of LoadbalancingPolicyStatus
+func (in *LoadbalancingPolicyStatus) DeepCopyInto(out *LoadbalancingPolicyStatus) {
+	*out = *in
+	in.JobStatus.DeepCopyInto(&out.JobStatus)
+}
+
+// DeepCopy creates a deep copy of LoadbalancingPolicyStatus
+func (in *LoadbalancingPolicyStatus) DeepCopy() *LoadbalancingPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadbalancingPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type
+func (in *PreemptionRequest) DeepCopyInto(out *PreemptionRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy creates a deep copy of PreemptionRequest
+func (in *PreemptionRequest) DeepCopy() *PreemptionRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(PreemptionRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject creates a deep copy as runtime.Object
+func (in *PreemptionRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies all properties of PreemptionRequestList
+func (in *PreemptionRequestList) DeepCopyInto(out *PreemptionRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PreemptionRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy creates a deep copy of PreemptionRequestList
+func (in *PreemptionRequestList) DeepCopy() *PreemptionRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(PreemptionRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject creates a deep copy as runtime.Object
+func (in *PreemptionRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies all properties of PreemptionRequestSpec
+func (in *PreemptionRequestSpec) DeepCopyInto(out *PreemptionRequestSpec) {
+	*out = *in
+	if in.ProtectedNamespaces != nil {
+		in, out := &in.ProtectedNamespaces, &out.ProtectedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a deep copy of PreemptionRequestSpec
+func (in *PreemptionRequestSpec) DeepCopy() *PreemptionRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PreemptionRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of PreemptionRequestStatus
+func (in *PreemptionRequestStatus) DeepCopyInto(out *PreemptionRequestStatus) {
+	*out = *in
+	in.JobStatus.DeepCopyInto(&out.JobStatus)
+	if in.PreemptedPods != nil {
+		in, out := &in.PreemptedPods, &out.PreemptedPods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a deep copy of PreemptionRequestStatus
+func (in *PreemptionRequestStatus) DeepCopy() *PreemptionRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PreemptionRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type
+func (in *StorageProvisioning) DeepCopyInto(out *StorageProvisioning) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy creates a deep copy of StorageProvisioning
+func (in *StorageProvisioning) DeepCopy() *StorageProvisioning {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageProvisioning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject creates a deep copy as runtime.Object
+func (in *StorageProvisioning) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies all properties of StorageProvisioningList
+func (in *StorageProvisioningList) DeepCopyInto(out *StorageProvisioningList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StorageProvisioning, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy creates a deep copy of StorageProvisioningList
+func (in *StorageProvisioningList) DeepCopy() *StorageProvisioningList {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageProvisioningList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject creates a deep copy as runtime.Object
+func (in *StorageProvisioningList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies all properties of StorageProvisioningSpec
+func (in *StorageProvisioningSpec) DeepCopyInto(out *StorageProvisioningSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy creates a deep copy of StorageProvisioningSpec
+func (in *StorageProvisioningSpec) DeepCopy() *StorageProvisioningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageProvisioningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of StorageProvisioningStatus
+func (in *StorageProvisioningStatus) DeepCopyInto(out *StorageProvisioningStatus) {
+	*out = *in
+	in.JobStatus.DeepCopyInto(&out.JobStatus)
+	if in.MountedPods != nil {
+		in, out := &in.MountedPods, &out.MountedPods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a deep copy of StorageProvisioningStatus
+func (in *StorageProvisioningStatus) DeepCopy() *StorageProvisioningStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageProvisioningStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type
+func (in *DataCache) DeepCopyInto(out *DataCache) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy creates a deep copy of DataCache
+func (in *DataCache) DeepCopy() *DataCache {
+	if in == nil {
+		return nil
+	}
+	out := new(DataCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject creates a deep copy as runtime.Object
+func (in *DataCache) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies all properties of DataCacheList
+func (in *DataCacheList) DeepCopyInto(out *DataCacheList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataCache, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy creates a deep copy of DataCacheList
+func (in *DataCacheList) DeepCopy() *DataCacheList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataCacheList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject creates a deep copy as runtime.Object
+func (in *DataCacheList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies all properties of DataCacheSpec
+func (in *DataCacheSpec) DeepCopyInto(out *DataCacheSpec) {
+	*out = *in
+	if in.WorkloadLabels != nil {
+		in, out := &in.WorkloadLabels, &out.WorkloadLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.WorkloadNames != nil {
+		in, out := &in.WorkloadNames, &out.WorkloadNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a deep copy of DataCacheSpec
+func (in *DataCacheSpec) DeepCopy() *DataCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of DataCacheStatus
+func (in *DataCacheStatus) DeepCopyInto(out *DataCacheStatus) {
+	*out = *in
+	in.JobStatus.DeepCopyInto(&out.JobStatus)
+}
+
+// DeepCopy creates a deep copy of DataCacheStatus
+func (in *DataCacheStatus) DeepCopy() *DataCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// stringList is a flag holding a comma-separated list. Repeating the flag appends;
+// the first use replaces the values loaded from a request file.
+type stringList struct {
+	values *[]string
+	set    bool
+}
+
+func (l *stringList) String() string {
+	if l == nil || l.values == nil {
+		return ""
+	}
+	return strings.Join(*l.values, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	if !l.set {
+		*l.values = nil
+		l.set = true
+	}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l.values = append(*l.values, item)
+		}
+	}
+	return nil
+}
+
+// int32Value is a flag of an int32 request field
+type int32Value struct {
+	value *int32
+}
+
+func (v int32Value) String() string {
+	if v.value == nil {
+		return "0"
+	}
+	return fmt.Sprint(*v.value)
+}
+
+func (v int32Value) Set(s string) error {
+	var n int32
+	if _, err := fmt.Sscan(s, &n); err != nil {
+		return fmt.Errorf("invalid integer %q", s)
+	}
+	*v.value = n
+	return nil
+}
+
+// int32Var registers an int32 flag defaulting to the current value of p
+func int32Var(fs *flag.FlagSet, p *int32, name, usage string) {
+	fs.Var(int32Value{p}, name, usage)
+}
+
+// newFlagSet creates the flag set of a command; errors are returned instead of exiting
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+// parseArgs parses flags placed before, between or after positional arguments
+// ("get <id> -o json" as well as "get -o json <id>") and returns the positional arguments
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		if args[0] == "--" {
+			return append(positional, args[1:]...), nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// loadRequestFile decodes a YAML or JSON request file ("-" reads stdin) into req.
+// Unknown fields are rejected so that typos do not silently fall back to defaults.
+func loadRequestFile(path string, req interface{}) error {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if err := yaml.UnmarshalStrict(data, req); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,357 @@
+// aisctl is the command-line client of the AI storage orchestrator REST API.
+//
+//	aisctl [global flags] <resource> <command> [flags] [args]
+//
+// 스크립트에서 curl로 호출하던 API를 마이그레이션/오토스케일링/로드밸런싱/프로비저닝/
+// 선점/캐싱/인사이트 서브커맨드로 제공한다.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"ai-storage-orchestrator/pkg/client"
+)
+
+const defaultServer = "http://localhost:8080"
+
+// errHelp is returned after help was printed; the command exits successfully
+var errHelp = errors.New("help requested")
+
+// env is the state shared by the subcommands of one invocation
+type env struct {
+	client  *client.Client
+	printer *printer
+	output  string
+	command string // e.g. "aisctl migrations get", for help and errors
+	stdout  io.Writer
+	stderr  io.Writer
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run executes a command line and returns the exit code: 0 on success, 1 when the API call
+// or the job failed and 2 on usage errors
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	global := newFlagSet("aisctl")
+	server := global.String("server", envOr("AISCTL_SERVER", defaultServer), "orchestrator URL (env AISCTL_SERVER)")
+	token := global.String("token", os.Getenv("AISCTL_TOKEN"), "bearer token (env AISCTL_TOKEN)")
+	requestTimeout := global.Duration("request-timeout", client.DefaultTimeout, "timeout of a single API call")
+	e := &env{stdout: stdout, stderr: stderr}
+	addOutputFlags(global, &e.output)
+
+	if err := global.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			printUsage(stdout, global)
+			return 0
+		}
+		fmt.Fprintf(stderr, "error: %v\n\n", err)
+		printUsage(stderr, global)
+		return 2
+	}
+	args = global.Args()
+	if len(args) == 0 || args[0] == "help" {
+		printUsage(stdout, global)
+		return 0
+	}
+
+	r := findResource(args[0])
+	if r == nil {
+		fmt.Fprintf(stderr, "error: unknown resource %q\n\n", args[0])
+		printUsage(stderr, global)
+		return 2
+	}
+	if len(args) == 1 || args[1] == "help" || args[1] == "-h" || args[1] == "--help" {
+		printResourceUsage(stdout, r)
+		return 0
+	}
+
+	e.client = client.NewClient(*server)
+	e.client.SetToken(*token)
+	e.client.SetUserAgent("aisctl")
+	e.client.SetHTTPClient(&http.Client{Timeout: *requestTimeout})
+
+	err := e.runVerb(ctx, r, args[1], args[2:])
+	var usageErr usageError
+	switch {
+	case err == nil, errors.Is(err, errHelp):
+		return 0
+	case errors.As(err, &usageErr):
+		fmt.Fprintf(stderr, "error: %v\n\n", err)
+		printResourceUsage(stderr, r)
+		return 2
+	default:
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+}
+
+// runVerb runs one subcommand of a resource
+func (e *env) runVerb(ctx context.Context, r *resource, verb string, args []string) error {
+	e.command = "aisctl " + r.name + " " + verb
+	if a, ok := r.actions[verb]; ok {
+		return a.run(ctx, e, args)
+	}
+
+	switch verb {
+	case "create":
+		if r.create == nil {
+			break
+		}
+		req, watch, err := e.parseRequest("create", r.create, args)
+		if err != nil {
+			return err
+		}
+		obj, err := r.create.submit(ctx, e.client, req)
+		if err != nil {
+			return err
+		}
+		if !watch {
+			return e.printer.printObject(obj, r.columns)
+		}
+		id, err := objectID(obj, r.columns)
+		if err != nil {
+			return err
+		}
+		return e.watch(ctx, r, []string{id}, defaultWatchInterval)
+
+	case "get":
+		ids, err := e.parsePositional(verb, args, len(r.idUsage()), r.idUsage()...)
+		if err != nil {
+			return err
+		}
+		obj, err := r.get(ctx, e.client, ids)
+		if err != nil {
+			return err
+		}
+		return e.printer.printObject(obj, r.columns)
+
+	case "list", "ls":
+		if _, err := e.parsePositional(verb, args, 0); err != nil {
+			return err
+		}
+		items, err := r.list(ctx, e.client)
+		if err != nil {
+			return err
+		}
+		return e.printer.printObjects(items, r.columns)
+
+	case "watch":
+		fs := e.flagSet(verb)
+		interval := fs.Duration("interval", defaultWatchInterval, "polling interval")
+		ids, err := e.parse(fs, args, len(r.idUsage()), r.idUsage()...)
+		if err != nil {
+			return err
+		}
+		return e.watch(ctx, r, ids, *interval)
+
+	case "cancel", "delete":
+		if r.remove == nil {
+			break
+		}
+		ids, err := e.parsePositional(verb, args, 1, "<id>")
+		if err != nil {
+			return err
+		}
+		if err := r.remove(ctx, e.client, ids[0]); err != nil {
+			return err
+		}
+		if r.removeVerb() == "cancel" {
+			e.printer.printMessage("%s cancellation requested", ids[0])
+		} else {
+			e.printer.printMessage("%s deleted", ids[0])
+		}
+		return nil
+
+	case "metrics":
+		if r.metrics == nil {
+			break
+		}
+		if _, err := e.parsePositional(verb, args, 0); err != nil {
+			return err
+		}
+		metrics, err := r.metrics(ctx, e.client)
+		if err != nil {
+			return err
+		}
+		return e.printer.printObject(metrics, nil)
+	}
+	return usageError{fmt.Sprintf("unknown command %q for %s", verb, r.name)}
+}
+
+// flagSet creates the flag set of a subcommand with the output flags
+func (e *env) flagSet(name string) *flag.FlagSet {
+	fs := newFlagSet(name)
+	addOutputFlags(fs, &e.output)
+	return fs
+}
+
+// parse parses the flags of a subcommand, checks the number of positional arguments and
+// sets up the printer
+func (e *env) parse(fs *flag.FlagSet, args []string, want int, names ...string) ([]string, error) {
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(e.stdout, "Usage: %s [flags]\n\nFlags:\n", strings.Join(append([]string{e.command}, names...), " "))
+			fs.SetOutput(e.stdout)
+			fs.PrintDefaults()
+			return nil, errHelp
+		}
+		return nil, usageError{err.Error()}
+	}
+	if len(positional) != want {
+		if want == 0 {
+			return nil, usageError{fmt.Sprintf("%s takes no arguments", e.command)}
+		}
+		return nil, usageError{fmt.Sprintf("%s requires %s", e.command, strings.Join(names, " "))}
+	}
+	if e.printer, err = newPrinter(e.stdout, e.output); err != nil {
+		return nil, usageError{err.Error()}
+	}
+	return positional, nil
+}
+
+// parsePositional parses a subcommand that has no flags of its own
+func (e *env) parsePositional(name string, args []string, want int, names ...string) ([]string, error) {
+	return e.parse(e.flagSet(name), args, want, names...)
+}
+
+// parseRequest builds a request from -f and the request flags; flags override the file
+func (e *env) parseRequest(name string, c *creator, args []string) (interface{}, bool, error) {
+	var file string
+	var watch bool
+	newFlags := func(req interface{}) *flag.FlagSet {
+		fs := e.flagSet(name)
+		fs.StringVar(&file, "f", "", "YAML or JSON request file (- for stdin)")
+		fs.StringVar(&file, "filename", "", "YAML or JSON request file (- for stdin)")
+		fs.BoolVar(&watch, "watch", false, "watch the created object until it finishes")
+		fs.BoolVar(&watch, "w", false, "watch the created object (shorthand)")
+		c.bindFlags(fs, req)
+		return fs
+	}
+
+	req := c.newRequest()
+	if _, err := e.parse(newFlags(req), args, 0); err != nil {
+		return nil, false, err
+	}
+	if file == "" {
+		return req, watch, nil
+	}
+
+	// 파일을 먼저 읽고, 명령행 플래그로 지정한 값만 덮어쓴다
+	path := file
+	req = c.newRequest()
+	if err := loadRequestFile(path, req); err != nil {
+		return nil, false, err
+	}
+	if _, err := e.parse(newFlags(req), args, 0); err != nil {
+		return nil, false, err
+	}
+	return req, watch, nil
+}
+
+// objectID returns the ID of a created object, read from the first column
+func objectID(obj interface{}, columns []column) (string, error) {
+	fields, err := toMap(obj)
+	if err != nil {
+		return "", err
+	}
+	id, _ := lookup(fields, columns[0].path).(string)
+	if id == "" {
+		return "", fmt.Errorf("response has no %s", columns[0].path)
+	}
+	return id, nil
+}
+
+// addOutputFlags registers -o/--output
+func addOutputFlags(fs *flag.FlagSet, output *string) {
+	if *output == "" {
+		*output = outputTable
+	}
+	fs.StringVar(output, "o", *output, "output format: table, json or yaml")
+	fs.StringVar(output, "output", *output, "output format: table, json or yaml")
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func sortedKeys(m map[string]*action) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func printUsage(w io.Writer, global *flag.FlagSet) {
+	fmt.Fprintln(w, "aisctl - command-line client of the AI storage orchestrator")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Usage:")
+	fmt.Fprintln(w, "  aisctl [global flags] <resource> <command> [flags] [args]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Resources:")
+	for _, r := range resources {
+		fmt.Fprintf(w, "  %-16s %s\n", r.name, strings.Join(r.verbs(), ", "))
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Global flags:")
+	global.SetOutput(w)
+	global.PrintDefaults()
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Examples:")
+	fmt.Fprintln(w, "  aisctl migrations create --pod trainer-0 -n ml --source-node worker-1 --target-node worker-2 --watch")
+	fmt.Fprintln(w, "  aisctl autoscalers create -f autoscaler.yaml")
+	fmt.Fprintln(w, "  aisctl loadbalancing plan --strategy storage_aware -o yaml")
+	fmt.Fprintln(w, "  aisctl preemption list -o json")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `Run "aisctl <resource> help" for the commands of a resource.`)
+}
+
+func printResourceUsage(w io.Writer, r *resource) {
+	ids := strings.Join(r.idUsage(), " ")
+	fmt.Fprintf(w, "Usage: aisctl %s <command> [flags] [args]\n", r.name)
+	if len(r.aliases) > 0 {
+		fmt.Fprintf(w, "Aliases: %s\n", strings.Join(r.aliases, ", "))
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, verb := range r.verbs() {
+		switch verb {
+		case "create":
+			fmt.Fprintf(w, "  create [-f file] [flags] [--watch] - create from flags or a YAML/JSON file\n")
+		case "get":
+			fmt.Fprintf(w, "  get %s - show one object\n", ids)
+		case "list":
+			fmt.Fprintf(w, "  list - list all objects\n")
+		case "watch":
+			fmt.Fprintf(w, "  watch %s [--interval 2s] - print status changes until the job finishes\n", ids)
+		case "cancel", "delete":
+			fmt.Fprintf(w, "  %s <id>\n", verb)
+		case "metrics":
+			fmt.Fprintf(w, "  metrics - show the metrics of the controller\n")
+		default:
+			fmt.Fprintf(w, "  %s %s\n", verb, r.actions[verb].usage)
+		}
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `Every command accepts -o table|json|yaml; run "aisctl <resource> <command> -h" for its flags.`)
+}
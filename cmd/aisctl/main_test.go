@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOrchestrator answers the autoscaling endpoints with canned responses
+func fakeOrchestrator(t *testing.T, created *types.AutoscalingRequest) *httptest.Server {
+	autoscaler := &types.AutoscalingResponse{
+		AutoscalingID: "autoscaling-1a2b3c4d",
+		Status:        types.AutoscalingStatusActive,
+		Details:       &types.AutoscalingDetails{CurrentReplicas: 2, DesiredReplicas: 3},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/autoscaling", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer s3cret", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(created))
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(autoscaler)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"autoscalers": []interface{}{autoscaler}, "count": 1})
+	})
+	mux.HandleFunc("/api/v1/autoscaling/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "Autoscaler not found", "details": "autoscaler missing not found"})
+	})
+	return httptest.NewServer(mux)
+}
+
+func runCLI(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestCreateFromFileAndFlags(t *testing.T) {
+	var created types.AutoscalingRequest
+	server := fakeOrchestrator(t, &created)
+	defer server.Close()
+
+	file := filepath.Join(t.TempDir(), "autoscaler.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+workload_name: trainer
+workload_namespace: ml
+min_replicas: 1
+max_replicas: 4
+target_gpu_percent: 70
+`), 0o600))
+
+	// Flags override the file
+	code, stdout, stderr := runCLI("--server", server.URL, "--token", "s3cret",
+		"autoscalers", "create", "-f", file, "--max", "8", "-o", "json")
+	require.Equal(t, 0, code, stderr)
+	assert.Equal(t, "trainer", created.WorkloadName)
+	assert.Equal(t, "Deployment", created.WorkloadType)
+	assert.Equal(t, int32(1), created.MinReplicas)
+	assert.Equal(t, int32(8), created.MaxReplicas)
+	assert.Equal(t, int32(70), created.TargetGPU)
+	assert.Contains(t, stdout, `"autoscaling_id": "autoscaling-1a2b3c4d"`)
+
+	// Unknown fields in the file are rejected
+	require.NoError(t, os.WriteFile(file, []byte("workload_nmae: trainer\n"), 0o600))
+	code, _, stderr = runCLI("--server", server.URL, "as", "create", "-f", file)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "workload_nmae")
+}
+
+func TestListAndErrors(t *testing.T) {
+	server := fakeOrchestrator(t, &types.AutoscalingRequest{})
+	defer server.Close()
+
+	code, stdout, stderr := runCLI("--server", server.URL, "--token", "s3cret", "autoscaling", "list")
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "ID")
+	assert.Contains(t, stdout, "DESIRED")
+	assert.Contains(t, stdout, "autoscaling-1a2b3c4d")
+
+	code, _, stderr = runCLI("--server", server.URL, "autoscalers", "get", "missing")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "HTTP 404: Autoscaler not found")
+
+	code, _, _ = runCLI("--server", server.URL, "autoscalers", "get")
+	assert.Equal(t, 2, code, "missing ID is a usage error")
+	code, _, _ = runCLI("--server", server.URL, "preemption", "delete", "x")
+	assert.Equal(t, 2, code, "preemption jobs cannot be deleted")
+	code, _, _ = runCLI("backups", "list")
+	assert.Equal(t, 2, code)
+	code, _, _ = runCLI("-o", "xml", "autoscalers", "list")
+	assert.Equal(t, 2, code)
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Output formats of -o
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// column is a table column read from a JSON path of the object, e.g. "details.current_replicas"
+type column struct {
+	header string
+	path   string
+	format func(interface{}) string // optional, defaults to formatValue
+}
+
+// printer writes API objects in the selected output format
+type printer struct {
+	out    io.Writer
+	format string
+}
+
+func newPrinter(out io.Writer, format string) (*printer, error) {
+	switch format {
+	case outputTable, outputJSON, outputYAML:
+		return &printer{out: out, format: format}, nil
+	default:
+		return nil, fmt.Errorf("invalid output format %q (must be table, json or yaml)", format)
+	}
+}
+
+// printObjects prints a list of objects, one table row each
+func (p *printer) printObjects(items interface{}, columns []column) error {
+	if p.format != outputTable {
+		return p.printRaw(items)
+	}
+	rows, err := toMaps(items)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		fmt.Fprintln(p.out, "No resources found.")
+		return nil
+	}
+	w := tabwriter.NewWriter(p.out, 0, 0, 3, ' ', 0)
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = col.header
+	}
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, tableRow(row, columns))
+	}
+	return w.Flush()
+}
+
+// printObject prints a single object; objects without columns, such as metrics, are printed as FIELD/VALUE pairs
+func (p *printer) printObject(obj interface{}, columns []column) error {
+	if p.format != outputTable {
+		return p.printRaw(obj)
+	}
+	if len(columns) > 0 {
+		return p.printObjects([]interface{}{obj}, columns)
+	}
+
+	fields, err := toMap(obj)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	w := tabwriter.NewWriter(p.out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "FIELD\tVALUE")
+	for _, key := range keys {
+		fmt.Fprintf(w, "%s\t%s\n", key, formatValue(fields[key]))
+	}
+	return w.Flush()
+}
+
+// printMessage prints the result of an action without response body, e.g. a cancellation
+func (p *printer) printMessage(format string, args ...interface{}) {
+	fmt.Fprintf(p.out, format+"\n", args...)
+}
+
+func (p *printer) printRaw(obj interface{}) error {
+	data, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+	if p.format == outputYAML {
+		if data, err = yaml.JSONToYAML(data); err != nil {
+			return err
+		}
+		_, err = p.out.Write(data)
+		return err
+	}
+	_, err = fmt.Fprintln(p.out, string(data))
+	return err
+}
+
+// tableRow formats the cells of one row
+func tableRow(row map[string]interface{}, columns []column) string {
+	cells := make([]string, len(columns))
+	for i, col := range columns {
+		value := lookup(row, col.path)
+		if col.format != nil {
+			cells[i] = col.format(value)
+		} else {
+			cells[i] = formatValue(value)
+		}
+	}
+	return strings.Join(cells, "\t")
+}
+
+// lookup returns the value at a dotted JSON path, or nil
+func lookup(obj map[string]interface{}, path string) interface{} {
+	var value interface{} = obj
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+// formatValue renders a JSON value for a table cell
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "-"
+	case string:
+		if v == "" {
+			return "-"
+		}
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return t.Local().Format("2006-01-02 15:04:05")
+		}
+		return v
+	case float64:
+		return fmt.Sprint(v)
+	case bool:
+		return fmt.Sprint(v)
+	case []interface{}:
+		if len(v) == 0 {
+			return "-"
+		}
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = formatValue(item)
+		}
+		return strings.Join(parts, ",")
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+// formatDuration renders a time.Duration serialized as nanoseconds
+func formatDuration(value interface{}) string {
+	ns, ok := value.(float64)
+	if !ok {
+		return "-"
+	}
+	return time.Duration(ns).Round(time.Second).String()
+}
+
+// formatAge renders the time elapsed since a timestamp, like the AGE column of kubectl
+func formatAge(value interface{}) string {
+	s, ok := value.(string)
+	if !ok {
+		return "-"
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil || t.IsZero() {
+		return "-"
+	}
+	age := time.Since(t)
+	switch {
+	case age < time.Minute:
+		return fmt.Sprintf("%ds", int(age.Seconds()))
+	case age < time.Hour:
+		return fmt.Sprintf("%dm", int(age.Minutes()))
+	case age < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(age.Hours()/24))
+	}
+}
+
+// toMap converts an API object into its JSON form
+func toMap(obj interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// toMaps converts a slice of API objects into their JSON form
+func toMaps(items interface{}) ([]map[string]interface{}, error) {
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("expected a list, got %T", items)
+	}
+	rows := make([]map[string]interface{}, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		row, err := toMap(v.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
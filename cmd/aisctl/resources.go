@@ -0,0 +1,558 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"ai-storage-orchestrator/pkg/client"
+	"ai-storage-orchestrator/pkg/types"
+)
+
+// resource is a group of subcommands over one API resource
+type resource struct {
+	name    string
+	aliases []string
+	columns []column
+
+	// idArgs names the positional arguments identifying one object (default: "<id>")
+	idArgs []string
+
+	create  *creator
+	get     func(ctx context.Context, c *client.Client, ids []string) (interface{}, error)
+	list    func(ctx context.Context, c *client.Client) (interface{}, error)
+	remove  func(ctx context.Context, c *client.Client, id string) error
+	metrics func(ctx context.Context, c *client.Client) (interface{}, error)
+
+	// actions are resource specific subcommands such as "loadbalancing plan"
+	actions map[string]*action
+}
+
+// creator builds a request from flags or a YAML/JSON file and submits it
+type creator struct {
+	newRequest func() interface{}
+	// bindFlags registers the request flags; defaults must be the current field values
+	// so that flags override the values of a request file
+	bindFlags func(fs *flag.FlagSet, req interface{})
+	submit    func(ctx context.Context, c *client.Client, req interface{}) (interface{}, error)
+}
+
+// action is a resource specific subcommand
+type action struct {
+	usage string
+	run   func(ctx context.Context, e *env, args []string) error
+}
+
+// resources lists the resources of the CLI in help order
+var resources = []*resource{
+	{
+		name:    "migrations",
+		aliases: []string{"migration", "mig"},
+		columns: []column{
+			{header: "ID", path: "migration_id"},
+			{header: "STATUS", path: "status"},
+			{header: "WORKLOAD", path: "details.workload_name"},
+			{header: "NEW POD", path: "details.new_pod_name"},
+			{header: "MODE", path: "details.checkpoint_mode"},
+			{header: "DURATION", path: "details.duration", format: formatDuration},
+			{header: "AGE", path: "details.start_time", format: formatAge},
+		},
+		create: &creator{
+			newRequest: func() interface{} { return &types.MigrationRequest{} },
+			bindFlags: func(fs *flag.FlagSet, r interface{}) {
+				req := r.(*types.MigrationRequest)
+				fs.StringVar(&req.PodName, "pod", req.PodName, "name of the pod to migrate")
+				fs.StringVar(&req.PodNamespace, "namespace", req.PodNamespace, "namespace of the pod")
+				fs.StringVar(&req.PodNamespace, "n", req.PodNamespace, "namespace of the pod (shorthand)")
+				fs.StringVar(&req.SourceNode, "source-node", req.SourceNode, "node the pod runs on")
+				fs.StringVar(&req.TargetNode, "target-node", req.TargetNode, "node to migrate the pod to")
+				fs.BoolVar(&req.PreservePV, "preserve-pv", req.PreservePV, "checkpoint the pod state to a PV")
+				fs.BoolVar(&req.ForceRestart, "force-restart", req.ForceRestart, "restart instead of checkpoint/restore")
+				fs.IntVar(&req.Timeout, "timeout", req.Timeout, "migration timeout in seconds (server default 600)")
+			},
+			submit: func(ctx context.Context, c *client.Client, r interface{}) (interface{}, error) {
+				return c.CreateMigration(ctx, r.(*types.MigrationRequest))
+			},
+		},
+		get: func(ctx context.Context, c *client.Client, ids []string) (interface{}, error) {
+			return c.GetMigration(ctx, ids[0])
+		},
+		list: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.ListMigrations(ctx)
+		},
+		remove: func(ctx context.Context, c *client.Client, id string) error {
+			return c.CancelMigration(ctx, id)
+		},
+		metrics: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.GetMigrationMetrics(ctx)
+		},
+	},
+	{
+		name:    "autoscalers",
+		aliases: []string{"autoscaler", "autoscaling", "as"},
+		columns: []column{
+			{header: "ID", path: "autoscaling_id"},
+			{header: "STATUS", path: "status"},
+			{header: "CURRENT", path: "details.current_replicas"},
+			{header: "DESIRED", path: "details.desired_replicas"},
+			{header: "CPU%", path: "details.current_cpu_percent"},
+			{header: "GPU%", path: "details.current_gpu_percent"},
+			{header: "READ MB/S", path: "details.current_storage_read_throughput_mbps"},
+			{header: "METRICS", path: "details.metrics_provenance"},
+			{header: "AGE", path: "details.created_at", format: formatAge},
+		},
+		create: &creator{
+			newRequest: func() interface{} { return &types.AutoscalingRequest{WorkloadType: "Deployment"} },
+			bindFlags: func(fs *flag.FlagSet, r interface{}) {
+				req := r.(*types.AutoscalingRequest)
+				fs.StringVar(&req.WorkloadName, "name", req.WorkloadName, "name of the workload to scale")
+				fs.StringVar(&req.WorkloadNamespace, "namespace", req.WorkloadNamespace, "namespace of the workload")
+				fs.StringVar(&req.WorkloadNamespace, "n", req.WorkloadNamespace, "namespace of the workload (shorthand)")
+				fs.StringVar(&req.WorkloadType, "kind", req.WorkloadType, "workload kind: Deployment or StatefulSet")
+				int32Var(fs, &req.MinReplicas, "min", "minimum replicas")
+				int32Var(fs, &req.MaxReplicas, "max", "maximum replicas")
+				int32Var(fs, &req.TargetCPU, "cpu", "target CPU utilization percentage")
+				int32Var(fs, &req.TargetMemory, "memory", "target memory utilization percentage")
+				int32Var(fs, &req.TargetGPU, "gpu", "target GPU utilization percentage")
+				fs.Int64Var(&req.TargetStorageReadThroughput, "read-mbps", req.TargetStorageReadThroughput, "target storage read throughput per pod in MB/s")
+				fs.Int64Var(&req.TargetStorageWriteThroughput, "write-mbps", req.TargetStorageWriteThroughput, "target storage write throughput per pod in MB/s")
+				fs.Int64Var(&req.TargetStorageIOPS, "iops", req.TargetStorageIOPS, "target storage IOPS per pod")
+			},
+			submit: func(ctx context.Context, c *client.Client, r interface{}) (interface{}, error) {
+				return c.CreateAutoscaler(ctx, r.(*types.AutoscalingRequest))
+			},
+		},
+		get: func(ctx context.Context, c *client.Client, ids []string) (interface{}, error) {
+			return c.GetAutoscaler(ctx, ids[0])
+		},
+		list: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.ListAutoscalers(ctx)
+		},
+		remove: func(ctx context.Context, c *client.Client, id string) error {
+			return c.DeleteAutoscaler(ctx, id)
+		},
+		metrics: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.GetAutoscalingMetrics(ctx)
+		},
+		actions: map[string]*action{
+			"wake":     {usage: "<id> [flags] - wake a workload scaled to zero", run: wakeAutoscaler},
+			"schedule": {usage: "<id> - show the replica bounds of the schedules over the next 24 hours", run: previewAutoscalerSchedule},
+		},
+	},
+	{
+		name:    "loadbalancing",
+		aliases: []string{"loadbalancer", "lb"},
+		columns: []column{
+			{header: "ID", path: "loadbalancing_id"},
+			{header: "STATUS", path: "status"},
+			{header: "ANALYZED", path: "details.total_pods_analyzed"},
+			{header: "PLANNED", path: "details.pods_to_migrate"},
+			{header: "SUCCEEDED", path: "details.successful_migrations"},
+			{header: "FAILED", path: "details.failed_migrations"},
+			{header: "AGE", path: "details.created_at", format: formatAge},
+		},
+		create: loadbalancingCreator,
+		get: func(ctx context.Context, c *client.Client, ids []string) (interface{}, error) {
+			return c.GetLoadbalancing(ctx, ids[0])
+		},
+		list: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.ListLoadbalancing(ctx)
+		},
+		remove: func(ctx context.Context, c *client.Client, id string) error {
+			return c.CancelLoadbalancing(ctx, id)
+		},
+		metrics: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.GetLoadbalancingMetrics(ctx)
+		},
+		actions: map[string]*action{
+			"plan": {usage: "[flags] - preview the migrations of a loadbalancing job (dry run)", run: planLoadbalancing},
+		},
+	},
+	{
+		name:    "provisioning",
+		aliases: []string{"provisionings", "prov"},
+		columns: []column{
+			{header: "ID", path: "provisioning_id"},
+			{header: "STATUS", path: "status"},
+			{header: "PVC", path: "details.pvc_name"},
+			{header: "SIZE", path: "details.actual_size"},
+			{header: "CLASS", path: "details.actual_class"},
+			{header: "STORAGECLASS", path: "details.storage_class_name"},
+			{header: "AGE", path: "details.created_at", format: formatAge},
+		},
+		create: &creator{
+			newRequest: func() interface{} { return &types.ProvisioningRequest{} },
+			bindFlags: func(fs *flag.FlagSet, r interface{}) {
+				req := r.(*types.ProvisioningRequest)
+				fs.StringVar(&req.WorkloadName, "name", req.WorkloadName, "name of the workload")
+				fs.StringVar(&req.WorkloadNamespace, "namespace", req.WorkloadNamespace, "namespace of the workload")
+				fs.StringVar(&req.WorkloadNamespace, "n", req.WorkloadNamespace, "namespace of the workload (shorthand)")
+				fs.StringVar(&req.WorkloadType, "type", req.WorkloadType, "workload type: training, inference or data-pipeline")
+				fs.StringVar(&req.StorageSize, "size", req.StorageSize, "storage size, e.g. 500Gi")
+				fs.StringVar(&req.StorageClass, "class", req.StorageClass, "storage class: high-throughput, high-iops or balanced")
+				fs.StringVar(&req.AccessMode, "access-mode", req.AccessMode, "ReadWriteOnce, ReadWriteMany or ReadOnlyMany")
+				fs.BoolVar(&req.AutoSize, "auto-size", req.AutoSize, "size the volume from the workload type")
+				fs.Int64Var(&req.RequiredReadThroughput, "read-mbps", req.RequiredReadThroughput, "required read throughput in MB/s")
+				fs.Int64Var(&req.RequiredWriteThroughput, "write-mbps", req.RequiredWriteThroughput, "required write throughput in MB/s")
+				fs.Int64Var(&req.RequiredIOPS, "iops", req.RequiredIOPS, "required IOPS")
+				fs.StringVar(&req.MountPath, "mount-path", req.MountPath, "mount path in the container")
+			},
+			submit: func(ctx context.Context, c *client.Client, r interface{}) (interface{}, error) {
+				return c.CreateProvisioning(ctx, r.(*types.ProvisioningRequest))
+			},
+		},
+		get: func(ctx context.Context, c *client.Client, ids []string) (interface{}, error) {
+			return c.GetProvisioning(ctx, ids[0])
+		},
+		list: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.ListProvisionings(ctx)
+		},
+		remove: func(ctx context.Context, c *client.Client, id string) error {
+			return c.DeleteProvisioning(ctx, id)
+		},
+		metrics: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.GetProvisioningMetrics(ctx)
+		},
+		actions: map[string]*action{
+			"recommend": {usage: "<workload-type> - show the storage recommended for a workload type", run: recommendStorage},
+		},
+	},
+	{
+		name:    "preemption",
+		aliases: []string{"preemptions", "preempt"},
+		columns: []column{
+			{header: "ID", path: "preemption_id"},
+			{header: "STATUS", path: "status"},
+			{header: "RESOURCE", path: "details.target_resource_type"},
+			{header: "TARGET", path: "details.target_resource_amount"},
+			{header: "PREEMPTED", path: "details.successful_preemptions"},
+			{header: "FAILED", path: "details.failed_preemptions"},
+			{header: "ACHIEVED", path: "details.target_achieved"},
+			{header: "AGE", path: "details.created_at", format: formatAge},
+		},
+		create: &creator{
+			newRequest: func() interface{} { return &types.PreemptionRequest{} },
+			bindFlags: func(fs *flag.FlagSet, r interface{}) {
+				req := r.(*types.PreemptionRequest)
+				fs.StringVar(&req.NodeName, "node", req.NodeName, "node to free resources on")
+				fs.StringVar(&req.Namespace, "namespace", req.Namespace, "only preempt pods of this namespace (default: all, requires admin)")
+				fs.StringVar(&req.Namespace, "n", req.Namespace, "namespace (shorthand)")
+				fs.StringVar(&req.ResourceType, "resource", req.ResourceType, "resource to free: cpu, memory, gpu, storage or all")
+				fs.StringVar(&req.TargetAmount, "amount", req.TargetAmount, "amount to free, e.g. 4000m or 8Gi")
+				fs.StringVar(&req.Strategy, "strategy", req.Strategy, "lowest_priority, youngest, largest_resource or weighted_score")
+				int32Var(fs, &req.MinPriority, "min-priority", "only preempt pods with a lower priority")
+				int32Var(fs, &req.MaxPodsToPreempt, "max-pods", "maximum number of pods to preempt")
+				fs.Int64Var(&req.GracePeriodSeconds, "grace-period", req.GracePeriodSeconds, "termination grace period in seconds")
+				fs.Var(&stringList{values: &req.ProtectedNamespaces}, "protected-namespaces", "comma-separated namespaces that are never preempted")
+				fs.StringVar(&req.Reason, "reason", req.Reason, "reason recorded for auditing")
+			},
+			submit: func(ctx context.Context, c *client.Client, r interface{}) (interface{}, error) {
+				return c.StartPreemption(ctx, r.(*types.PreemptionRequest))
+			},
+		},
+		get: func(ctx context.Context, c *client.Client, ids []string) (interface{}, error) {
+			return c.GetPreemption(ctx, ids[0])
+		},
+		list: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.ListPreemptions(ctx)
+		},
+		metrics: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.GetPreemptionMetrics(ctx)
+		},
+	},
+	{
+		name:    "caches",
+		aliases: []string{"cache", "caching"},
+		columns: []column{
+			{header: "ID", path: "cache_id"},
+			{header: "STATUS", path: "status"},
+			{header: "NAMESPACE", path: "details.source_namespace"},
+			{header: "PVC", path: "details.source_pvc"},
+			{header: "TIER", path: "details.target_tier"},
+			{header: "POLICY", path: "details.cache_policy"},
+			{header: "HIT RATIO", path: "details.stats.hit_ratio"},
+			{header: "AGE", path: "details.created_at", format: formatAge},
+		},
+		create: &creator{
+			newRequest: func() interface{} { return &types.CachingRequest{} },
+			bindFlags: func(fs *flag.FlagSet, r interface{}) {
+				req := r.(*types.CachingRequest)
+				fs.StringVar(&req.SourcePVC, "pvc", req.SourcePVC, "PVC holding the data to cache")
+				fs.StringVar(&req.SourceNamespace, "namespace", req.SourceNamespace, "namespace of the PVC")
+				fs.StringVar(&req.SourceNamespace, "n", req.SourceNamespace, "namespace of the PVC (shorthand)")
+				fs.StringVar(&req.SourcePath, "path", req.SourcePath, "path within the PVC to cache")
+				fs.StringVar((*string)(&req.TargetTier), "tier", string(req.TargetTier), "storage tier: nvme, ssd, hdd or auto")
+				fs.StringVar(&req.CacheSize, "size", req.CacheSize, "maximum cache size, e.g. 100Gi")
+				fs.StringVar((*string)(&req.CachePolicy), "policy", string(req.CachePolicy), "eviction policy: lru, lfu, fifo or ttl")
+				fs.Int64Var(&req.TTLSeconds, "ttl", req.TTLSeconds, "time-to-live in seconds of the ttl policy")
+				int32Var(fs, &req.Priority, "priority", "cache priority, higher is more important")
+				fs.BoolVar(&req.Prefetch, "prefetch", req.Prefetch, "load the data before it is requested")
+				fs.StringVar(&req.Reason, "reason", req.Reason, "reason recorded for auditing")
+			},
+			submit: func(ctx context.Context, c *client.Client, r interface{}) (interface{}, error) {
+				return c.CreateCache(ctx, r.(*types.CachingRequest))
+			},
+		},
+		get: func(ctx context.Context, c *client.Client, ids []string) (interface{}, error) {
+			return c.GetCache(ctx, ids[0])
+		},
+		list: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.ListCaches(ctx)
+		},
+		remove: func(ctx context.Context, c *client.Client, id string) error {
+			return c.DeleteCache(ctx, id)
+		},
+		metrics: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.GetCachingMetrics(ctx)
+		},
+		actions: map[string]*action{
+			"evict":   {usage: "<id> - evict the cached data", run: evictCache},
+			"warmup":  {usage: "<id> [--path p]... [--pattern glob] [--sync] - prefetch data into the cache", run: warmupCache},
+			"migrate": {usage: "<id> --tier <tier> [--reason text] - move the cache to another storage tier", run: migrateCache},
+		},
+	},
+	{
+		name:    "insight",
+		aliases: []string{"signatures", "signature"},
+		idArgs:  []string{"<namespace>", "<pod>"},
+		columns: []column{
+			{header: "NAMESPACE", path: "pod_namespace"},
+			{header: "POD", path: "pod_name"},
+			{header: "NODE", path: "node_name"},
+			{header: "TYPE", path: "workload_type"},
+			{header: "STAGE", path: "current_stage"},
+			{header: "IO PATTERN", path: "io_pattern"},
+			{header: "UPDATED", path: "last_updated", format: formatAge},
+		},
+		get: func(ctx context.Context, c *client.Client, ids []string) (interface{}, error) {
+			return c.GetInsightSignature(ctx, ids[0], ids[1])
+		},
+		list: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.ListInsightSignatures(ctx)
+		},
+		metrics: func(ctx context.Context, c *client.Client) (interface{}, error) {
+			return c.GetInsightMetrics(ctx)
+		},
+	},
+}
+
+// findResource returns the resource named name or one of its aliases
+func findResource(name string) *resource {
+	for _, r := range resources {
+		if r.name == name {
+			return r
+		}
+		for _, alias := range r.aliases {
+			if alias == name {
+				return r
+			}
+		}
+	}
+	return nil
+}
+
+// loadbalancingCreator is shared by "loadbalancing create" and "loadbalancing plan"
+var loadbalancingCreator = &creator{
+	newRequest: func() interface{} { return &types.LoadbalancingRequest{} },
+	bindFlags: func(fs *flag.FlagSet, r interface{}) {
+		req := r.(*types.LoadbalancingRequest)
+		fs.StringVar(&req.Namespace, "namespace", req.Namespace, "only move pods of this namespace (default: all, requires admin)")
+		fs.StringVar(&req.Namespace, "n", req.Namespace, "namespace (shorthand)")
+		fs.Var(&stringList{values: &req.TargetNodes}, "nodes", "comma-separated nodes to balance (default: all)")
+		fs.StringVar(&req.Strategy, "strategy", req.Strategy, "least_loaded, load_spreading, storage_aware, ...")
+		int32Var(fs, &req.CPUThreshold, "cpu-threshold", "CPU percentage above which a node is overloaded")
+		int32Var(fs, &req.MemoryThreshold, "memory-threshold", "memory percentage above which a node is overloaded")
+		int32Var(fs, &req.GPUThreshold, "gpu-threshold", "GPU percentage above which a node is overloaded")
+		int32Var(fs, &req.MaxMigrationsPerCycle, "max-migrations", "maximum migrations per cycle")
+		int32Var(fs, &req.MaxConcurrentMigrations, "max-concurrent", "maximum concurrent migrations of a cycle")
+		int32Var(fs, &req.Interval, "interval", "seconds between cycles (0: run once)")
+		fs.BoolVar(&req.PreservePV, "preserve-pv", req.PreservePV, "checkpoint migrated pods to a PV")
+		fs.BoolVar(&req.DryRun, "dry-run", req.DryRun, "plan without migrating any pod")
+	},
+	submit: func(ctx context.Context, c *client.Client, r interface{}) (interface{}, error) {
+		return c.StartLoadbalancing(ctx, r.(*types.LoadbalancingRequest))
+	},
+}
+
+// planLoadbalancing runs "loadbalancing plan"
+func planLoadbalancing(ctx context.Context, e *env, args []string) error {
+	req, _, err := e.parseRequest("plan", loadbalancingCreator, args)
+	if err != nil {
+		return err
+	}
+	plan, err := e.client.PlanLoadbalancing(ctx, req.(*types.LoadbalancingRequest))
+	if err != nil {
+		return err
+	}
+	if e.printer.format != outputTable {
+		return e.printer.printRaw(plan)
+	}
+	if err := e.printer.printObjects(plan.PlannedMigrations, []column{
+		{header: "NAMESPACE", path: "pod_namespace"},
+		{header: "POD", path: "pod_name"},
+		{header: "SOURCE", path: "source_node"},
+		{header: "TARGET", path: "target_node"},
+		{header: "IMPROVEMENT", path: "estimated_improvement"},
+		{header: "REASON", path: "reason"},
+	}); err != nil {
+		return err
+	}
+	e.printer.printMessage("\nStrategy %s: balance score %.1f -> %.1f",
+		plan.Strategy, plan.ClusterState.BalanceScore, plan.PredictedBalanceScore)
+	return nil
+}
+
+// recommendStorage runs "provisioning recommend <workload-type>"
+func recommendStorage(ctx context.Context, e *env, args []string) error {
+	ids, err := e.parsePositional("recommend", args, 1, "<workload-type>")
+	if err != nil {
+		return err
+	}
+	recommendation, err := e.client.GetProvisioningRecommendation(ctx, ids[0])
+	if err != nil {
+		return err
+	}
+	return e.printer.printObject(recommendation, nil)
+}
+
+// wakeAutoscaler runs "autoscalers wake <id>"
+func wakeAutoscaler(ctx context.Context, e *env, args []string) error {
+	req := &types.WakeRequest{}
+	var queueDepth int64
+	fs := e.flagSet("wake")
+	fs.Int64Var(&queueDepth, "queue-depth", -1, "pending requests in the queue; wakes only at the activation threshold")
+	fs.StringVar(&req.Reason, "reason", "", "reason recorded on the autoscaler")
+	ids, err := e.parse(fs, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	if queueDepth >= 0 {
+		req.QueueDepth = &queueDepth
+	}
+	resp, err := e.client.WakeAutoscaler(ctx, ids[0], req)
+	if err != nil {
+		return err
+	}
+	e.printer.printMessage("autoscaler %s: %s", ids[0], resp.Message)
+	return nil
+}
+
+// previewAutoscalerSchedule runs "autoscalers schedule <id>"
+func previewAutoscalerSchedule(ctx context.Context, e *env, args []string) error {
+	ids, err := e.parsePositional("schedule", args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	preview, err := e.client.PreviewAutoscalerSchedule(ctx, ids[0])
+	if err != nil {
+		return err
+	}
+	if e.printer.format != outputTable {
+		return e.printer.printRaw(preview)
+	}
+	return e.printer.printObjects(preview.Periods, []column{
+		{header: "START", path: "start"},
+		{header: "END", path: "end"},
+		{header: "SCHEDULE", path: "schedule"},
+		{header: "MIN", path: "min_replicas"},
+		{header: "MAX", path: "max_replicas"},
+	})
+}
+
+// evictCache runs "caches evict <id>"
+func evictCache(ctx context.Context, e *env, args []string) error {
+	ids, err := e.parsePositional("evict", args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	if err := e.client.EvictCache(ctx, ids[0]); err != nil {
+		return err
+	}
+	e.printer.printMessage("cache %s eviction started", ids[0])
+	return nil
+}
+
+// warmupCache runs "caches warmup <id>"
+func warmupCache(ctx context.Context, e *env, args []string) error {
+	req := &types.CacheWarmupRequest{}
+	var sync bool
+	fs := e.flagSet("warmup")
+	fs.Var(&stringList{values: &req.Paths}, "path", "path to prefetch (repeatable)")
+	fs.StringVar(&req.Pattern, "pattern", "", "glob of the files to prefetch, e.g. *.tfrecord")
+	fs.BoolVar(&sync, "sync", false, "wait for the warmup to finish on the server")
+	ids, err := e.parse(fs, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	req.CacheID = ids[0]
+	req.Async = !sync
+	if err := e.client.WarmupCache(ctx, ids[0], req); err != nil {
+		return err
+	}
+	e.printer.printMessage("cache %s warmup started", ids[0])
+	return nil
+}
+
+// migrateCache runs "caches migrate <id> --tier <tier>"
+func migrateCache(ctx context.Context, e *env, args []string) error {
+	req := &types.TierMigrationRequest{}
+	fs := e.flagSet("migrate")
+	fs.StringVar((*string)(&req.TargetTier), "tier", "", "target storage tier: nvme, ssd or hdd")
+	fs.StringVar(&req.Reason, "reason", "", "reason recorded for auditing")
+	ids, err := e.parse(fs, args, 1, "<id>")
+	if err != nil {
+		return err
+	}
+	if req.TargetTier == "" {
+		return usageError{"--tier is required"}
+	}
+	req.CacheID = ids[0]
+	if err := e.client.MigrateCacheTier(ctx, ids[0], req); err != nil {
+		return err
+	}
+	e.printer.printMessage("cache %s migration to %s started", ids[0], req.TargetTier)
+	return nil
+}
+
+// usageError is an error in the command line rather than in the API call
+type usageError struct {
+	msg string
+}
+
+func (e usageError) Error() string { return e.msg }
+
+// idUsage returns the usage of the positional arguments identifying one object
+func (r *resource) idUsage() []string {
+	if len(r.idArgs) > 0 {
+		return r.idArgs
+	}
+	return []string{"<id>"}
+}
+
+// verbs returns the subcommands of the resource in help order
+func (r *resource) verbs() []string {
+	var verbs []string
+	if r.create != nil {
+		verbs = append(verbs, "create")
+	}
+	verbs = append(verbs, "get", "list", "watch")
+	if r.remove != nil {
+		verbs = append(verbs, r.removeVerb())
+	}
+	if r.metrics != nil {
+		verbs = append(verbs, "metrics")
+	}
+	for _, name := range sortedKeys(r.actions) {
+		verbs = append(verbs, name)
+	}
+	return verbs
+}
+
+// removeVerb is "cancel" for jobs that roll back and "delete" for long-lived objects
+func (r *resource) removeVerb() string {
+	switch r.name {
+	case "migrations", "loadbalancing":
+		return "cancel"
+	default:
+		return "delete"
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"ai-storage-orchestrator/pkg/client"
+)
+
+// defaultWatchInterval is the polling interval of watch
+const defaultWatchInterval = 2 * time.Second
+
+// finalStatuses end a watch; autoscalers and caches never reach one and are watched until interrupted
+var finalStatuses = map[string]bool{
+	"completed": true,
+	"failed":    true,
+	"cancelled": true,
+	"ready":     true,
+}
+
+// watch polls an object and prints it whenever its status or message changes.
+// A job that ends failed makes the command fail, so scripts can wait on it.
+func (e *env) watch(ctx context.Context, r *resource, ids []string, interval time.Duration) error {
+	var last string
+	header := true
+	for {
+		obj, err := r.get(ctx, e.client, ids)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if client.IsNotFound(err) {
+				return err
+			}
+			// 일시적인 오류는 다음 주기에 다시 시도한다
+			fmt.Fprintf(e.stderr, "warning: %v\n", err)
+		} else {
+			fields, err := toMap(obj)
+			if err != nil {
+				return err
+			}
+			status, _ := fields["status"].(string)
+			message, _ := fields["message"].(string)
+			if state := status + "\x00" + message; state != last {
+				last = state
+				if err := e.printWatchEvent(obj, fields, r.columns, header); err != nil {
+					return err
+				}
+				header = false
+			}
+			if finalStatuses[status] {
+				if status == "failed" {
+					return fmt.Errorf("%s %s failed: %s", r.name, strings.Join(ids, "/"), message)
+				}
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// printWatchEvent prints one change: a table row with the message, or a JSON/YAML document
+func (e *env) printWatchEvent(obj interface{}, fields map[string]interface{}, columns []column, header bool) error {
+	switch e.printer.format {
+	case outputYAML:
+		fmt.Fprintln(e.stdout, "---")
+		return e.printer.printRaw(obj)
+	case outputJSON:
+		return e.printer.printRaw(obj)
+	}
+
+	w := tabwriter.NewWriter(e.stdout, 14, 0, 3, ' ', 0)
+	if header {
+		headers := make([]string, 0, len(columns)+1)
+		for _, col := range columns {
+			headers = append(headers, col.header)
+		}
+		fmt.Fprintln(w, strings.Join(append(headers, "MESSAGE"), "\t"))
+	}
+	fmt.Fprintf(w, "%s\t%s\n", tableRow(fields, columns), formatValue(fields["message"]))
+	return w.Flush()
+}
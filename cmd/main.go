@@ -1,14 +1,29 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log"
+	"net/http"
 	"os"
-	"os/signal"
-	"syscall"
+	"strings"
+	"time"
 
+	apollov1 "ai-storage-orchestrator/api/v1"
 	"ai-storage-orchestrator/pkg/apis"
+	"ai-storage-orchestrator/pkg/audit"
+	"ai-storage-orchestrator/pkg/auth"
 	"ai-storage-orchestrator/pkg/controller"
+	"ai-storage-orchestrator/pkg/eventbus"
 	"ai-storage-orchestrator/pkg/k8s"
+	"ai-storage-orchestrator/pkg/metrics"
+	"ai-storage-orchestrator/pkg/store"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 )
 
 func main() {
@@ -18,6 +33,17 @@ func main() {
 		port = "8080"
 	}
 	kubeconfig := os.Getenv("KUBECONFIG")
+	jobStorePath := os.Getenv("JOB_STORE_PATH") // empty disables persistence
+	auditLogPath := os.Getenv("AUDIT_LOG_PATH") // empty disables the audit trail
+	probeAddr := os.Getenv("PROBE_ADDR")
+	if probeAddr == "" {
+		probeAddr = ":8081"
+	}
+	leaderElect := os.Getenv("LEADER_ELECT") != "false"
+	leaderElectionNamespace := os.Getenv("POD_NAMESPACE")
+	if leaderElectionNamespace == "" {
+		leaderElectionNamespace = "kube-system"
+	}
 
 	log.Println("Starting AI Storage Orchestrator...")
 	// Initialize Kubernetes client
@@ -25,10 +51,34 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to create Kubernetes client: %v", err)
 	}
+	defer k8sClient.EventRecorder().Shutdown() // flush queued events on shutdown
 	log.Println("Kubernetes client initialized successfully")
 
+	// Select the metrics provider (METRICS_PROVIDER, PROMETHEUS_URL, ...)
+	metricsConfig, err := metrics.ConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid metrics provider configuration: %v", err)
+	}
+	metricsProvider, err := metrics.New(metricsConfig, k8sClient.MetricsClientset())
+	if err != nil {
+		log.Fatalf("Failed to create metrics provider: %v", err)
+	}
+	k8sClient.SetMetricsProvider(metricsProvider)
+	log.Printf("Metrics provider: %s", metricsProvider.Name())
+	// 빈 값이면 GPU 사용률 수집 비활성화 (GPU 메트릭은 unavailable로 보고)
+	if dcgmURL, ok := os.LookupEnv("DCGM_EXPORTER_URL"); ok {
+		k8sClient.SetDCGMExporterURL(dcgmURL)
+		log.Printf("DCGM exporter endpoint: %q", dcgmURL)
+	}
+
 	// Initialize migration controller
 	migrationController := controller.NewMigrationController(k8sClient)
+	if checkpointRegistry := os.Getenv("CHECKPOINT_REGISTRY"); checkpointRegistry != "" {
+		migrationController.SetCheckpointRegistry(checkpointRegistry, leaderElectionNamespace, os.Getenv("CHECKPOINT_REGISTRY_SECRET"))
+		log.Printf("Container checkpoint images will be pushed to %s", checkpointRegistry)
+	} else {
+		log.Println("Container checkpointing disabled (CHECKPOINT_REGISTRY not set); migrated containers restart on the target node")
+	}
 	log.Println("Migration controller initialized")
 
 	// Initialize autoscaling controller
@@ -41,6 +91,14 @@ func main() {
 
 	// Initialize provisioning controller
 	provisioningController := controller.NewProvisioningController(k8sClient)
+	if storageClassMap := os.Getenv("STORAGE_CLASS_MAP"); storageClassMap != "" {
+		classes, err := controller.ParseStorageClassMap(storageClassMap)
+		if err != nil {
+			log.Fatalf("Invalid STORAGE_CLASS_MAP: %v", err)
+		}
+		provisioningController.SetStorageClassMap(classes)
+		log.Printf("Storage class mapping: %v", classes)
+	}
 	log.Println("Provisioning controller initialized")
 
 	// Initialize preemption controller
@@ -49,28 +107,173 @@ func main() {
 
 	// Initialize caching controller (글로벌 캐싱)
 	cachingController := controller.NewCachingController(k8sClient)
+	cachingController.SetMetricsProvider(metricsProvider)
 	log.Println("Caching controller initialized")
 
 	// Initialize insight controller (워크로드 시그니처 수집)
 	insightController := controller.NewInsightController()
 	log.Println("Insight controller initialized")
 
+	// Job progress event bus, streamed by GET /api/v1/events
+	eventBus := eventbus.NewBus(eventbus.DefaultHistorySize)
+	migrationController.SetEventBus(eventBus)
+	autoscalingController.SetEventBus(eventBus)
+	loadbalancingController.SetEventBus(eventBus)
+	provisioningController.SetEventBus(eventBus)
+	preemptionController.SetEventBus(eventBus)
+
+	// Initialize the audit trail before jobs are restored, so interrupted migrations are recorded
+	var auditLog *audit.FileLog
+	if auditLogPath != "" {
+		var err error
+		if auditLog, err = audit.NewFileLog(auditLogPath); err != nil {
+			log.Fatalf("Failed to open audit log: %v", err)
+		}
+		defer auditLog.Close()
+		if os.Getenv("AUDIT_EVENTS") == "true" {
+			auditLog.SetEventSink(audit.NewKubernetesEventSink(k8sClient.EventRecorder()))
+		}
+		migrationController.SetAuditLog(auditLog)
+		preemptionController.SetAuditLog(auditLog)
+		log.Printf("Audit log opened at %s", auditLogPath)
+	} else {
+		log.Println("AUDIT_LOG_PATH not set, mutating API calls will not be audited")
+	}
+
+	// Initialize persistent job store and restore jobs from the previous run
+	jobStore := store.NewNopStore()
+	if jobStorePath != "" {
+		boltStore, err := store.NewBoltStore(jobStorePath)
+		if err != nil {
+			log.Fatalf("Failed to open job store: %v", err)
+		}
+		jobStore = boltStore
+		log.Printf("Job store opened at %s", jobStorePath)
+	} else {
+		log.Println("JOB_STORE_PATH not set, jobs will not survive restarts")
+	}
+	defer jobStore.Close()
+
+	restorers := []interface {
+		SetJobStore(store.JobStore)
+		RestoreJobs() error
+	}{
+		migrationController, autoscalingController, loadbalancingController,
+		provisioningController, preemptionController, cachingController, insightController,
+	}
+	// Webhook notifications of job events (작업 상태 전이를 외부 웹훅으로 전달)
+	webhookController := controller.NewWebhookController()
+	restorers = append(restorers, webhookController)
+
+	for _, r := range restorers {
+		r.SetJobStore(jobStore)
+		if err := r.RestoreJobs(); err != nil {
+			log.Printf("Warning: %v", err)
+		}
+	}
+
+	// Initialize controller-runtime manager for CRD controllers
+	// (StorageHPA, PodMigration, LoadbalancingPolicy, PreemptionRequest, StorageProvisioning, DataCache)
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		log.Fatalf("Failed to register client-go scheme: %v", err)
+	}
+	if err := apollov1.AddToScheme(scheme); err != nil {
+		log.Fatalf("Failed to register apollo.keti.re.kr/v1 scheme: %v", err)
+	}
+
+	mgr, err := ctrl.NewManager(k8sClient.RestConfig(), ctrl.Options{
+		Scheme: scheme,
+		// Orchestrator metrics are served by the REST API, not by the manager
+		Metrics:                       metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress:        probeAddr,
+		LeaderElection:                leaderElect,
+		LeaderElectionID:              "ai-storage-orchestrator.apollo.keti.re.kr",
+		LeaderElectionNamespace:       leaderElectionNamespace,
+		LeaderElectionReleaseOnCancel: true,
+	})
+	if err != nil {
+		log.Fatalf("Failed to create controller manager: %v", err)
+	}
+
+	// LoadbalancingPolicy/PreemptionRequest는 기본적으로 자신의 네임스페이스만 대상으로 함
+	// 다른 네임스페이스나 전체("*")를 지정할 수 있는 리소스의 네임스페이스 목록 (쉼표 구분)
+	// StorageHPA 커스텀 메트릭(임의 PromQL)도 이 네임스페이스에서만 평가됨
+	storageHPAReconciler := controller.NewStorageHPAReconciler(mgr.GetClient(), mgr.GetScheme(), k8sClient)
+	loadbalancingPolicyReconciler := controller.NewLoadbalancingPolicyReconciler(mgr.GetClient(), mgr.GetScheme(), loadbalancingController)
+	preemptionRequestReconciler := controller.NewPreemptionRequestReconciler(mgr.GetClient(), mgr.GetScheme(), preemptionController)
+	if crossNamespace := os.Getenv("CROSS_NAMESPACE_JOB_NAMESPACES"); crossNamespace != "" {
+		namespaces := strings.Split(crossNamespace, ",")
+		loadbalancingPolicyReconciler.AllowCrossNamespace(namespaces)
+		preemptionRequestReconciler.AllowCrossNamespace(namespaces)
+		storageHPAReconciler.AllowCrossNamespace(namespaces)
+		log.Printf("LoadbalancingPolicy/PreemptionRequest in %s may target other namespaces, StorageHPAs may use custom metrics", crossNamespace)
+	}
+
+	reconcilers := map[string]interface {
+		SetupWithManager(ctrl.Manager) error
+	}{
+		"StorageHPA":          storageHPAReconciler,
+		"PodMigration":        controller.NewPodMigrationReconciler(mgr.GetClient(), mgr.GetScheme(), migrationController),
+		"LoadbalancingPolicy": loadbalancingPolicyReconciler,
+		"PreemptionRequest":   preemptionRequestReconciler,
+		"StorageProvisioning": controller.NewStorageProvisioningReconciler(mgr.GetClient(), mgr.GetScheme(), provisioningController),
+		"DataCache":           controller.NewDataCacheReconciler(mgr.GetClient(), mgr.GetScheme(), cachingController),
+	}
+	for kind, r := range reconcilers {
+		if err := r.SetupWithManager(mgr); err != nil {
+			log.Fatalf("Failed to set up %s controller: %v", kind, err)
+		}
+	}
+	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		log.Fatalf("Failed to set up health check: %v", err)
+	}
+	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+		log.Fatalf("Failed to set up ready check: %v", err)
+	}
+	log.Printf("CRD controllers registered (leader election: %v, probes: %s)", leaderElect, probeAddr)
+
 	// Initialize HTTP API handler
 	apiHandler := apis.NewHandler(migrationController, autoscalingController, loadbalancingController, provisioningController, preemptionController, cachingController, insightController)
+
+	// REST API authentication (AUTH_MODE, AUTH_TOKEN_FILE, AUTH_AUDIENCES)
+	authProvider, err := auth.New(auth.ConfigFromEnv(), k8sClient.Clientset())
+	if err != nil {
+		log.Fatalf("Invalid auth configuration: %v", err)
+	}
+	if authProvider != nil {
+		apiHandler.SetAuthProvider(authProvider)
+		log.Printf("REST API authentication enabled: %s", authProvider.Name())
+	} else {
+		log.Println("Warning: AUTH_MODE not set, REST API authentication is disabled")
+	}
+	if auditLog != nil {
+		apiHandler.SetAuditLog(auditLog)
+	}
+	apiHandler.SetEventBus(eventBus)
+	apiHandler.SetWebhookController(webhookController)
+	if origins := os.Getenv("CORS_ALLOWED_ORIGINS"); origins != "" {
+		apiHandler.SetAllowedOrigins(strings.Split(origins, ","))
+	}
 	router := apiHandler.SetupRoutes()
 
 	log.Printf("HTTP server starting on port %s", port)
 	log.Println("Available endpoints:")
 	log.Println("  POST   /api/v1/migrations - Start new pod migration")
+	log.Println("  GET    /api/v1/migrations - List all migrations")
 	log.Println("  GET    /api/v1/migrations/:id - Get migration details")
 	log.Println("  GET    /api/v1/migrations/:id/status - Get migration status")
+	log.Println("  DELETE /api/v1/migrations/:id - Cancel migration and roll back")
 	log.Println("  GET    /api/v1/metrics - Get migration metrics")
 	log.Println("  POST   /api/v1/autoscaling - Create autoscaler")
 	log.Println("  GET    /api/v1/autoscaling/:id - Get autoscaler details")
 	log.Println("  DELETE /api/v1/autoscaling/:id - Delete autoscaler")
+	log.Println("  POST   /api/v1/autoscaling/:id/wake - Wake an autoscaler scaled to zero")
+	log.Println("  GET    /api/v1/autoscaling/:id/schedule - Preview scheduled replica bounds (24h)")
 	log.Println("  GET    /api/v1/autoscaling - List all autoscalers")
 	log.Println("  GET    /api/v1/autoscaling/metrics - Get autoscaling metrics")
 	log.Println("  POST   /api/v1/loadbalancing - Start loadbalancing job")
+	log.Println("  POST   /api/v1/loadbalancing/plan - Preview loadbalancing plan (dry run)")
 	log.Println("  GET    /api/v1/loadbalancing/:id - Get loadbalancing details")
 	log.Println("  DELETE /api/v1/loadbalancing/:id - Cancel loadbalancing job")
 	log.Println("  GET    /api/v1/loadbalancing - List all loadbalancing jobs")
@@ -98,23 +301,62 @@ func main() {
 	log.Println("  GET    /api/v1/insight/signatures - List all workload signatures")
 	log.Println("  GET    /api/v1/insight/signatures/:namespace/:name - Get specific signature")
 	log.Println("  GET    /api/v1/insight/metrics - Get insight metrics")
+	log.Println("  GET    /api/v1/audit - Query audit log")
+	log.Println("  GET    /api/v1/events - Stream job progress (Server-Sent Events)")
+	log.Println("  POST   /api/v1/webhooks - Register webhook notification target")
+	log.Println("  GET    /api/v1/webhooks - List webhooks")
+	log.Println("  GET    /api/v1/webhooks/:id - Get webhook details")
+	log.Println("  PUT    /api/v1/webhooks/:id - Update webhook")
+	log.Println("  DELETE /api/v1/webhooks/:id - Delete webhook")
+	log.Println("  GET    /api/v1/webhooks/deadletters - List failed webhook deliveries")
+	log.Println("  GET    /metrics - Prometheus metrics")
 	log.Println("  GET    /health - Health check")
 
-	// Setup graceful shutdown
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	// Setup graceful shutdown: SIGINT/SIGTERM or a failure of either server
+	// stops both the HTTP server and the controller manager
+	ctx, cancel := context.WithCancel(ctrl.SetupSignalHandler())
+	defer cancel()
+
+	server := &http.Server{
+		Addr:    ":" + port,
+		Handler: router,
+	}
+	// Event streams never finish on their own, end them so Shutdown does not wait for them
+	server.RegisterOnShutdown(eventBus.Close)
+
+	// Deliver job events to the registered webhooks
+	go webhookController.Run(ctx, eventBus)
 
 	// Start server in goroutine
 	go func() {
-		if err := router.Run(":" + port); err != nil {
-			log.Fatalf("Failed to start HTTP server: %v", err)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("HTTP server failed: %v", err)
+			cancel()
+		}
+	}()
+
+	// Start controller manager in goroutine
+	mgrDone := make(chan struct{})
+	go func() {
+		defer close(mgrDone)
+		if err := mgr.Start(ctx); err != nil {
+			log.Printf("Controller manager failed: %v", err)
+			cancel()
 		}
 	}()
 
 	log.Printf("AI Storage Orchestrator is ready to handle migration requests")
 
 	// Wait for interrupt signal
-	<-quit
+	<-ctx.Done()
 	log.Println("Shutting down AI Storage Orchestrator...")
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer shutdownCancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("HTTP server shutdown error: %v", err)
+	}
+	<-mgrDone
+
 	log.Println("Graceful shutdown completed")
 }
\ No newline at end of file
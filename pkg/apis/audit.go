@@ -0,0 +1,243 @@
+package apis
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"ai-storage-orchestrator/pkg/audit"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// maxAuditBodyBytes bounds the request body stored in an audit entry
+	maxAuditBodyBytes = 64 * 1024
+	// maxAuditQueryLimit bounds the number of entries returned by GET /api/v1/audit
+	maxAuditQueryLimit = 1000
+)
+
+// auditActions names the audited routes; read-only and high-volume routes are not audited
+var auditActions = map[string]string{
+	"POST /api/v1/migrations":           "migration.create",
+	"DELETE /api/v1/migrations/:id":     "migration.cancel",
+	"POST /api/v1/autoscaling":          "autoscaling.create",
+	"DELETE /api/v1/autoscaling/:id":    "autoscaling.delete",
+	"POST /api/v1/autoscaling/:id/wake": "autoscaling.wake",
+	"POST /api/v1/loadbalancing":        "loadbalancing.create",
+	"DELETE /api/v1/loadbalancing/:id":  "loadbalancing.cancel",
+	"POST /api/v1/provisioning":         "provisioning.create",
+	"DELETE /api/v1/provisioning/:id":   "provisioning.delete",
+	"POST /api/v1/preemption":           "preemption.create",
+	"POST /api/v1/caching":              "caching.create",
+	"DELETE /api/v1/caching/:id":        "caching.delete",
+	"POST /api/v1/caching/:id/evict":    "caching.evict",
+	"POST /api/v1/caching/:id/warmup":   "caching.warmup",
+	"POST /api/v1/caching/:id/migrate":  "caching.migrate",
+	"POST /api/v1/caching/policy":       "caching.policy",
+	"POST /api/v1/webhooks":             "webhook.create",
+	"PUT /api/v1/webhooks/:id":          "webhook.update",
+	"DELETE /api/v1/webhooks/:id":       "webhook.delete",
+}
+
+// jobIDFields are the response fields that carry the ID of a created job
+var jobIDFields = []string{"migration_id", "autoscaling_id", "loadbalancing_id", "provisioning_id", "preemption_id", "cache_id", "webhook_id"}
+
+// redactedFields are never written to the audit log
+var redactedFields = []string{"secret", "token", "password"}
+
+// namespaceKey is the gin context key of the namespace resolved by authorize
+const namespaceKey = "namespace"
+
+// recordingWriter keeps a copy of the response body for the audit entry
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	if w.body.Len() < maxAuditBodyBytes {
+		w.body.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// auditTrail records every mutating API call, including rejected ones, in the audit log
+func (h *Handler) auditTrail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		action, audited := auditActions[c.Request.Method+" "+c.FullPath()]
+		if h.auditLog == nil || !audited {
+			c.Next()
+			return
+		}
+
+		var body []byte
+		if c.Request.Body != nil {
+			body, _ = io.ReadAll(c.Request.Body)
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		writer := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		c.Next()
+
+		entry := &audit.Entry{
+			Actor:      "anonymous",
+			Action:     action,
+			Method:     c.Request.Method,
+			Endpoint:   c.Request.URL.Path,
+			Namespace:  c.GetString(namespaceKey),
+			JobID:      c.Param("id"),
+			SourceAddr: c.ClientIP(),
+			StatusCode: c.Writer.Status(),
+		}
+		if id := identityFrom(c); id != nil {
+			entry.Actor = id.Name
+			entry.Groups = id.Groups
+			entry.Provider = id.Provider
+		}
+
+		fields := map[string]interface{}{}
+		if len(body) > 0 && len(body) <= maxAuditBodyBytes && json.Unmarshal(body, &fields) == nil {
+			redact(fields)
+			entry.Request, _ = json.Marshal(fields)
+			entry.Reason, _ = fields["reason"].(string)
+			entry.Targets = requestTargets(fields)
+		}
+
+		response := map[string]interface{}{}
+		_ = json.Unmarshal(writer.body.Bytes(), &response)
+		if entry.JobID == "" {
+			for _, field := range jobIDFields {
+				if id, ok := response[field].(string); ok {
+					entry.JobID = id
+					break
+				}
+			}
+		}
+
+		switch status := entry.StatusCode; {
+		case status == http.StatusUnauthorized || status == http.StatusForbidden:
+			entry.Outcome = audit.OutcomeDenied
+		case status >= http.StatusBadRequest:
+			entry.Outcome = audit.OutcomeFailure
+		default:
+			entry.Outcome = audit.OutcomeSuccess
+		}
+		if msg, ok := response["error"].(string); ok {
+			entry.Message = msg
+			if details, ok := response["details"].(string); ok {
+				entry.Message += ": " + details
+			}
+		} else if msg, ok := response["message"].(string); ok {
+			entry.Message = msg
+		}
+
+		if err := h.auditLog.Record(entry); err != nil {
+			log.Printf("Warning: Failed to record audit entry for %s: %v", action, err)
+		}
+	}
+}
+
+// requestTargets extracts the pods, PVCs, workloads and nodes named in a request body
+func requestTargets(fields map[string]interface{}) []audit.Target {
+	str := func(key string) string {
+		s, _ := fields[key].(string)
+		return s
+	}
+
+	var targets []audit.Target
+	if name, ns := str("pod_name"), str("pod_namespace"); name != "" && ns != "" {
+		targets = append(targets, audit.Target{Kind: "Pod", Namespace: ns, Name: name})
+	}
+	if name, ns := str("source_pvc"), str("source_namespace"); name != "" && ns != "" {
+		targets = append(targets, audit.Target{Kind: "PersistentVolumeClaim", Namespace: ns, Name: name})
+	}
+	if name, ns := str("workload_name"), str("workload_namespace"); name != "" && ns != "" {
+		switch kind := str("workload_type"); kind {
+		case "Deployment", "StatefulSet":
+			targets = append(targets, audit.Target{Kind: kind, Namespace: ns, Name: name})
+		}
+	}
+	if node := str("node_name"); node != "" {
+		targets = append(targets, audit.Target{Kind: "Node", Name: node})
+	}
+	return targets
+}
+
+// redact masks credential fields (e.g. webhook secrets) before a body is logged
+func redact(fields map[string]interface{}) {
+	for key, value := range fields {
+		lower := strings.ToLower(key)
+		for _, sensitive := range redactedFields {
+			if strings.Contains(lower, sensitive) {
+				fields[key] = "[REDACTED]"
+				break
+			}
+		}
+		if nested, ok := value.(map[string]interface{}); ok && fields[key] != "[REDACTED]" {
+			redact(nested)
+		}
+	}
+}
+
+// queryAudit handles GET /api/v1/audit
+func (h *Handler) queryAudit(c *gin.Context) {
+	if h.auditLog == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"error":   "Audit log is not enabled",
+			"details": "set AUDIT_LOG_PATH to record and query the audit trail",
+		})
+		return
+	}
+
+	filter := audit.Filter{
+		Namespace: c.Query("namespace"),
+		Action:    c.Query("action"),
+		Actor:     c.Query("actor"),
+		JobID:     c.Query("job_id"),
+	}
+	for param, dest := range map[string]*time.Time{"since": &filter.Since, "until": &filter.Until} {
+		if value := c.Query(param); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"error":   "Invalid " + param + " parameter",
+					"details": err.Error(),
+				})
+				return
+			}
+			*dest = t
+		}
+	}
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 || limit > maxAuditQueryLimit {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid limit parameter",
+				"details": "limit must be between 1 and " + strconv.Itoa(maxAuditQueryLimit),
+			})
+			return
+		}
+		filter.Limit = limit
+	}
+
+	entries, err := h.auditLog.Query(filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to query audit log",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"entries": entries,
+		"count":   len(entries),
+	})
+}
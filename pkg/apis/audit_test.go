@@ -0,0 +1,90 @@
+package apis
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"ai-storage-orchestrator/pkg/audit"
+	"ai-storage-orchestrator/pkg/auth"
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuditTrail tests that accepted and denied mutating calls are recorded with actor, targets and job ID
+func TestAuditTrail(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	provider, err := auth.NewStaticTokenProvider([]auth.StaticToken{
+		{Name: "team-a", Token: "team-a-token", Role: auth.RoleOperator, Namespaces: []string{"team-a"}},
+	})
+	require.NoError(t, err)
+	auditLog, err := audit.NewFileLog(filepath.Join(t.TempDir(), "audit.jsonl"))
+	require.NoError(t, err)
+	defer auditLog.Close()
+
+	h := &Handler{}
+	h.SetAuthProvider(provider)
+	h.SetAuditLog(auditLog)
+
+	router := gin.New()
+	v1 := router.Group("/api/v1", h.auditTrail(), h.authenticate())
+	v1.POST("/migrations", h.authorize(access{role: auth.RoleOperator, namespace: bodyNamespace(func(r *types.MigrationRequest) string { return r.PodNamespace })}),
+		func(c *gin.Context) {
+			c.JSON(http.StatusAccepted, gin.H{"migration_id": "migration-1234", "status": "pending"})
+		})
+	v1.POST("/autoscaling/:id/wake", h.authorize(access{role: auth.RoleOperator}), func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"autoscaling_id": c.Param("id"), "status": "active"})
+	})
+	v1.GET("/audit", h.authorize(access{role: auth.RoleViewer, allNamespacesRole: auth.RoleAdmin, namespace: queryNamespace("namespace")}), h.queryAudit)
+
+	post := func(body string) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/migrations", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer team-a-token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+	assert.Equal(t, http.StatusAccepted, post(`{"pod_name":"trainer-0","pod_namespace":"team-a","source_node":"n1","target_node":"n2"}`))
+	assert.Equal(t, http.StatusForbidden, post(`{"pod_name":"web-0","pod_namespace":"team-b","source_node":"n1","target_node":"n2"}`))
+
+	entries, err := auditLog.Query(audit.Filter{Action: "migration.create"})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	denied, accepted := entries[0], entries[1]
+	assert.Equal(t, audit.OutcomeDenied, denied.Outcome)
+	assert.Equal(t, "team-b", denied.Namespace)
+	assert.Equal(t, audit.OutcomeSuccess, accepted.Outcome)
+	assert.Equal(t, "team-a", accepted.Actor)
+	assert.Equal(t, "migration-1234", accepted.JobID)
+	assert.Equal(t, []audit.Target{{Kind: "Pod", Namespace: "team-a", Name: "trainer-0"}}, accepted.Targets)
+	assert.Contains(t, string(accepted.Request), `"pod_name":"trainer-0"`)
+
+	// Waking a workload scaled to zero is audited
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/autoscaling/autoscaler-1234/wake", strings.NewReader(`{"queue_depth":8}`))
+	req.Header.Set("Authorization", "Bearer team-a-token")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+	entries, err = auditLog.Query(audit.Filter{Action: "autoscaling.wake"})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "autoscaler-1234", entries[0].JobID)
+
+	// Namespaced tokens can only query their own namespace
+	query := func(url string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set("Authorization", "Bearer team-a-token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	assert.Equal(t, http.StatusForbidden, query("/api/v1/audit").Code)
+	w := query("/api/v1/audit?namespace=team-a&action=migration.create")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"count":1`)
+}
@@ -0,0 +1,293 @@
+package apis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"ai-storage-orchestrator/pkg/auth"
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+const (
+	// identityKey is the gin context key of the authenticated *auth.Identity
+	identityKey = "identity"
+
+	// requestKey is the gin context key of the request body bound by bodyNamespace
+	requestKey = "request"
+)
+
+// access describes who may call a route
+type access struct {
+	// role is required in the namespace the request targets
+	role auth.Role
+	// allNamespacesRole, when set, is required instead of role if the request targets all namespaces
+	allNamespacesRole auth.Role
+	// namespace resolves the namespace the request targets (nil: cluster scope)
+	namespace func(c *gin.Context) (string, error)
+	// allNamespaces, when set, reports whether the request can reach data beyond its namespace
+	// (e.g. raw PromQL); such requests require allNamespacesRole in all namespaces
+	allNamespaces func(c *gin.Context) bool
+}
+
+// authenticate resolves the bearer token of the request into an identity.
+// Without an auth provider every request is let through.
+func (h *Handler) authenticate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if h.authProvider == nil {
+			c.Next()
+			return
+		}
+
+		token := bearerToken(c.Request)
+		if token == "" {
+			c.Header("WWW-Authenticate", `Bearer realm="ai-storage-orchestrator"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error":   "Unauthorized",
+				"details": "missing bearer token",
+			})
+			return
+		}
+
+		id, err := h.authProvider.Authenticate(c.Request.Context(), token)
+		if err != nil {
+			if auth.IsUnauthenticated(err) {
+				c.Header("WWW-Authenticate", `Bearer realm="ai-storage-orchestrator", error="invalid_token"`)
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+					"error":   "Unauthorized",
+					"details": err.Error(),
+				})
+				return
+			}
+			log.Printf("Warning: Authentication failed: %v", err)
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+				"error":   "Authentication unavailable",
+				"details": err.Error(),
+			})
+			return
+		}
+
+		c.Set(identityKey, id)
+		c.Next()
+	}
+}
+
+// authorize checks that the authenticated identity holds the role of the route
+// in the namespace the request targets
+func (h *Handler) authorize(a access) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// The namespace is resolved even without auth so the audit trail can record it
+		namespace := ""
+		if a.namespace != nil {
+			var err error
+			if namespace, err = a.namespace(c); err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+					"error":   "Invalid request format",
+					"details": err.Error(),
+				})
+				return
+			}
+		}
+		c.Set(namespaceKey, namespace)
+
+		if h.authProvider == nil {
+			c.Next()
+			return
+		}
+
+		id := identityFrom(c)
+		if id == nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error":   "Unauthorized",
+				"details": "request is not authenticated",
+			})
+			return
+		}
+
+		role := a.role
+		if a.allNamespaces != nil && a.allNamespaces(c) {
+			namespace = ""
+		}
+		if namespace == "" && a.allNamespacesRole != "" {
+			role = a.allNamespacesRole
+		}
+
+		allowed, err := h.authProvider.Authorize(c.Request.Context(), id, role, namespace)
+		if err != nil {
+			log.Printf("Warning: Authorization of %s failed: %v", id.Name, err)
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+				"error":   "Authorization unavailable",
+				"details": err.Error(),
+			})
+			return
+		}
+		if !allowed {
+			scope := "all namespaces"
+			if namespace != "" {
+				scope = "namespace " + namespace
+			}
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error":   "Forbidden",
+				"details": fmt.Sprintf("%s does not have the %s role in %s", id.Name, role, scope),
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// identityFrom returns the identity set by authenticate, or nil when auth is disabled
+func identityFrom(c *gin.Context) *auth.Identity {
+	v, ok := c.Get(identityKey)
+	if !ok {
+		return nil
+	}
+	id, _ := v.(*auth.Identity)
+	return id
+}
+
+// bearerToken extracts the token of an "Authorization: Bearer" header
+func bearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if len(header) < 7 || !strings.EqualFold(header[:7], "bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(header[7:])
+}
+
+// bodyNamespace decodes the JSON body into the request type of the route and reads the namespace from
+// the decoded value. The handler takes the same value with bindRequest, so authorization and the
+// handler always act on the same namespace.
+func bodyNamespace[T any](namespace func(req *T) string) func(c *gin.Context) (string, error) {
+	return func(c *gin.Context) (string, error) {
+		req := new(T)
+		if err := decodeBody(c, req); err != nil {
+			return "", err
+		}
+		c.Set(requestKey, req)
+		return namespace(req), nil
+	}
+}
+
+// bindRequest returns the request body decoded by authorize, or decodes it when the route did not,
+// and validates its binding tags like ShouldBindJSON
+func bindRequest[T any](c *gin.Context) (*T, error) {
+	req, ok := c.Value(requestKey).(*T)
+	if !ok {
+		req = new(T)
+		if err := decodeBody(c, req); err != nil {
+			return nil, err
+		}
+	}
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// decodeBody decodes a JSON body; the body is restored for the audit trail.
+// 중복 키나 대소문자만 다른 키는 encoding/json이 마지막 값을 조용히 사용하므로 거부한다.
+func decodeBody(c *gin.Context, obj interface{}) error {
+	var raw []byte
+	if c.Request.Body != nil {
+		var err error
+		if raw, err = io.ReadAll(c.Request.Body); err != nil {
+			return fmt.Errorf("failed to read request body: %w", err)
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(raw))
+	}
+	if len(bytes.TrimSpace(raw)) > 0 {
+		if err := checkJSONKeys(json.NewDecoder(bytes.NewReader(raw))); err != nil {
+			return err
+		}
+	}
+	if err := json.NewDecoder(bytes.NewReader(raw)).Decode(obj); err != nil {
+		return fmt.Errorf("invalid JSON body: %w", err)
+	}
+	return nil
+}
+
+// checkJSONKeys reads one JSON value and rejects objects with keys that are equal ignoring case
+func checkJSONKeys(dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("invalid JSON body: %w", err)
+	}
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return nil
+	}
+	switch delim {
+	case '{':
+		seen := map[string]string{}
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return fmt.Errorf("invalid JSON body: %w", err)
+			}
+			key, _ := tok.(string)
+			// encoding/json의 키 매칭과 같이 유니코드 대소문자 변형(K/K, s/ſ)도 같은 키로 취급
+			folded := strings.ToLower(strings.ToUpper(key))
+			if previous, ok := seen[folded]; ok {
+				return fmt.Errorf("duplicate key %q in JSON body (also given as %q)", key, previous)
+			}
+			seen[folded] = key
+			if err := checkJSONKeys(dec); err != nil {
+				return err
+			}
+		}
+	case '[':
+		for dec.More() {
+			if err := checkJSONKeys(dec); err != nil {
+				return err
+			}
+		}
+	default:
+		return nil
+	}
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("invalid JSON body: %w", err)
+	}
+	return nil
+}
+
+// paramNamespace reads the namespace from a path parameter
+func paramNamespace(name string) func(c *gin.Context) (string, error) {
+	return func(c *gin.Context) (string, error) {
+		return c.Param(name), nil
+	}
+}
+
+// queryNamespace reads the namespace from a query parameter
+func queryNamespace(name string) func(c *gin.Context) (string, error) {
+	return func(c *gin.Context) (string, error) {
+		return c.Query(name), nil
+	}
+}
+
+// customMetricsQuery reports whether an autoscaling request runs raw PromQL. A query is not bound to
+// the workload namespace, so custom metrics are restricted to callers allowed in all namespaces.
+func customMetricsQuery(c *gin.Context) bool {
+	req, ok := c.Value(requestKey).(*types.AutoscalingRequest)
+	return ok && len(req.CustomMetrics) > 0
+}
+
+// jobNamespace looks up the namespace of the job named by the :id path parameter.
+// Unknown jobs resolve to cluster scope, so only cluster-wide callers learn that they do not exist.
+func jobNamespace(lookup func(id string) (string, error)) func(c *gin.Context) (string, error) {
+	return func(c *gin.Context) (string, error) {
+		namespace, err := lookup(c.Param("id"))
+		if err != nil {
+			return "", nil
+		}
+		return namespace, nil
+	}
+}
@@ -0,0 +1,118 @@
+package apis
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ai-storage-orchestrator/pkg/auth"
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// namespacedRequest is a request body scoped by its namespace field
+type namespacedRequest struct {
+	Namespace string            `json:"namespace"`
+	Target    map[string]string `json:"target,omitempty"`
+}
+
+// TestAuthMiddleware tests bearer token checks and namespace scoping from the request body
+func TestAuthMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	provider, err := auth.NewStaticTokenProvider([]auth.StaticToken{
+		{Name: "team-a", Token: "team-a-token", Role: auth.RoleOperator, Namespaces: []string{"team-a"}},
+		{Name: "operator", Token: "operator-token", Role: auth.RoleOperator},
+	})
+	require.NoError(t, err)
+
+	h := &Handler{}
+	h.SetAuthProvider(provider)
+
+	router := gin.New()
+	api := router.Group("/api", h.authenticate())
+	api.POST("/preemption", h.authorize(access{role: auth.RoleOperator, allNamespacesRole: auth.RoleAdmin,
+		namespace: bodyNamespace(func(r *namespacedRequest) string { return r.Namespace })}),
+		func(c *gin.Context) {
+			// The handler acts on the request authorization was checked against
+			req, err := bindRequest[namespacedRequest](c)
+			require.NoError(t, err)
+			c.String(http.StatusOK, req.Namespace)
+		})
+
+	call := func(token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/preemption", strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, call("", `{"namespace":"team-a"}`).Code)
+	assert.Equal(t, http.StatusUnauthorized, call("bogus", `{"namespace":"team-a"}`).Code)
+
+	w := call("team-a-token", `{"namespace":"team-a"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "team-a", w.Body.String())
+
+	assert.Equal(t, http.StatusForbidden, call("team-a-token", `{"namespace":"team-b"}`).Code)
+	assert.Equal(t, http.StatusForbidden, call("team-a-token", `{}`).Code)
+
+	// All-namespace preemption requires admin even for cluster-wide operators
+	assert.Equal(t, http.StatusOK, call("operator-token", `{"namespace":"team-b"}`).Code)
+	assert.Equal(t, http.StatusForbidden, call("operator-token", `{}`).Code)
+
+	// Duplicate and case-variant keys would let the handler see another namespace than authorization
+	for _, body := range []string{
+		`{"namespace":"team-a","namespace":"team-b"}`,
+		`{"namespace":"team-a","Namespace":"team-b"}`,
+		`{"namespace":"team-a","NAMESPACE":"team-b"}`,
+		`{"namespace":"team-a","target":{"node":"n1","Node":"n2"}}`,
+		`{"namespace":"team-a"`,
+	} {
+		w := call("team-a-token", body)
+		assert.Equal(t, http.StatusBadRequest, w.Code, body)
+	}
+	assert.Contains(t, call("team-a-token", `{"namespace":"team-a","Namespace":"team-b"}`).Body.String(), "duplicate key")
+}
+
+// TestAuthCustomMetricsRequireAdmin tests that raw PromQL custom metrics need the admin role in all namespaces
+func TestAuthCustomMetricsRequireAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	provider, err := auth.NewStaticTokenProvider([]auth.StaticToken{
+		{Name: "team-a", Token: "team-a-token", Role: auth.RoleAdmin, Namespaces: []string{"team-a"}},
+		{Name: "admin", Token: "admin-token", Role: auth.RoleAdmin},
+	})
+	require.NoError(t, err)
+
+	h := &Handler{}
+	h.SetAuthProvider(provider)
+
+	router := gin.New()
+	api := router.Group("/api", h.authenticate())
+	api.POST("/autoscaling", h.authorize(access{role: auth.RoleOperator, allNamespacesRole: auth.RoleAdmin,
+		namespace:     bodyNamespace(func(r *types.AutoscalingRequest) string { return r.WorkloadNamespace }),
+		allNamespaces: customMetricsQuery}),
+		func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	call := func(token, body string) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/autoscaling", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	builtin := `{"workload_namespace":"team-a","target_cpu":70}`
+	custom := `{"workload_namespace":"team-a","custom_metrics":[{"name":"q","query":"sum(q{namespace=\"team-b\"})","target_type":"Value","target_value":1}]}`
+	assert.Equal(t, http.StatusOK, call("team-a-token", builtin))
+	assert.Equal(t, http.StatusForbidden, call("team-a-token", custom))
+	assert.Equal(t, http.StatusOK, call("admin-token", custom))
+}
@@ -0,0 +1,125 @@
+package apis
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"ai-storage-orchestrator/pkg/eventbus"
+
+	"github.com/gin-gonic/gin"
+)
+
+// eventStreamHeartbeat keeps idle streams open through proxies that time out silent connections
+const eventStreamHeartbeat = 15 * time.Second
+
+// streamEvents handles GET /api/v1/events. Job progress is streamed as Server-Sent Events;
+// clients resume after a disconnect with the Last-Event-ID header (or the last_event_id parameter).
+func (h *Handler) streamEvents(c *gin.Context) {
+	if h.eventBus == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"error":   "Event stream is not enabled",
+			"details": "the orchestrator was started without an event bus",
+		})
+		return
+	}
+
+	filter := eventbus.Filter{
+		Namespace: c.Query("namespace"),
+		JobID:     c.Query("job_id"),
+	}
+	if kinds := c.Query("kind"); kinds != "" {
+		for _, kind := range strings.Split(kinds, ",") {
+			kind = strings.ToLower(strings.TrimSpace(kind))
+			if !isEventKind(kind) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"error":   "Invalid kind parameter",
+					"details": fmt.Sprintf("unknown kind %q, expected one of %s", kind, strings.Join(eventbus.Kinds, ", ")),
+				})
+				return
+			}
+			filter.Kinds = append(filter.Kinds, kind)
+		}
+	}
+
+	lastID := c.GetHeader("Last-Event-ID")
+	if lastID == "" {
+		lastID = c.Query("last_event_id")
+	}
+	var sub *eventbus.Subscription
+	var replay []eventbus.Event
+	if lastID != "" {
+		since, err := strconv.ParseUint(lastID, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid last event ID",
+				"details": err.Error(),
+			})
+			return
+		}
+		sub, replay = h.eventBus.Resume(filter, since)
+	} else {
+		sub = h.eventBus.Subscribe(filter)
+	}
+	defer sub.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // nginx 버퍼링 비활성화
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	for i := range replay {
+		if writeEvent(c, &replay[i]) != nil {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(eventStreamHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case event, ok := <-sub.Events():
+			if !ok {
+				// Dropped for falling behind; the client reconnects with its last event ID
+				return
+			}
+			if writeEvent(c, &event) != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Writer, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// writeEvent writes one event in the text/event-stream format
+func writeEvent(c *gin.Context, event *eventbus.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+		return err
+	}
+	c.Writer.Flush()
+	return nil
+}
+
+func isEventKind(kind string) bool {
+	for _, k := range eventbus.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package apis
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ai-storage-orchestrator/pkg/eventbus"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStreamEvents tests the Server-Sent Events stream, kind filtering and Last-Event-ID replay
+func TestStreamEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	bus := eventbus.NewBus(eventbus.DefaultHistorySize)
+	h := &Handler{}
+	h.SetEventBus(bus)
+	router := gin.New()
+	router.GET("/api/v1/events", h.streamEvents)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	bus.Publish(eventbus.Event{Kind: eventbus.KindMigration, Type: eventbus.TypeStatus, JobID: "migration-1", Status: "running"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/events?kind=migration,preemption", nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	bus.Publish(eventbus.Event{Kind: eventbus.KindLoadbalancing, Type: eventbus.TypeCycle, JobID: "loadbalancing-1"})
+	bus.Publish(eventbus.Event{Kind: eventbus.KindMigration, Type: eventbus.TypeStep, JobID: "migration-1", Step: "checkpoint"})
+
+	reader := bufio.NewReader(resp.Body)
+	readEvent := func() []string {
+		var lines []string
+		for {
+			line, err := reader.ReadString('\n')
+			require.NoError(t, err)
+			line = strings.TrimSuffix(line, "\n")
+			if line == "" {
+				return lines
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	event := readEvent()
+	require.Len(t, event, 3)
+	assert.Equal(t, "id: 3", event[0], "events before subscribing and loadbalancing events are not streamed")
+	assert.Equal(t, "event: step", event[1])
+	assert.Contains(t, event[2], `"step":"checkpoint"`)
+
+	// Resuming replays the events after the last ID
+	replay := httptest.NewRecorder()
+	replayCtx, replayCancel := context.WithCancel(context.Background())
+	replayReq := httptest.NewRequest(http.MethodGet, "/api/v1/events?last_event_id=0&job_id=migration-1", nil).WithContext(replayCtx)
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		replayCancel()
+	}()
+	router.ServeHTTP(replay, replayReq)
+	body := replay.Body.String()
+	assert.Contains(t, body, "id: 1\nevent: status\n")
+	assert.Contains(t, body, "id: 3\nevent: step\n")
+	assert.NotContains(t, body, "loadbalancing-1")
+
+	bad := httptest.NewRecorder()
+	router.ServeHTTP(bad, httptest.NewRequest(http.MethodGet, "/api/v1/events?kind=backup", nil))
+	assert.Equal(t, http.StatusBadRequest, bad.Code)
+}
@@ -1,10 +1,15 @@
 package apis
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
+	"ai-storage-orchestrator/pkg/audit"
+	"ai-storage-orchestrator/pkg/auth"
 	"ai-storage-orchestrator/pkg/controller"
+	"ai-storage-orchestrator/pkg/eventbus"
+	"ai-storage-orchestrator/pkg/exporter"
 	"ai-storage-orchestrator/pkg/types"
 
 	"github.com/gin-gonic/gin"
@@ -19,6 +24,19 @@ type Handler struct {
 	preemptionController    *controller.PreemptionController
 	cachingController       *controller.CachingController
 	insightController       *controller.InsightController
+
+	// REST API access control (nil: authentication disabled)
+	authProvider   auth.Provider
+	allowedOrigins []string
+
+	// Audit trail of mutating API calls (nil: disabled)
+	auditLog audit.Log
+
+	// Job progress stream of GET /api/v1/events (nil: disabled)
+	eventBus *eventbus.Bus
+
+	// Webhook notification targets of /api/v1/webhooks (nil: disabled)
+	webhookController *controller.WebhookController
 }
 
 // NewHandler creates a new API handler
@@ -34,6 +52,32 @@ func NewHandler(migrationController *controller.MigrationController, autoscaling
 	}
 }
 
+// SetAuthProvider enables bearer token authentication and role checks on the /api/v1 routes.
+// Must be called before SetupRoutes.
+func (h *Handler) SetAuthProvider(provider auth.Provider) {
+	h.authProvider = provider
+}
+
+// SetAuditLog enables the audit trail of mutating API calls and GET /api/v1/audit
+func (h *Handler) SetAuditLog(log audit.Log) {
+	h.auditLog = log
+}
+
+// SetEventBus enables the job progress stream of GET /api/v1/events
+func (h *Handler) SetEventBus(bus *eventbus.Bus) {
+	h.eventBus = bus
+}
+
+// SetWebhookController enables webhook notification management under /api/v1/webhooks
+func (h *Handler) SetWebhookController(wc *controller.WebhookController) {
+	h.webhookController = wc
+}
+
+// SetAllowedOrigins limits the origins allowed by CORS (default: any origin)
+func (h *Handler) SetAllowedOrigins(origins []string) {
+	h.allowedOrigins = origins
+}
+
 // SetupRoutes configures the HTTP routes
 func (h *Handler) SetupRoutes() *gin.Engine {
 	router := gin.Default()
@@ -41,63 +85,113 @@ func (h *Handler) SetupRoutes() *gin.Engine {
 	// Add middleware
 	router.Use(gin.Logger())
 	router.Use(gin.Recovery())
-	router.Use(corsMiddleware())
+	router.Use(corsMiddleware(h.allowedOrigins))
 
 	// Health check endpoint
 	router.GET("/health", h.healthCheck)
 
+	// Prometheus exposition endpoint (알람용 scrape 대상)
+	router.GET("/metrics", gin.WrapH(exporter.Handler(exporter.Sources{
+		Migration:     h.migrationController,
+		Autoscaling:   h.autoscalingController,
+		Loadbalancing: h.loadbalancingController,
+		Preemption:    h.preemptionController,
+		Caching:       h.cachingController,
+		Provisioning:  h.provisioningController,
+		Insight:       h.insightController,
+	})))
+
+	// Access levels of the API routes (역할: viewer < operator < admin)
+	viewer := access{role: auth.RoleViewer}
+	admin := access{role: auth.RoleAdmin}
+	migrationScope := jobNamespace(h.migrationController.GetMigrationNamespace)
+	autoscalerScope := jobNamespace(h.autoscalingController.GetAutoscalerNamespace)
+	loadbalancingScope := jobNamespace(h.loadbalancingController.GetLoadbalancingNamespace)
+	provisioningScope := jobNamespace(h.provisioningController.GetProvisioningNamespace)
+	preemptionScope := jobNamespace(h.preemptionController.GetPreemptionNamespace)
+	cacheScope := jobNamespace(h.cachingController.GetCacheNamespace)
+
+	// Namespaces of create requests, read from the body bound for the handler
+	migrationBody := bodyNamespace(func(r *types.MigrationRequest) string { return r.PodNamespace })
+	autoscalerBody := bodyNamespace(func(r *types.AutoscalingRequest) string { return r.WorkloadNamespace })
+	loadbalancingBody := bodyNamespace(func(r *types.LoadbalancingRequest) string { return r.Namespace })
+	provisioningBody := bodyNamespace(func(r *types.ProvisioningRequest) string { return r.WorkloadNamespace })
+	preemptionBody := bodyNamespace(func(r *types.PreemptionRequest) string { return r.Namespace })
+	cacheBody := bodyNamespace(func(r *types.CachingRequest) string { return r.SourceNamespace })
+	insightBody := bodyNamespace(func(r *types.InsightReport) string { return r.PodNamespace })
+
 	// Migration API endpoints
 	v1 := router.Group("/api/v1")
+	v1.Use(h.auditTrail(), h.authenticate())
 	{
-		v1.POST("/migrations", h.createMigration)
-		v1.GET("/migrations/:id", h.getMigration)
-		v1.GET("/migrations/:id/status", h.getMigrationStatus)
-		v1.GET("/metrics", h.getMetrics)
+		v1.POST("/migrations", h.authorize(access{role: auth.RoleOperator, namespace: migrationBody}), h.createMigration)
+		v1.GET("/migrations", h.authorize(viewer), h.listMigrations)
+		v1.GET("/migrations/:id", h.authorize(access{role: auth.RoleViewer, namespace: migrationScope}), h.getMigration)
+		v1.GET("/migrations/:id/status", h.authorize(access{role: auth.RoleViewer, namespace: migrationScope}), h.getMigrationStatus)
+		v1.DELETE("/migrations/:id", h.authorize(access{role: auth.RoleOperator, namespace: migrationScope}), h.cancelMigration)
+		v1.GET("/metrics", h.authorize(viewer), h.getMetrics)
 
 		// Autoscaling API endpoints
-		v1.POST("/autoscaling", h.createAutoscaler)
-		v1.GET("/autoscaling/:id", h.getAutoscaler)
-		v1.DELETE("/autoscaling/:id", h.deleteAutoscaler)
-		v1.GET("/autoscaling", h.listAutoscalers)
-		v1.GET("/autoscaling/metrics", h.getAutoscalingMetrics)
-
-		// Loadbalancing API endpoints
-		v1.POST("/loadbalancing", h.createLoadbalancing)
-		v1.GET("/loadbalancing/:id", h.getLoadbalancing)
-		v1.DELETE("/loadbalancing/:id", h.cancelLoadbalancing)
-		v1.GET("/loadbalancing", h.listLoadbalancing)
-		v1.GET("/loadbalancing/metrics", h.getLoadbalancingMetrics)
+		v1.POST("/autoscaling", h.authorize(access{role: auth.RoleOperator, allNamespacesRole: auth.RoleAdmin, namespace: autoscalerBody, allNamespaces: customMetricsQuery}), h.createAutoscaler)
+		v1.GET("/autoscaling/:id", h.authorize(access{role: auth.RoleViewer, namespace: autoscalerScope}), h.getAutoscaler)
+		v1.DELETE("/autoscaling/:id", h.authorize(access{role: auth.RoleOperator, namespace: autoscalerScope}), h.deleteAutoscaler)
+		v1.POST("/autoscaling/:id/wake", h.authorize(access{role: auth.RoleOperator, namespace: autoscalerScope}), h.wakeAutoscaler)
+		v1.GET("/autoscaling/:id/schedule", h.authorize(access{role: auth.RoleViewer, namespace: autoscalerScope}), h.previewAutoscalerSchedule)
+		v1.GET("/autoscaling", h.authorize(viewer), h.listAutoscalers)
+		v1.GET("/autoscaling/metrics", h.authorize(viewer), h.getAutoscalingMetrics)
+
+		// Loadbalancing API endpoints (전체 네임스페이스 대상 작업은 admin 필요)
+		v1.POST("/loadbalancing", h.authorize(access{role: auth.RoleOperator, allNamespacesRole: auth.RoleAdmin, namespace: loadbalancingBody}), h.createLoadbalancing)
+		v1.POST("/loadbalancing/plan", h.authorize(access{role: auth.RoleViewer, namespace: loadbalancingBody}), h.planLoadbalancing)
+		v1.GET("/loadbalancing/:id", h.authorize(access{role: auth.RoleViewer, namespace: loadbalancingScope}), h.getLoadbalancing)
+		v1.DELETE("/loadbalancing/:id", h.authorize(access{role: auth.RoleOperator, namespace: loadbalancingScope}), h.cancelLoadbalancing)
+		v1.GET("/loadbalancing", h.authorize(viewer), h.listLoadbalancing)
+		v1.GET("/loadbalancing/metrics", h.authorize(viewer), h.getLoadbalancingMetrics)
 
 		// Provisioning API endpoints
-		v1.POST("/provisioning", h.createProvisioning)
-		v1.GET("/provisioning/:id", h.getProvisioning)
-		v1.DELETE("/provisioning/:id", h.deleteProvisioning)
-		v1.GET("/provisioning", h.listProvisioning)
-		v1.GET("/provisioning/recommend/:workload_type", h.getProvisioningRecommendation)
-		v1.GET("/provisioning/metrics", h.getProvisioningMetrics)
-
-		// Preemption API endpoints
-		v1.POST("/preemption", h.createPreemption)
-		v1.GET("/preemption/:id", h.getPreemption)
-		v1.GET("/preemption", h.listPreemptions)
-		v1.GET("/preemption/metrics", h.getPreemptionMetrics)
+		v1.POST("/provisioning", h.authorize(access{role: auth.RoleOperator, namespace: provisioningBody}), h.createProvisioning)
+		v1.GET("/provisioning/:id", h.authorize(access{role: auth.RoleViewer, namespace: provisioningScope}), h.getProvisioning)
+		v1.DELETE("/provisioning/:id", h.authorize(access{role: auth.RoleOperator, namespace: provisioningScope}), h.deleteProvisioning)
+		v1.GET("/provisioning", h.authorize(viewer), h.listProvisioning)
+		v1.GET("/provisioning/recommend/:workload_type", h.authorize(viewer), h.getProvisioningRecommendation)
+		v1.GET("/provisioning/metrics", h.authorize(viewer), h.getProvisioningMetrics)
+
+		// Preemption API endpoints (전체 네임스페이스 대상 작업은 admin 필요)
+		v1.POST("/preemption", h.authorize(access{role: auth.RoleOperator, allNamespacesRole: auth.RoleAdmin, namespace: preemptionBody}), h.createPreemption)
+		v1.GET("/preemption/:id", h.authorize(access{role: auth.RoleViewer, namespace: preemptionScope}), h.getPreemption)
+		v1.GET("/preemption", h.authorize(viewer), h.listPreemptions)
+		v1.GET("/preemption/metrics", h.authorize(viewer), h.getPreemptionMetrics)
 
 		// Caching API endpoints (글로벌 캐싱)
-		v1.POST("/caching", h.createCache)
-		v1.GET("/caching/:id", h.getCache)
-		v1.DELETE("/caching/:id", h.deleteCache)
-		v1.GET("/caching", h.listCaches)
-		v1.POST("/caching/:id/evict", h.evictCache)
-		v1.POST("/caching/:id/warmup", h.warmupCache)
-		v1.POST("/caching/:id/migrate", h.migrateCache)
-		v1.POST("/caching/policy", h.applyPolicyDecision)
-		v1.GET("/caching/metrics", h.getCachingMetrics)
+		v1.POST("/caching", h.authorize(access{role: auth.RoleOperator, namespace: cacheBody}), h.createCache)
+		v1.GET("/caching/:id", h.authorize(access{role: auth.RoleViewer, namespace: cacheScope}), h.getCache)
+		v1.DELETE("/caching/:id", h.authorize(access{role: auth.RoleOperator, namespace: cacheScope}), h.deleteCache)
+		v1.GET("/caching", h.authorize(viewer), h.listCaches)
+		v1.POST("/caching/:id/evict", h.authorize(access{role: auth.RoleOperator, namespace: cacheScope}), h.evictCache)
+		v1.POST("/caching/:id/warmup", h.authorize(access{role: auth.RoleOperator, namespace: cacheScope}), h.warmupCache)
+		v1.POST("/caching/:id/migrate", h.authorize(access{role: auth.RoleOperator, namespace: cacheScope}), h.migrateCache)
+		v1.POST("/caching/policy", h.authorize(admin), h.applyPolicyDecision)
+		v1.GET("/caching/metrics", h.authorize(viewer), h.getCachingMetrics)
 
 		// Insight API endpoints (워크로드 시그니처 수집)
-		v1.POST("/insight/report", h.receiveInsightReport)
-		v1.GET("/insight/signatures", h.listInsightSignatures)
-		v1.GET("/insight/signatures/:namespace/:name", h.getInsightSignature)
-		v1.GET("/insight/metrics", h.getInsightMetrics)
+		v1.POST("/insight/report", h.authorize(access{role: auth.RoleOperator, namespace: insightBody}), h.receiveInsightReport)
+		v1.GET("/insight/signatures", h.authorize(viewer), h.listInsightSignatures)
+		v1.GET("/insight/signatures/:namespace/:name", h.authorize(access{role: auth.RoleViewer, namespace: paramNamespace("namespace")}), h.getInsightSignature)
+		v1.GET("/insight/metrics", h.authorize(viewer), h.getInsightMetrics)
+
+		// Audit API endpoints (감사 로그 조회, 전체 네임스페이스 조회는 admin 필요)
+		v1.GET("/audit", h.authorize(access{role: auth.RoleViewer, allNamespacesRole: auth.RoleAdmin, namespace: queryNamespace("namespace")}), h.queryAudit)
+
+		// Event stream endpoints (작업 진행 상황 Server-Sent Events 스트림)
+		v1.GET("/events", h.authorize(access{role: auth.RoleViewer, namespace: queryNamespace("namespace")}), h.streamEvents)
+
+		// Webhook API endpoints (작업 이벤트 웹훅 알림 대상 관리)
+		v1.POST("/webhooks", h.authorize(admin), h.createWebhook)
+		v1.GET("/webhooks", h.authorize(admin), h.listWebhooks)
+		v1.GET("/webhooks/deadletters", h.authorize(admin), h.listWebhookDeadLetters)
+		v1.GET("/webhooks/:id", h.authorize(admin), h.getWebhook)
+		v1.PUT("/webhooks/:id", h.authorize(admin), h.updateWebhook)
+		v1.DELETE("/webhooks/:id", h.authorize(admin), h.deleteWebhook)
 	}
 
 	return router
@@ -114,9 +208,8 @@ func (h *Handler) healthCheck(c *gin.Context) {
 
 // createMigration handles POST /api/v1/migrations
 func (h *Handler) createMigration(c *gin.Context) {
-	var req types.MigrationRequest
-	
-	if err := c.ShouldBindJSON(&req); err != nil {
+	req, err := bindRequest[types.MigrationRequest](c)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Invalid request format",
 			"details": err.Error(),
@@ -125,7 +218,7 @@ func (h *Handler) createMigration(c *gin.Context) {
 	}
 
 	// Validate required fields
-	if err := h.validateMigrationRequest(&req); err != nil {
+	if err := h.validateMigrationRequest(req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Validation failed",
 			"details": err.Error(),
@@ -139,7 +232,7 @@ func (h *Handler) createMigration(c *gin.Context) {
 	}
 
 	// Start migration
-	response, err := h.migrationController.StartMigration(&req)
+	response, err := h.migrationController.StartMigration(req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to start migration",
@@ -201,6 +294,36 @@ func (h *Handler) getMigrationStatus(c *gin.Context) {
 	c.JSON(http.StatusOK, statusResponse)
 }
 
+// cancelMigration handles DELETE /api/v1/migrations/:id
+func (h *Handler) cancelMigration(c *gin.Context) {
+	migrationID := c.Param("id")
+
+	if err := h.migrationController.CancelMigration(migrationID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to cancel migration",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// 롤백은 비동기로 진행되며 완료 시 상태가 cancelled로 변경됨
+	c.JSON(http.StatusAccepted, gin.H{
+		"success":      true,
+		"message":      "Migration cancellation requested, rolling back",
+		"migration_id": migrationID,
+	})
+}
+
+// listMigrations handles GET /api/v1/migrations
+func (h *Handler) listMigrations(c *gin.Context) {
+	migrations, next, ok := paginate(c, h.migrationController.ListMigrations(),
+		func(m *types.MigrationResponse) string { return m.MigrationID })
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, listResponse("migrations", migrations, len(migrations), next))
+}
+
 // getMetrics handles GET /api/v1/metrics
 func (h *Handler) getMetrics(c *gin.Context) {
 	metrics := h.migrationController.GetMetrics()
@@ -233,9 +356,8 @@ func (h *Handler) validateMigrationRequest(req *types.MigrationRequest) error {
 
 // createAutoscaler handles POST /api/v1/autoscaling
 func (h *Handler) createAutoscaler(c *gin.Context) {
-	var req types.AutoscalingRequest
-
-	if err := c.ShouldBindJSON(&req); err != nil {
+	req, err := bindRequest[types.AutoscalingRequest](c)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Invalid request format",
 			"details": err.Error(),
@@ -243,7 +365,7 @@ func (h *Handler) createAutoscaler(c *gin.Context) {
 		return
 	}
 
-	response, err := h.autoscalingController.CreateAutoscaler(&req)
+	response, err := h.autoscalingController.CreateAutoscaler(req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to create autoscaler",
@@ -290,13 +412,59 @@ func (h *Handler) deleteAutoscaler(c *gin.Context) {
 	})
 }
 
+// wakeAutoscaler handles POST /api/v1/autoscaling/:id/wake
+// The body is optional; a queue_depth below the activation threshold does not wake the workload.
+func (h *Handler) wakeAutoscaler(c *gin.Context) {
+	autoscalerID := c.Param("id")
+
+	var req types.WakeRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid request format",
+				"details": err.Error(),
+			})
+			return
+		}
+	}
+
+	response, err := h.autoscalingController.WakeAutoscaler(autoscalerID, &req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to wake autoscaler",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, response)
+}
+
+// previewAutoscalerSchedule handles GET /api/v1/autoscaling/:id/schedule
+// Returns the effective min/max replicas over the next 24 hours
+func (h *Handler) previewAutoscalerSchedule(c *gin.Context) {
+	autoscalerID := c.Param("id")
+
+	preview, err := h.autoscalingController.PreviewSchedule(autoscalerID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Autoscaler not found",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, preview)
+}
+
 // listAutoscalers handles GET /api/v1/autoscaling
 func (h *Handler) listAutoscalers(c *gin.Context) {
-	autoscalers := h.autoscalingController.ListAutoscalers()
-	c.JSON(http.StatusOK, gin.H{
-		"autoscalers": autoscalers,
-		"count":       len(autoscalers),
-	})
+	autoscalers, next, ok := paginate(c, h.autoscalingController.ListAutoscalers(),
+		func(a *types.AutoscalingResponse) string { return a.AutoscalingID })
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, listResponse("autoscalers", autoscalers, len(autoscalers), next))
 }
 
 // getAutoscalingMetrics handles GET /api/v1/autoscaling/metrics
@@ -306,11 +474,24 @@ func (h *Handler) getAutoscalingMetrics(c *gin.Context) {
 }
 
 // corsMiddleware provides CORS support
-func corsMiddleware() gin.HandlerFunc {
+func corsMiddleware(allowedOrigins []string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		c.Header("Access-Control-Allow-Origin", "*")
-		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, Authorization")
+		origin := "*"
+		if len(allowedOrigins) > 0 {
+			origin = ""
+			for _, allowed := range allowedOrigins {
+				if allowed == c.GetHeader("Origin") {
+					origin = allowed
+					break
+				}
+			}
+			c.Header("Vary", "Origin")
+		}
+		if origin != "" {
+			c.Header("Access-Control-Allow-Origin", origin)
+			c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+			c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, Authorization")
+		}
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -327,9 +508,8 @@ func corsMiddleware() gin.HandlerFunc {
 
 // createLoadbalancing handles POST /api/v1/loadbalancing
 func (h *Handler) createLoadbalancing(c *gin.Context) {
-	var req types.LoadbalancingRequest
-
-	if err := c.ShouldBindJSON(&req); err != nil {
+	req, err := bindRequest[types.LoadbalancingRequest](c)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Invalid request format",
 			"details": err.Error(),
@@ -337,7 +517,14 @@ func (h *Handler) createLoadbalancing(c *gin.Context) {
 		return
 	}
 
-	jobID, err := h.loadbalancingController.StartLoadbalancing(&req)
+	jobID, err := h.loadbalancingController.StartLoadbalancing(req)
+	if errors.Is(err, controller.ErrInvalidLoadbalancingRequest) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Validation failed",
+			"details": err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to start loadbalancing",
@@ -359,6 +546,36 @@ func (h *Handler) createLoadbalancing(c *gin.Context) {
 	c.JSON(http.StatusCreated, response)
 }
 
+// planLoadbalancing handles POST /api/v1/loadbalancing/plan
+func (h *Handler) planLoadbalancing(c *gin.Context) {
+	req, err := bindRequest[types.LoadbalancingRequest](c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	plan, err := h.loadbalancingController.PlanLoadbalancing(req)
+	if errors.Is(err, controller.ErrInvalidLoadbalancingRequest) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Validation failed",
+			"details": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to plan loadbalancing",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, plan)
+}
+
 // getLoadbalancing handles GET /api/v1/loadbalancing/:id
 func (h *Handler) getLoadbalancing(c *gin.Context) {
 	jobID := c.Param("id")
@@ -396,11 +613,12 @@ func (h *Handler) cancelLoadbalancing(c *gin.Context) {
 
 // listLoadbalancing handles GET /api/v1/loadbalancing
 func (h *Handler) listLoadbalancing(c *gin.Context) {
-	jobs := h.loadbalancingController.ListLoadbalancingJobs()
-	c.JSON(http.StatusOK, gin.H{
-		"loadbalancing_jobs": jobs,
-		"count":              len(jobs),
-	})
+	jobs, next, ok := paginate(c, h.loadbalancingController.ListLoadbalancingJobs(),
+		func(j *types.LoadbalancingResponse) string { return j.LoadbalancingID })
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, listResponse("loadbalancing_jobs", jobs, len(jobs), next))
 }
 
 // getLoadbalancingMetrics handles GET /api/v1/loadbalancing/metrics
@@ -414,9 +632,8 @@ func (h *Handler) getLoadbalancingMetrics(c *gin.Context) {
 
 // createProvisioning handles POST /api/v1/provisioning
 func (h *Handler) createProvisioning(c *gin.Context) {
-	var req types.ProvisioningRequest
-
-	if err := c.ShouldBindJSON(&req); err != nil {
+	req, err := bindRequest[types.ProvisioningRequest](c)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Invalid request format",
 			"details": err.Error(),
@@ -424,7 +641,7 @@ func (h *Handler) createProvisioning(c *gin.Context) {
 		return
 	}
 
-	response, err := h.provisioningController.CreateProvisioning(&req)
+	response, err := h.provisioningController.CreateProvisioning(req)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Failed to create provisioning",
@@ -472,11 +689,12 @@ func (h *Handler) deleteProvisioning(c *gin.Context) {
 
 // listProvisioning handles GET /api/v1/provisioning
 func (h *Handler) listProvisioning(c *gin.Context) {
-	provisionings := h.provisioningController.ListProvisionings()
-	c.JSON(http.StatusOK, gin.H{
-		"provisionings": provisionings,
-		"count":         len(provisionings),
-	})
+	provisionings, next, ok := paginate(c, h.provisioningController.ListProvisionings(),
+		func(p *types.ProvisioningResponse) string { return p.ProvisioningID })
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, listResponse("provisionings", provisionings, len(provisionings), next))
 }
 
 // getProvisioningRecommendation handles GET /api/v1/provisioning/recommend/:workload_type
@@ -499,9 +717,8 @@ func (h *Handler) getProvisioningMetrics(c *gin.Context) {
 
 // createPreemption handles POST /api/v1/preemption
 func (h *Handler) createPreemption(c *gin.Context) {
-	var req types.PreemptionRequest
-
-	if err := c.ShouldBindJSON(&req); err != nil {
+	req, err := bindRequest[types.PreemptionRequest](c)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Invalid request format",
 			"details": err.Error(),
@@ -509,7 +726,7 @@ func (h *Handler) createPreemption(c *gin.Context) {
 		return
 	}
 
-	response, err := h.preemptionController.StartPreemption(&req)
+	response, err := h.preemptionController.StartPreemption(req)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Failed to start preemption",
@@ -539,11 +756,12 @@ func (h *Handler) getPreemption(c *gin.Context) {
 
 // listPreemptions handles GET /api/v1/preemption
 func (h *Handler) listPreemptions(c *gin.Context) {
-	preemptions := h.preemptionController.ListPreemptions()
-	c.JSON(http.StatusOK, gin.H{
-		"preemptions": preemptions,
-		"count":       len(preemptions),
-	})
+	preemptions, next, ok := paginate(c, h.preemptionController.ListPreemptions(),
+		func(p *types.PreemptionResponse) string { return p.PreemptionID })
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, listResponse("preemptions", preemptions, len(preemptions), next))
 }
 
 // getPreemptionMetrics handles GET /api/v1/preemption/metrics
@@ -558,9 +776,8 @@ func (h *Handler) getPreemptionMetrics(c *gin.Context) {
 
 // createCache handles POST /api/v1/caching
 func (h *Handler) createCache(c *gin.Context) {
-	var req types.CachingRequest
-
-	if err := c.ShouldBindJSON(&req); err != nil {
+	req, err := bindRequest[types.CachingRequest](c)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Invalid request format",
 			"details": err.Error(),
@@ -568,7 +785,7 @@ func (h *Handler) createCache(c *gin.Context) {
 		return
 	}
 
-	response, err := h.cachingController.CreateCache(&req)
+	response, err := h.cachingController.CreateCache(req)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Failed to create cache",
@@ -616,11 +833,12 @@ func (h *Handler) deleteCache(c *gin.Context) {
 
 // listCaches handles GET /api/v1/caching
 func (h *Handler) listCaches(c *gin.Context) {
-	caches := h.cachingController.ListCaches()
-	c.JSON(http.StatusOK, gin.H{
-		"caches": caches,
-		"count":  len(caches),
-	})
+	caches, next, ok := paginate(c, h.cachingController.ListCaches(),
+		func(cache *types.CachingResponse) string { return cache.CacheID })
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, listResponse("caches", caches, len(caches), next))
 }
 
 // evictCache handles POST /api/v1/caching/:id/evict
@@ -740,9 +958,8 @@ func (h *Handler) getCachingMetrics(c *gin.Context) {
 // receiveInsightReport handles POST /api/v1/insight/report
 // Receives workload signature reports from insight-trace sidecars
 func (h *Handler) receiveInsightReport(c *gin.Context) {
-	var report types.InsightReport
-
-	if err := c.ShouldBindJSON(&report); err != nil {
+	report, err := bindRequest[types.InsightReport](c)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Invalid request format",
 			"details": err.Error(),
@@ -750,7 +967,7 @@ func (h *Handler) receiveInsightReport(c *gin.Context) {
 		return
 	}
 
-	response, err := h.insightController.ReceiveReport(&report)
+	response, err := h.insightController.ReceiveReport(report)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Failed to process insight report",
@@ -764,11 +981,13 @@ func (h *Handler) receiveInsightReport(c *gin.Context) {
 
 // listInsightSignatures handles GET /api/v1/insight/signatures
 func (h *Handler) listInsightSignatures(c *gin.Context) {
-	signatures := h.insightController.ListSignatures()
-	c.JSON(http.StatusOK, gin.H{
-		"signatures": signatures,
-		"count":      len(signatures),
-	})
+	// 시그니처는 namespace/name 순으로 페이지를 나눈다
+	signatures, next, ok := paginate(c, h.insightController.ListSignatures(),
+		func(s *types.WorkloadSignature) string { return s.PodNamespace + "/" + s.PodName })
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, listResponse("signatures", signatures, len(signatures), next))
 }
 
 // getInsightSignature handles GET /api/v1/insight/signatures/:namespace/:name
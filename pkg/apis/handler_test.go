@@ -0,0 +1,40 @@
+package apis
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ai-storage-orchestrator/pkg/controller"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLoadbalancingValidationErrors tests that invalid loadbalancing requests are rejected as bad requests
+func TestLoadbalancingValidationErrors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	h := &Handler{loadbalancingController: controller.NewLoadbalancingController(nil, nil)}
+	router := gin.New()
+	router.POST("/api/v1/loadbalancing", h.createLoadbalancing)
+	router.POST("/api/v1/loadbalancing/plan", h.planLoadbalancing)
+
+	for _, path := range []string{"/api/v1/loadbalancing", "/api/v1/loadbalancing/plan"} {
+		for _, body := range []string{
+			`{"strategy":"round-robin"}`,
+			`{"strategy":`,
+		} {
+			req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Code, "%s %s", path, body)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/loadbalancing/plan", strings.NewReader(`{"strategy":"round-robin"}`))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Contains(t, w.Body.String(), "invalid strategy: round-robin")
+}
@@ -0,0 +1,59 @@
+package apis
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxListLimit bounds the limit parameter of list endpoints
+const maxListLimit = 1000
+
+// paginate applies the limit and continue parameters of list endpoints. A paged list is ordered
+// by ID and the continue token is the last ID of the previous page, so paging stays consistent
+// while jobs are created or deleted. Without either parameter the list is returned unchanged.
+// On an invalid limit the 400 response is written and ok is false.
+func paginate[T any](c *gin.Context, items []T, id func(T) string) (page []T, next string, ok bool) {
+	value := c.Query("limit")
+	after := c.Query("continue")
+	if value == "" && after == "" {
+		return items, "", true
+	}
+
+	sort.Slice(items, func(i, j int) bool { return id(items[i]) < id(items[j]) })
+	if after != "" {
+		start := sort.Search(len(items), func(i int) bool { return id(items[i]) > after })
+		items = items[start:]
+	}
+	if value == "" {
+		return items, "", true
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 || limit > maxListLimit {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid limit parameter",
+			"details": fmt.Sprintf("limit must be between 1 and %d", maxListLimit),
+		})
+		return nil, "", false
+	}
+	if len(items) <= limit {
+		return items, "", true
+	}
+	return items[:limit], id(items[limit-1]), true
+}
+
+// listResponse is the body of a list endpoint; continue is set when more items follow
+func listResponse(key string, page interface{}, count int, next string) gin.H {
+	body := gin.H{
+		key:     page,
+		"count": count,
+	}
+	if next != "" {
+		body["continue"] = next
+	}
+	return body
+}
@@ -0,0 +1,150 @@
+package apis
+
+import (
+	"net/http"
+
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requireWebhooks rejects webhook calls when notifications are not configured
+func (h *Handler) requireWebhooks(c *gin.Context) bool {
+	if h.webhookController == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"error":   "Webhook notifications are not enabled",
+			"details": "the orchestrator was started without a webhook controller",
+		})
+		return false
+	}
+	return true
+}
+
+// createWebhook handles POST /api/v1/webhooks
+func (h *Handler) createWebhook(c *gin.Context) {
+	if !h.requireWebhooks(c) {
+		return
+	}
+	var req types.WebhookRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	response, err := h.webhookController.CreateWebhook(&req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to create webhook",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// getWebhook handles GET /api/v1/webhooks/:id
+func (h *Handler) getWebhook(c *gin.Context) {
+	if !h.requireWebhooks(c) {
+		return
+	}
+	webhookID := c.Param("id")
+
+	response, err := h.webhookController.GetWebhook(webhookID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Webhook not found",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// listWebhooks handles GET /api/v1/webhooks
+func (h *Handler) listWebhooks(c *gin.Context) {
+	if !h.requireWebhooks(c) {
+		return
+	}
+	webhooks, next, ok := paginate(c, h.webhookController.ListWebhooks(),
+		func(w *types.WebhookResponse) string { return w.WebhookID })
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, listResponse("webhooks", webhooks, len(webhooks), next))
+}
+
+// updateWebhook handles PUT /api/v1/webhooks/:id
+func (h *Handler) updateWebhook(c *gin.Context) {
+	if !h.requireWebhooks(c) {
+		return
+	}
+	webhookID := c.Param("id")
+
+	if _, err := h.webhookController.GetWebhook(webhookID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Webhook not found",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	var req types.WebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	response, err := h.webhookController.UpdateWebhook(webhookID, &req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Failed to update webhook",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// deleteWebhook handles DELETE /api/v1/webhooks/:id
+func (h *Handler) deleteWebhook(c *gin.Context) {
+	if !h.requireWebhooks(c) {
+		return
+	}
+	webhookID := c.Param("id")
+
+	if err := h.webhookController.DeleteWebhook(webhookID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Failed to delete webhook",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Webhook deleted successfully",
+		"webhook_id": webhookID,
+	})
+}
+
+// listWebhookDeadLetters handles GET /api/v1/webhooks/deadletters
+func (h *Handler) listWebhookDeadLetters(c *gin.Context) {
+	if !h.requireWebhooks(c) {
+		return
+	}
+	letters, next, ok := paginate(c, h.webhookController.ListDeadLetters(c.Query("webhook_id")),
+		func(d *types.WebhookDeadLetter) string { return d.ID })
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, listResponse("deadletters", letters, len(letters), next))
+}
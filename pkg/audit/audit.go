@@ -0,0 +1,59 @@
+// Package audit records a structured trail of every mutating orchestrator action.
+// 누가, 언제, 어떤 API로, 어떤 파드/PVC에 무엇을 했고 결과가 어땠는지를
+// append-only JSONL 파일에 남기고, 선택적으로 대상 오브젝트에 Kubernetes Event로 남긴다.
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/google/uuid"
+)
+
+// SystemActor is the actor of entries recorded by the controllers themselves
+// (the API entry that started the job carries the caller)
+const SystemActor = "system:ai-storage-orchestrator"
+
+// The audit records are shared with API clients, so they live in pkg/types
+type (
+	Outcome = types.AuditOutcome
+	Target  = types.AuditTarget
+	Entry   = types.AuditEntry
+	Filter  = types.AuditFilter
+)
+
+const (
+	OutcomeSuccess = types.AuditOutcomeSuccess
+	OutcomeFailure = types.AuditOutcomeFailure
+	// OutcomeDenied is recorded when authentication or authorization rejected the request
+	OutcomeDenied = types.AuditOutcomeDenied
+)
+
+// DefaultQueryLimit is the number of entries returned when Filter.Limit is not set
+const DefaultQueryLimit = types.DefaultAuditQueryLimit
+
+// Recorder writes audit entries
+type Recorder interface {
+	Record(entry *Entry) error
+}
+
+// Log is a Recorder that can also be queried
+type Log interface {
+	Recorder
+	Query(filter Filter) ([]*Entry, error)
+}
+
+// prepare fills in the ID and timestamp of a new entry
+func prepare(entry *Entry) {
+	if entry.ID == "" {
+		entry.ID = fmt.Sprintf("audit-%s", uuid.New().String()[:8])
+	}
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now()
+	}
+	if entry.Actor == "" {
+		entry.Actor = SystemActor
+	}
+}
@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSink struct {
+	mux     sync.Mutex
+	entries []*Entry
+}
+
+func (s *fakeSink) Emit(entry *Entry) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.entries = append(s.entries, entry)
+}
+
+func (s *fakeSink) count() int {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return len(s.entries)
+}
+
+// TestFileLog tests appending, reopening and filtered queries of the audit log
+func TestFileLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.jsonl")
+	l, err := NewFileLog(path)
+	require.NoError(t, err)
+	sink := &fakeSink{}
+	l.SetEventSink(sink)
+
+	base := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	entries := []*Entry{
+		{Timestamp: base, Actor: "alice", Action: "migration.create", Namespace: "team-a", Outcome: OutcomeSuccess,
+			Targets: []Target{{Kind: "Pod", Namespace: "team-a", Name: "trainer-0"}}},
+		{Timestamp: base.Add(time.Minute), Actor: "bob", Action: "preemption.create", Namespace: "team-b", Outcome: OutcomeDenied},
+		{Timestamp: base.Add(2 * time.Minute), Action: "preemption.evict", JobID: "preemption-1", Outcome: OutcomeSuccess,
+			Targets: []Target{{Kind: "Pod", Namespace: "team-a", Name: "batch-1"}, {Kind: "Pod", Namespace: "team-c", Name: "batch-2"}}},
+	}
+	for _, e := range entries {
+		require.NoError(t, l.Record(e))
+		assert.NotEmpty(t, e.ID)
+	}
+	require.NoError(t, l.Close())
+	assert.Eventually(t, func() bool { return sink.count() == 2 }, time.Second, 10*time.Millisecond)
+
+	// Reopening appends to the same file
+	l, err = NewFileLog(path)
+	require.NoError(t, err)
+	defer l.Close()
+	require.NoError(t, l.Record(&Entry{Timestamp: base.Add(3 * time.Minute), Actor: "alice", Action: "caching.create", Outcome: OutcomeFailure}))
+
+	all, err := l.Query(Filter{})
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+	assert.Equal(t, "caching.create", all[0].Action, "newest entries come first")
+	assert.Equal(t, SystemActor, all[1].Actor)
+
+	byNamespace, err := l.Query(Filter{Namespace: "team-a"})
+	require.NoError(t, err)
+	require.Len(t, byNamespace, 2, "entries whose targets are in the namespace match too")
+
+	byPrefix, err := l.Query(Filter{Action: "preemption."})
+	require.NoError(t, err)
+	assert.Len(t, byPrefix, 2)
+
+	byTime, err := l.Query(Filter{Since: base.Add(30 * time.Second), Until: base.Add(150 * time.Second)})
+	require.NoError(t, err)
+	assert.Len(t, byTime, 2)
+
+	limited, err := l.Query(Filter{Actor: "alice", Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+	assert.Equal(t, "caching.create", limited[0].Action)
+}
+
+// TestEventReason tests the event reasons derived from actions
+func TestEventReason(t *testing.T) {
+	assert.Equal(t, "AuditPreemptionEvict", eventReason(&Entry{Action: "preemption.evict", Outcome: OutcomeSuccess}))
+	assert.Equal(t, "AuditMigrationCreateFailed", eventReason(&Entry{Action: "migration.create", Outcome: OutcomeFailure}))
+}
@@ -0,0 +1,70 @@
+package audit
+
+import (
+	"fmt"
+
+	"ai-storage-orchestrator/pkg/k8s"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// EventSink emits audit entries as Kubernetes Events on their targets
+type EventSink interface {
+	Emit(entry *Entry)
+}
+
+// KubernetesEventSink records an Event on every target of an entry, so the action
+// shows up in `kubectl describe` of the affected pods, PVCs and workloads
+type KubernetesEventSink struct {
+	recorder *k8s.EventRecorder
+}
+
+// NewKubernetesEventSink creates an event sink sharing the recorder of the Kubernetes client
+func NewKubernetesEventSink(recorder *k8s.EventRecorder) *KubernetesEventSink {
+	return &KubernetesEventSink{recorder: recorder}
+}
+
+// Emit records one event per target. Failures are logged only, auditing never blocks an action.
+func (s *KubernetesEventSink) Emit(entry *Entry) {
+	eventType := corev1.EventTypeNormal
+	if entry.Outcome != OutcomeSuccess {
+		eventType = corev1.EventTypeWarning
+	}
+	reason := eventReason(entry)
+	message := fmt.Sprintf("%s by %s", entry.Action, entry.Actor)
+	if entry.JobID != "" {
+		message += fmt.Sprintf(" (job %s)", entry.JobID)
+	}
+	if entry.Reason != "" {
+		message += ": " + entry.Reason
+	}
+	if entry.Message != "" {
+		message += " - " + entry.Message
+	}
+
+	for _, target := range entry.Targets {
+		s.recorder.Event(target.Kind, target.Namespace, target.Name, eventType, reason, message)
+	}
+}
+
+// eventReason turns an action such as "preemption.evict" into an event reason such as "AuditPreemptionEvict"
+func eventReason(entry *Entry) string {
+	reason := []byte("Audit")
+	upper := true
+	for i := 0; i < len(entry.Action); i++ {
+		c := entry.Action[i]
+		if c == '.' || c == '_' || c == '-' {
+			upper = true
+			continue
+		}
+		if upper && c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		upper = false
+		reason = append(reason, c)
+	}
+	if entry.Outcome != OutcomeSuccess {
+		reason = append(reason, "Failed"...)
+	}
+	return string(reason)
+}
@@ -0,0 +1,117 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// maxLineBytes bounds a single JSONL line when reading the log back
+const maxLineBytes = 4 * 1024 * 1024
+
+// FileLog appends entries as JSON lines to a file opened in append-only mode
+type FileLog struct {
+	path   string
+	file   *os.File
+	mux    sync.Mutex
+	events EventSink
+}
+
+// NewFileLog opens (or creates) the audit log at path
+func NewFileLog(path string) (*FileLog, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log %s: %w", path, err)
+	}
+	return &FileLog{path: path, file: file}, nil
+}
+
+// SetEventSink additionally emits every entry with targets as Kubernetes Events
+func (l *FileLog) SetEventSink(sink EventSink) {
+	l.events = sink
+}
+
+// Record appends an entry and syncs it to disk
+func (l *FileLog) Record(entry *Entry) error {
+	prepare(entry)
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+	line = append(line, '\n')
+
+	l.mux.Lock()
+	_, err = l.file.Write(line)
+	if err == nil {
+		err = l.file.Sync()
+	}
+	l.mux.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+
+	if l.events != nil && len(entry.Targets) > 0 {
+		go l.events.Emit(entry) // API lookups must not delay the audited request
+	}
+	return nil
+}
+
+// Query scans the log and returns the newest entries matching the filter
+func (l *FileLog) Query(filter Filter) ([]*Entry, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultQueryLimit
+	}
+
+	file, err := os.Open(l.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	// 최신 항목 limit개만 유지하는 링 버퍼
+	ring := make([]*Entry, 0, limit)
+	next := 0
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxLineBytes)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			log.Printf("Warning: Skipping corrupt audit log line: %v", err)
+			continue
+		}
+		if !filter.Matches(&entry) {
+			continue
+		}
+		if len(ring) < limit {
+			ring = append(ring, &entry)
+		} else {
+			ring[next] = &entry
+			next = (next + 1) % limit
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	// Newest first
+	result := make([]*Entry, 0, len(ring))
+	for i := len(ring) - 1; i >= 0; i-- {
+		result = append(result, ring[(next+i)%len(ring)])
+	}
+	return result, nil
+}
+
+// Close closes the log file
+func (l *FileLog) Close() error {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	return l.file.Close()
+}
@@ -0,0 +1,137 @@
+// Package auth authenticates REST API callers and decides which roles they hold.
+// 토큰(정적 토큰 또는 Kubernetes ServiceAccount 토큰)으로 호출자를 식별하고,
+// 라우트별로 요구되는 역할(viewer/operator/admin)을 네임스페이스 단위로 확인한다.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Role is the permission level required by a REST route
+type Role string
+
+const (
+	// RoleViewer may read jobs and metrics
+	RoleViewer Role = "viewer"
+	// RoleOperator may additionally create and cancel jobs (migrations, preemptions, caches, ...)
+	RoleOperator Role = "operator"
+	// RoleAdmin may additionally run cluster-wide operations and apply cache policy decisions
+	RoleAdmin Role = "admin"
+)
+
+// rank orders roles from least to most privileged; unknown roles rank below viewer
+func (r Role) rank() int {
+	switch r {
+	case RoleViewer:
+		return 1
+	case RoleOperator:
+		return 2
+	case RoleAdmin:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Includes reports whether the role grants everything the other role grants
+func (r Role) Includes(other Role) bool {
+	return r.rank() > 0 && r.rank() >= other.rank()
+}
+
+// ParseRole validates a role name
+func ParseRole(s string) (Role, error) {
+	role := Role(strings.ToLower(strings.TrimSpace(s)))
+	if role.rank() == 0 {
+		return "", fmt.Errorf("unknown role %q (expected %s, %s or %s)", s, RoleViewer, RoleOperator, RoleAdmin)
+	}
+	return role, nil
+}
+
+// ErrUnauthenticated is returned (wrapped) when a token is missing or not recognized
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Identity is an authenticated API caller
+type Identity struct {
+	Name   string              `json:"name"`
+	UID    string              `json:"uid,omitempty"`
+	Groups []string            `json:"groups,omitempty"`
+	Extra  map[string][]string `json:"-"`
+
+	// Role and Namespaces are only known for static tokens; Kubernetes identities are
+	// authorized per request with a SubjectAccessReview
+	Role       Role     `json:"role,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"` // empty: all namespaces
+
+	// Provider is the name of the provider that authenticated the identity
+	Provider string `json:"provider"`
+}
+
+// Provider authenticates bearer tokens and authorizes the resulting identities
+type Provider interface {
+	// Name identifies the provider in logs and identities
+	Name() string
+
+	// Authenticate returns the identity of a token, or an error wrapping ErrUnauthenticated
+	// when the provider does not know the token
+	Authenticate(ctx context.Context, token string) (*Identity, error)
+
+	// Authorize reports whether the identity holds role in namespace.
+	// An empty namespace means the request is not limited to one namespace,
+	// which requires the role cluster-wide.
+	Authorize(ctx context.Context, id *Identity, role Role, namespace string) (bool, error)
+}
+
+// unauthenticated wraps ErrUnauthenticated with the reason
+func unauthenticated(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrUnauthenticated, fmt.Sprintf(format, args...))
+}
+
+// IsUnauthenticated reports whether err means the token was not accepted
+func IsUnauthenticated(err error) bool {
+	return errors.Is(err, ErrUnauthenticated)
+}
+
+// Chain authenticates a token with the first provider that accepts it and
+// authorizes the identity with that same provider
+type Chain struct {
+	providers []Provider
+}
+
+// NewChain creates a provider chain
+func NewChain(providers ...Provider) *Chain {
+	return &Chain{providers: providers}
+}
+
+// Name returns the names of the chained providers
+func (c *Chain) Name() string {
+	names := make([]string, 0, len(c.providers))
+	for _, p := range c.providers {
+		names = append(names, p.Name())
+	}
+	return strings.Join(names, "+")
+}
+
+// Authenticate tries each provider in order; errors other than ErrUnauthenticated are returned immediately
+func (c *Chain) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	err := unauthenticated("no auth provider configured")
+	for _, p := range c.providers {
+		var id *Identity
+		if id, err = p.Authenticate(ctx, token); err == nil || !IsUnauthenticated(err) {
+			return id, err
+		}
+	}
+	return nil, err
+}
+
+// Authorize delegates to the provider that authenticated the identity
+func (c *Chain) Authorize(ctx context.Context, id *Identity, role Role, namespace string) (bool, error) {
+	for _, p := range c.providers {
+		if p.Name() == id.Provider {
+			return p.Authorize(ctx, id, role, namespace)
+		}
+	}
+	return false, fmt.Errorf("identity %s was authenticated by unknown provider %q", id.Name, id.Provider)
+}
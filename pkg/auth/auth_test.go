@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// TestStaticTokenProvider tests token lookup, role levels and namespace scoping
+func TestStaticTokenProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+tokens:
+- name: team-a
+  token: team-a-token
+  role: operator
+  namespaces: [team-a]
+- name: platform
+  token: platform-token
+  role: admin
+`), 0600))
+
+	p, err := LoadStaticTokenFile(path)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = p.Authenticate(ctx, "wrong-token")
+	assert.True(t, IsUnauthenticated(err))
+
+	team, err := p.Authenticate(ctx, "team-a-token")
+	require.NoError(t, err)
+	assert.Equal(t, "team-a", team.Name)
+	assert.Equal(t, "static", team.Provider)
+
+	cases := []struct {
+		id        *Identity
+		role      Role
+		namespace string
+		allowed   bool
+	}{
+		{team, RoleViewer, "team-a", true},
+		{team, RoleOperator, "team-a", true},
+		{team, RoleOperator, "team-b", false},
+		{team, RoleViewer, "", false}, // namespaced tokens never get cluster scope
+		{team, RoleAdmin, "team-a", false},
+	}
+	for _, tc := range cases {
+		allowed, err := p.Authorize(ctx, tc.id, tc.role, tc.namespace)
+		require.NoError(t, err)
+		assert.Equal(t, tc.allowed, allowed, "%s as %s in %q", tc.id.Name, tc.role, tc.namespace)
+	}
+
+	platform, err := p.Authenticate(ctx, "platform-token")
+	require.NoError(t, err)
+	allowed, err := p.Authorize(ctx, platform, RoleAdmin, "")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	_, err = NewStaticTokenProvider([]StaticToken{{Name: "x", Token: "y", Role: "root"}})
+	assert.Error(t, err)
+}
+
+// TestKubernetesProvider tests TokenReview authentication and SubjectAccessReview role checks
+func TestKubernetesProvider(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		if review.Spec.Token == "sa-token" {
+			review.Status.Authenticated = true
+			review.Status.User = authenticationv1.UserInfo{
+				Username: "system:serviceaccount:team-a:trainer",
+				Groups:   []string{"system:serviceaccounts"},
+			}
+		}
+		return true, review, nil
+	})
+
+	var verbs []string
+	client.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		attrs := review.Spec.ResourceAttributes
+		verbs = append(verbs, attrs.Verb)
+		// team-a 네임스페이스에서만 view/operate 허용
+		review.Status.Allowed = attrs.Group == APIGroup && attrs.Resource == Resource &&
+			attrs.Namespace == "team-a" && (attrs.Verb == "view" || attrs.Verb == "operate")
+		return true, review, nil
+	})
+
+	p := NewKubernetesProvider(client, nil)
+	ctx := context.Background()
+
+	_, err := p.Authenticate(ctx, "bad-token")
+	assert.True(t, IsUnauthenticated(err))
+
+	id, err := p.Authenticate(ctx, "sa-token")
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:team-a:trainer", id.Name)
+
+	allowed, err := p.Authorize(ctx, id, RoleOperator, "team-a")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = p.Authorize(ctx, id, RoleOperator, "team-b")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, []string{"operate", "operate", "administer"}, verbs)
+
+	// Decisions are cached
+	verbs = nil
+	allowed, err = p.Authorize(ctx, id, RoleOperator, "team-a")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Empty(t, verbs)
+
+	// 그룹이 다르면 같은 사용자라도 캐시된 결정을 재사용하지 않음
+	withGroup := *id
+	withGroup.Groups = append([]string{"team-a:admins"}, id.Groups...)
+	_, err = p.Authorize(ctx, &withGroup, RoleOperator, "team-a")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"operate"}, verbs)
+
+	// The chain authorizes with the provider that authenticated the identity
+	static, err := NewStaticTokenProvider([]StaticToken{{Name: "ci", Token: "ci-token", Role: RoleViewer}})
+	require.NoError(t, err)
+	chain := NewChain(static, p)
+	ci, err := chain.Authenticate(ctx, "ci-token")
+	require.NoError(t, err)
+	allowed, err = chain.Authorize(ctx, ci, RoleViewer, "")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	sa, err := chain.Authenticate(ctx, "sa-token")
+	require.NoError(t, err)
+	assert.Equal(t, "kubernetes", sa.Provider)
+}
+
+// TestKubernetesProviderCacheIsBounded tests that unexpired decisions do not grow the cache past its limit
+func TestKubernetesProviderCacheIsBounded(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		review.Status.Allowed = true
+		return true, review, nil
+	})
+	p := NewKubernetesProvider(client, nil)
+	ctx := context.Background()
+
+	id := &Identity{Name: "trainer", Provider: "kubernetes"}
+	for i := 0; i < maxCachedReviews+10; i++ {
+		_, err := p.Authorize(ctx, id, RoleViewer, fmt.Sprintf("team-%d", i))
+		require.NoError(t, err)
+	}
+
+	assert.Len(t, p.decisions, maxCachedReviews)
+	// 가장 최근 결정은 남음
+	assert.Contains(t, p.decisions, decisionKey(id, "view", fmt.Sprintf("team-%d", maxCachedReviews+9)))
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"k8s.io/client-go/kubernetes"
+)
+
+// Provider names accepted in Config.Providers
+const (
+	ProviderNone       = "none"
+	ProviderStatic     = "static"
+	ProviderKubernetes = "kubernetes"
+)
+
+// Config selects the auth providers of the REST API
+type Config struct {
+	// Providers are tried in order; empty or "none" disables authentication
+	Providers []string
+
+	// TokenFile is the static token file used by the static provider
+	TokenFile string
+
+	// Audiences restricts the tokens accepted by the kubernetes provider
+	Audiences []string
+}
+
+// Enabled reports whether any provider is configured
+func (c Config) Enabled() bool {
+	for _, p := range c.Providers {
+		if p != ProviderNone {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigFromEnv reads the auth configuration from environment variables:
+// AUTH_MODE (comma separated providers, e.g. "kubernetes,static"), AUTH_TOKEN_FILE and AUTH_AUDIENCES
+func ConfigFromEnv() Config {
+	return Config{
+		Providers: splitList(os.Getenv("AUTH_MODE")),
+		TokenFile: os.Getenv("AUTH_TOKEN_FILE"),
+		Audiences: splitList(os.Getenv("AUTH_AUDIENCES")),
+	}
+}
+
+// New builds the providers selected by the config. It returns nil when authentication is disabled.
+func New(cfg Config, clientset kubernetes.Interface) (Provider, error) {
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+
+	providers := make([]Provider, 0, len(cfg.Providers))
+	for _, name := range cfg.Providers {
+		switch name {
+		case ProviderNone:
+			continue
+
+		case ProviderStatic:
+			if cfg.TokenFile == "" {
+				return nil, fmt.Errorf("the static auth provider requires AUTH_TOKEN_FILE")
+			}
+			p, err := LoadStaticTokenFile(cfg.TokenFile)
+			if err != nil {
+				return nil, err
+			}
+			providers = append(providers, p)
+
+		case ProviderKubernetes:
+			if clientset == nil {
+				return nil, fmt.Errorf("the kubernetes auth provider requires a Kubernetes client")
+			}
+			providers = append(providers, NewKubernetesProvider(clientset, cfg.Audiences))
+
+		default:
+			return nil, fmt.Errorf("unknown auth provider %q (expected %s, %s or %s)",
+				name, ProviderStatic, ProviderKubernetes, ProviderNone)
+		}
+	}
+
+	if len(providers) == 1 {
+		return providers[0], nil
+	}
+	return NewChain(providers...), nil
+}
+
+// splitList splits a comma separated value, dropping empty items
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,235 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// APIGroup and Resource name the virtual resource checked by SubjectAccessReview.
+	// RBAC rules grant the role verbs on it, e.g.
+	//
+	//	rules:
+	//	- apiGroups: ["apollo.keti.re.kr"]
+	//	  resources: ["orchestrator"]
+	//	  verbs: ["view", "operate"]
+	APIGroup = "apollo.keti.re.kr"
+	Resource = "orchestrator"
+
+	// defaultReviewTTL is how long TokenReview and SubjectAccessReview results are reused
+	defaultReviewTTL = 30 * time.Second
+	// maxCachedReviews bounds each review cache; expired entries are dropped first, then the oldest ones
+	maxCachedReviews = 1000
+)
+
+// roleVerbs maps each role to the RBAC verb that grants it
+var roleVerbs = map[Role]string{
+	RoleViewer:   "view",
+	RoleOperator: "operate",
+	RoleAdmin:    "administer",
+}
+
+// Verb returns the RBAC verb that grants the role
+func (r Role) Verb() string {
+	return roleVerbs[r]
+}
+
+// KubernetesProvider authenticates ServiceAccount and user tokens with the TokenReview API and
+// authorizes them with SubjectAccessReview, so access is managed with ordinary Roles and RoleBindings
+type KubernetesProvider struct {
+	client    kubernetes.Interface
+	audiences []string
+	ttl       time.Duration
+
+	cacheMux  sync.Mutex
+	tokens    map[string]cachedIdentity
+	decisions map[string]cachedDecision
+}
+
+type cachedIdentity struct {
+	identity *Identity
+	expires  time.Time
+}
+
+type cachedDecision struct {
+	allowed bool
+	expires time.Time
+}
+
+// NewKubernetesProvider creates a provider backed by the API server.
+// audiences restricts accepted tokens to the given audiences (empty: the API server's default audience).
+func NewKubernetesProvider(client kubernetes.Interface, audiences []string) *KubernetesProvider {
+	return &KubernetesProvider{
+		client:    client,
+		audiences: audiences,
+		ttl:       defaultReviewTTL,
+		tokens:    make(map[string]cachedIdentity),
+		decisions: make(map[string]cachedDecision),
+	}
+}
+
+// SetCacheTTL changes how long review results are reused (0 disables caching)
+func (p *KubernetesProvider) SetCacheTTL(ttl time.Duration) {
+	p.ttl = ttl
+}
+
+// Name returns the provider name
+func (p *KubernetesProvider) Name() string {
+	return "kubernetes"
+}
+
+// Authenticate validates the token with a TokenReview
+func (p *KubernetesProvider) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	// 토큰 원문 대신 해시를 캐시 키로 사용
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+
+	p.cacheMux.Lock()
+	cached, ok := p.tokens[key]
+	p.cacheMux.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.identity, nil
+	}
+
+	review, err := p.client.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token, Audiences: p.audiences},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("token review failed: %w", err)
+	}
+	if !review.Status.Authenticated {
+		if review.Status.Error != "" {
+			return nil, unauthenticated("token rejected: %s", review.Status.Error)
+		}
+		return nil, unauthenticated("token rejected")
+	}
+
+	user := review.Status.User
+	id := &Identity{
+		Name:     user.Username,
+		UID:      user.UID,
+		Groups:   user.Groups,
+		Provider: p.Name(),
+	}
+	if len(user.Extra) > 0 {
+		id.Extra = make(map[string][]string, len(user.Extra))
+		for k, v := range user.Extra {
+			id.Extra[k] = v
+		}
+	}
+
+	p.cacheMux.Lock()
+	pruneCache(p.tokens, func(c cachedIdentity) time.Time { return c.expires })
+	p.tokens[key] = cachedIdentity{identity: id, expires: time.Now().Add(p.ttl)}
+	p.cacheMux.Unlock()
+	return id, nil
+}
+
+// Authorize asks SubjectAccessReview whether the identity may use the verb of role,
+// or of any role that includes it, on the orchestrator resource in namespace
+func (p *KubernetesProvider) Authorize(ctx context.Context, id *Identity, role Role, namespace string) (bool, error) {
+	for _, candidate := range []Role{RoleViewer, RoleOperator, RoleAdmin} {
+		if !candidate.Includes(role) {
+			continue
+		}
+		allowed, err := p.accessReview(ctx, id, candidate.Verb(), namespace)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+	return false, nil
+}
+
+// accessReview runs (or reuses) a SubjectAccessReview for one verb
+func (p *KubernetesProvider) accessReview(ctx context.Context, id *Identity, verb, namespace string) (bool, error) {
+	key := decisionKey(id, verb, namespace)
+
+	p.cacheMux.Lock()
+	cached, ok := p.decisions[key]
+	p.cacheMux.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.allowed, nil
+	}
+
+	extra := make(map[string]authorizationv1.ExtraValue, len(id.Extra))
+	for k, v := range id.Extra {
+		extra[k] = v
+	}
+	review, err := p.client.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   id.Name,
+			UID:    id.UID,
+			Groups: id.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:     APIGroup,
+				Resource:  Resource,
+				Verb:      verb,
+				Namespace: namespace,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("subject access review failed: %w", err)
+	}
+
+	allowed := review.Status.Allowed && !review.Status.Denied
+	p.cacheMux.Lock()
+	pruneCache(p.decisions, func(c cachedDecision) time.Time { return c.expires })
+	p.decisions[key] = cachedDecision{allowed: allowed, expires: time.Now().Add(p.ttl)}
+	p.cacheMux.Unlock()
+	return allowed, nil
+}
+
+// decisionKey identifies a SubjectAccessReview by everything it is decided on:
+// the user, its groups and extra attributes, the verb and the namespace
+func decisionKey(id *Identity, verb, namespace string) string {
+	groups := append([]string(nil), id.Groups...)
+	sort.Strings(groups)
+	parts := []string{id.Name, id.UID, verb, namespace, strings.Join(groups, "\x01")}
+
+	extraKeys := make([]string, 0, len(id.Extra))
+	for k := range id.Extra {
+		extraKeys = append(extraKeys, k)
+	}
+	sort.Strings(extraKeys)
+	for _, k := range extraKeys {
+		parts = append(parts, k+"="+strings.Join(id.Extra[k], "\x01"))
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// pruneCache makes room for one more entry: when the cache is full, expired entries are removed,
+// then the entries closest to expiry (the oldest, since all share the TTL) until it is below maxCachedReviews
+func pruneCache[T any](cache map[string]T, expires func(T) time.Time) {
+	if len(cache) < maxCachedReviews {
+		return
+	}
+	now := time.Now()
+	for k, v := range cache {
+		if now.After(expires(v)) {
+			delete(cache, k)
+		}
+	}
+	for len(cache) >= maxCachedReviews {
+		var oldest string
+		var oldestExpires time.Time
+		for k, v := range cache {
+			if oldest == "" || expires(v).Before(oldestExpires) {
+				oldest, oldestExpires = k, expires(v)
+			}
+		}
+		delete(cache, oldest)
+	}
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"ai-storage-orchestrator/pkg/types"
+)
+
+// ============================================================================
+// Caching (글로벌 캐싱)
+// ============================================================================
+
+// CreateCache creates a cache of a PVC on a storage tier (POST /api/v1/caching)
+func (c *Client) CreateCache(ctx context.Context, req *types.CachingRequest) (*types.CachingResponse, error) {
+	var resp types.CachingResponse
+	if err := c.do(ctx, http.MethodPost, "/api/v1/caching", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetCache returns a cache (GET /api/v1/caching/:id)
+func (c *Client) GetCache(ctx context.Context, id string) (*types.CachingResponse, error) {
+	var resp types.CachingResponse
+	if err := c.do(ctx, http.MethodGet, "/api/v1/caching/"+pathID(id), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListCaches returns every cache (GET /api/v1/caching)
+func (c *Client) ListCaches(ctx context.Context) ([]*types.CachingResponse, error) {
+	return listAll[*types.CachingResponse](ctx, c, "/api/v1/caching", "caches", nil)
+}
+
+// ListCachesPage returns one page of caches (GET /api/v1/caching?limit=&continue=)
+func (c *Client) ListCachesPage(ctx context.Context, opts ListOptions) (*Page[*types.CachingResponse], error) {
+	return listPage[*types.CachingResponse](ctx, c, "/api/v1/caching", "caches", nil, opts)
+}
+
+// DeleteCache deletes a cache (DELETE /api/v1/caching/:id)
+func (c *Client) DeleteCache(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/api/v1/caching/"+pathID(id), nil, nil, nil)
+}
+
+// EvictCache evicts the data of a cache (POST /api/v1/caching/:id/evict)
+func (c *Client) EvictCache(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodPost, "/api/v1/caching/"+pathID(id)+"/evict", nil, nil, nil)
+}
+
+// WarmupCache prefetches data into a cache (POST /api/v1/caching/:id/warmup)
+func (c *Client) WarmupCache(ctx context.Context, id string, req *types.CacheWarmupRequest) error {
+	return c.do(ctx, http.MethodPost, "/api/v1/caching/"+pathID(id)+"/warmup", nil, req, nil)
+}
+
+// MigrateCacheTier moves a cache to another storage tier (POST /api/v1/caching/:id/migrate)
+func (c *Client) MigrateCacheTier(ctx context.Context, id string, req *types.TierMigrationRequest) error {
+	return c.do(ctx, http.MethodPost, "/api/v1/caching/"+pathID(id)+"/migrate", nil, req, nil)
+}
+
+// ApplyCachePolicyDecision applies a decision of the policy engine (POST /api/v1/caching/policy)
+func (c *Client) ApplyCachePolicyDecision(ctx context.Context, decision *types.CachePolicyDecision) error {
+	return c.do(ctx, http.MethodPost, "/api/v1/caching/policy", nil, decision, nil)
+}
+
+// GetCachingMetrics returns the caching metrics (GET /api/v1/caching/metrics)
+func (c *Client) GetCachingMetrics(ctx context.Context) (*types.CachingMetrics, error) {
+	var resp types.CachingMetrics
+	if err := c.do(ctx, http.MethodGet, "/api/v1/caching/metrics", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ============================================================================
+// Insight (워크로드 시그니처)
+// ============================================================================
+
+// ReportInsight sends a workload signature report, as the insight-trace sidecar does
+// (POST /api/v1/insight/report)
+func (c *Client) ReportInsight(ctx context.Context, report *types.InsightReport) (*types.InsightReportResponse, error) {
+	var resp types.InsightReportResponse
+	if err := c.do(ctx, http.MethodPost, "/api/v1/insight/report", nil, report, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListInsightSignatures returns every workload signature (GET /api/v1/insight/signatures)
+func (c *Client) ListInsightSignatures(ctx context.Context) ([]*types.WorkloadSignature, error) {
+	return listAll[*types.WorkloadSignature](ctx, c, "/api/v1/insight/signatures", "signatures", nil)
+}
+
+// ListInsightSignaturesPage returns one page of workload signatures, ordered by namespace/name (GET /api/v1/insight/signatures?limit=&continue=)
+func (c *Client) ListInsightSignaturesPage(ctx context.Context, opts ListOptions) (*Page[*types.WorkloadSignature], error) {
+	return listPage[*types.WorkloadSignature](ctx, c, "/api/v1/insight/signatures", "signatures", nil, opts)
+}
+
+// GetInsightSignature returns the signature of a pod (GET /api/v1/insight/signatures/:namespace/:name)
+func (c *Client) GetInsightSignature(ctx context.Context, namespace, name string) (*types.WorkloadSignature, error) {
+	var resp types.WorkloadSignature
+	path := "/api/v1/insight/signatures/" + pathID(namespace) + "/" + pathID(name)
+	if err := c.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetInsightMetrics returns the insight metrics (GET /api/v1/insight/metrics)
+func (c *Client) GetInsightMetrics(ctx context.Context) (*types.InsightMetrics, error) {
+	var resp types.InsightMetrics
+	if err := c.do(ctx, http.MethodGet, "/api/v1/insight/metrics", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
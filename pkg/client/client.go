@@ -0,0 +1,271 @@
+// Package client is a typed Go client of the orchestrator REST API.
+// 요청/응답은 pkg/types 구조체를 그대로 사용하므로 서버와 같은 스키마를 공유한다.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTimeout bounds a single API call of a client created by NewClient
+const DefaultTimeout = 30 * time.Second
+
+// RetryPolicy controls how idempotent requests (GET, PUT, DELETE) are retried after transport
+// errors and 429/502/503/504 responses. POST requests are never retried, since creating a job twice
+// is not safe.
+type RetryPolicy struct {
+	MaxRetries int           // retries after the first attempt; 0 disables retries
+	MinBackoff time.Duration // delay before the first retry, doubled on every further retry
+	MaxBackoff time.Duration // upper bound of the delay, also applied to Retry-After
+}
+
+// DefaultRetryPolicy is the retry policy of a client created by NewClient
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: 200 * time.Millisecond,
+	MaxBackoff: 5 * time.Second,
+}
+
+// backoff returns the delay before retry number attempt (0-based)
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.MinBackoff
+	for i := 0; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
+
+// Client calls the orchestrator REST API
+type Client struct {
+	baseURL     string
+	token       string
+	userAgent   string
+	httpClient  *http.Client
+	retryPolicy RetryPolicy
+}
+
+// NewClient creates a client of the orchestrator at baseURL, e.g. http://localhost:8080
+func NewClient(baseURL string) *Client {
+	return &Client{
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		userAgent:   "ai-storage-orchestrator-client",
+		httpClient:  &http.Client{Timeout: DefaultTimeout},
+		retryPolicy: DefaultRetryPolicy,
+	}
+}
+
+// SetToken sets the bearer token sent when the server has authentication enabled
+func (c *Client) SetToken(token string) {
+	c.token = token
+}
+
+// SetUserAgent sets the User-Agent header of requests
+func (c *Client) SetUserAgent(userAgent string) {
+	c.userAgent = userAgent
+}
+
+// SetHTTPClient replaces the HTTP client, e.g. to configure TLS or a different timeout.
+// The timeout applies to each attempt; event streams ignore it.
+func (c *Client) SetHTTPClient(httpClient *http.Client) {
+	c.httpClient = httpClient
+}
+
+// SetRetryPolicy replaces the retry policy; RetryPolicy{} disables retries
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = policy
+}
+
+// APIError is returned when the server answered with an error status.
+// Error and Details carry the {"error": ..., "details": ...} body of the API.
+type APIError struct {
+	StatusCode int    `json:"-"`
+	Message    string `json:"error"`
+	Details    string `json:"details,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	if e.Details != "" {
+		return fmt.Sprintf("HTTP %d: %s: %s", e.StatusCode, e.Message, e.Details)
+	}
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is an API error with status 404
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// do sends a request with an optional JSON body and decodes the JSON response into out
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, in, out interface{}) error {
+	data, err := c.doRaw(ctx, method, path, query, in)
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to decode response of %s %s: %w", method, path, err)
+	}
+	return nil
+}
+
+// doRaw sends a request, retrying idempotent methods per the retry policy, and returns the body
+// of the successful response
+func (c *Client) doRaw(ctx context.Context, method, path string, query url.Values, in interface{}) ([]byte, error) {
+	var body []byte
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request: %w", err)
+		}
+		body = data
+	}
+
+	for attempt := 0; ; attempt++ {
+		retry := attempt < c.retryPolicy.MaxRetries && isIdempotent(method)
+
+		data, retryAfter, err := c.send(ctx, method, path, query, body)
+		if err == nil {
+			return data, nil
+		}
+		if !retry || ctx.Err() != nil {
+			return nil, err
+		}
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && !isRetryableStatus(apiErr.StatusCode) {
+			return nil, err
+		}
+
+		// 재시도 가능한 오류: 백오프(또는 Retry-After) 후 다시 보낸다
+		delay := c.retryPolicy.backoff(attempt)
+		if retryAfter > 0 {
+			delay = retryAfter
+			if delay > c.retryPolicy.MaxBackoff {
+				delay = c.retryPolicy.MaxBackoff
+			}
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// send makes one attempt of a request. An error status is returned as *APIError together with
+// the delay requested by a Retry-After header.
+func (c *Client) send(ctx context.Context, method, path string, query url.Values, body []byte) ([]byte, time.Duration, error) {
+	req, err := c.newRequest(ctx, method, path, query, body)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s %s failed: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read response of %s %s: %w", method, path, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, parseRetryAfter(resp.Header.Get("Retry-After")), newAPIError(resp.StatusCode, data)
+	}
+	return data, 0, nil
+}
+
+// newRequest creates a request of an API path with the authentication and client headers
+func (c *Client) newRequest(ctx context.Context, method, path string, query url.Values, body []byte) (*http.Request, error) {
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	return req, nil
+}
+
+// newAPIError decodes the {"error": ..., "details": ...} body of an error response;
+// other bodies (e.g. from a proxy) become the message
+func newAPIError(statusCode int, data []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode}
+	if json.Unmarshal(data, apiErr) != nil || apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(data))
+		if apiErr.Message == "" {
+			apiErr.Message = http.StatusText(statusCode)
+		}
+	}
+	return apiErr
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds; HTTP dates are ignored
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// pathID escapes an ID for use as a path segment
+func pathID(id string) string {
+	return url.PathEscape(id)
+}
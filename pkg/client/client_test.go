@@ -0,0 +1,243 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/build"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"ai-storage-orchestrator/pkg/apis"
+	"ai-storage-orchestrator/pkg/auth"
+	"ai-storage-orchestrator/pkg/controller"
+	"ai-storage-orchestrator/pkg/eventbus"
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const adminToken = "admin-token"
+
+// newTestServer serves the real API routes with controllers that need no cluster
+func newTestServer(t *testing.T, bus *eventbus.Bus) (*httptest.Server, http.Handler) {
+	gin.SetMode(gin.TestMode)
+
+	migrationController := controller.NewMigrationController(nil)
+	h := apis.NewHandler(
+		migrationController,
+		controller.NewAutoscalingController(nil),
+		controller.NewLoadbalancingController(nil, migrationController),
+		controller.NewProvisioningController(nil),
+		controller.NewPreemptionController(nil),
+		controller.NewCachingController(nil),
+		controller.NewInsightController(),
+	)
+	h.SetWebhookController(controller.NewWebhookController())
+	if bus != nil {
+		h.SetEventBus(bus)
+	}
+	provider, err := auth.NewStaticTokenProvider([]auth.StaticToken{
+		{Name: "admin", Token: adminToken, Role: auth.RoleAdmin},
+	})
+	require.NoError(t, err)
+	h.SetAuthProvider(provider)
+
+	router := h.SetupRoutes()
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server, router
+}
+
+func newTestClient(url string) *Client {
+	c := NewClient(url)
+	c.SetToken(adminToken)
+	c.SetRetryPolicy(RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond})
+	return c
+}
+
+// TestClientAgainstHandler tests typed calls, API errors and pagination against the real routes
+func TestClientAgainstHandler(t *testing.T) {
+	server, _ := newTestServer(t, nil)
+	c := newTestClient(server.URL)
+	ctx := context.Background()
+
+	health, err := c.Health(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "healthy", health.Status)
+
+	for i := 0; i < 5; i++ {
+		_, err := c.ReportInsight(ctx, &types.InsightReport{
+			PodName:      fmt.Sprintf("trainer-%d", i),
+			PodNamespace: "ml",
+			Signature:    &types.WorkloadSignature{WorkloadType: "training", IOPattern: "read-heavy"},
+		})
+		require.NoError(t, err)
+	}
+
+	// Page through the signatures two at a time
+	var names []string
+	opts := ListOptions{Limit: 2}
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 5, "pagination did not terminate")
+		page, err := c.ListInsightSignaturesPage(ctx, opts)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(page.Items), 2)
+		for _, s := range page.Items {
+			names = append(names, s.PodName)
+		}
+		if page.Continue == "" {
+			break
+		}
+		opts.Continue = page.Continue
+	}
+	assert.Equal(t, []string{"trainer-0", "trainer-1", "trainer-2", "trainer-3", "trainer-4"}, names)
+
+	all, err := c.ListInsightSignatures(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 5)
+
+	signature, err := c.GetInsightSignature(ctx, "ml", "trainer-3")
+	require.NoError(t, err)
+	assert.Equal(t, "training", signature.WorkloadType)
+
+	_, err = c.GetMigration(ctx, "migration-missing")
+	assert.True(t, IsNotFound(err))
+	_, err = c.GetMigrationStatus(ctx, "migration-missing")
+	assert.True(t, IsNotFound(err))
+
+	_, err = c.ListMigrationsPage(ctx, ListOptions{Limit: 100000})
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, "Invalid limit parameter", apiErr.Message)
+
+	migrations, err := c.ListMigrations(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, migrations)
+
+	// Webhook CRUD
+	webhook, err := c.CreateWebhook(ctx, &types.WebhookRequest{Name: "ops", URL: "http://127.0.0.1:1/hook"})
+	require.NoError(t, err)
+	webhook, err = c.UpdateWebhook(ctx, webhook.WebhookID, &types.WebhookRequest{Name: "ops", URL: "http://127.0.0.1:1/hook", Disabled: true})
+	require.NoError(t, err)
+	assert.True(t, webhook.Disabled)
+	webhooks, err := c.ListWebhooks(ctx)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, webhook.WebhookID, webhooks[0].WebhookID)
+	require.NoError(t, c.DeleteWebhook(ctx, webhook.WebhookID))
+	_, err = c.GetWebhook(ctx, webhook.WebhookID)
+	assert.True(t, IsNotFound(err))
+
+	metrics, err := c.GetPrometheusMetrics(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, metrics, "# TYPE")
+
+	// Requests without a token are rejected by the authentication middleware
+	anonymous := NewClient(server.URL)
+	_, err = anonymous.ListMigrations(ctx)
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+}
+
+// TestRetry tests that idempotent requests are retried on 503 and POST requests are not
+func TestRetry(t *testing.T) {
+	_, router := newTestServer(t, nil)
+
+	var failures, calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if atomic.AddInt32(&failures, -1) >= 0 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		router.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+	c := newTestClient(server.URL)
+	ctx := context.Background()
+
+	atomic.StoreInt32(&failures, 2)
+	_, err := c.Health(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	atomic.StoreInt32(&calls, 0)
+	atomic.StoreInt32(&failures, 1)
+	_, err = c.ReportInsight(ctx, &types.InsightReport{PodName: "p", PodNamespace: "ml"})
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// Retries stop when the context is done
+	atomic.StoreInt32(&failures, 100)
+	c.SetRetryPolicy(RetryPolicy{MaxRetries: 100, MinBackoff: time.Second, MaxBackoff: time.Second})
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = c.Health(timeoutCtx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// TestStreamEvents tests event delivery, filtering and resuming from a last event ID
+func TestStreamEvents(t *testing.T) {
+	bus := eventbus.NewBus(eventbus.DefaultHistorySize)
+	server, _ := newTestServer(t, bus)
+	c := newTestClient(server.URL)
+
+	for _, step := range []string{"checkpoint", "transfer", "restore"} {
+		bus.Publish(eventbus.Event{Kind: eventbus.KindMigration, Type: eventbus.TypeStep, JobID: "migration-1", Step: step})
+	}
+	bus.Publish(eventbus.Event{Kind: eventbus.KindLoadbalancing, Type: eventbus.TypeCycle, JobID: "loadbalancing-1"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		bus.Publish(eventbus.Event{Kind: eventbus.KindMigration, Type: eventbus.TypeStep, JobID: "migration-1", Step: "live"})
+	}()
+
+	var steps []string
+	err := c.StreamEvents(ctx, eventbus.Filter{Kinds: []string{eventbus.KindMigration}}, 1, func(e *eventbus.Event) error {
+		steps = append(steps, e.Step)
+		if len(steps) == 3 {
+			return ErrStopStream
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	// Events 2 and 3 are replayed, the loadbalancing event is filtered out
+	assert.Equal(t, []string{"transfer", "restore", "live"}, steps)
+}
+
+// TestClientDependencies tests that the client only depends on the shared API types and event bus, so that
+// importing it does not pull the server side (client-go, controllers) into API consumers
+func TestClientDependencies(t *testing.T) {
+	const module = "ai-storage-orchestrator/"
+	seen := map[string]bool{}
+	var walk func(dir string)
+	walk = func(dir string) {
+		pkg, err := build.ImportDir(dir, 0)
+		require.NoError(t, err)
+		for _, path := range pkg.Imports {
+			assert.False(t, strings.HasPrefix(path, "k8s.io/") || strings.HasPrefix(path, "sigs.k8s.io/"),
+				"%s imports %s", dir, path)
+			if !strings.HasPrefix(path, module) || seen[path] {
+				continue
+			}
+			seen[path] = true
+			assert.Contains(t, []string{module + "pkg/types", module + "pkg/eventbus"}, path, "%s imports %s", dir, path)
+			walk(filepath.Join("..", "..", strings.TrimPrefix(path, module)))
+		}
+	}
+	walk(".")
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"ai-storage-orchestrator/pkg/eventbus"
+)
+
+// ErrStopStream is returned by an event handler to end StreamEvents without an error
+var ErrStopStream = errors.New("stop event stream")
+
+// maxEventSize bounds one event of the stream
+const maxEventSize = 1 << 20
+
+// StreamEvents subscribes to job progress (GET /api/v1/events) and calls fn for every event
+// matching filter. When lastEventID is not 0, the events published after it are replayed first.
+//
+// A dropped connection is resumed from the last received event, so no event is delivered twice
+// or lost while it is still in the server history. StreamEvents returns when ctx is done, when fn
+// returns an error (nil for ErrStopStream) or when the server rejects the subscription.
+func (c *Client) StreamEvents(ctx context.Context, filter eventbus.Filter, lastEventID uint64, fn func(*eventbus.Event) error) error {
+	query := url.Values{}
+	if len(filter.Kinds) > 0 {
+		query.Set("kind", strings.Join(filter.Kinds, ","))
+	}
+	if filter.JobID != "" {
+		query.Set("job_id", filter.JobID)
+	}
+	if filter.Namespace != "" {
+		query.Set("namespace", filter.Namespace)
+	}
+
+	// 스트림은 오래 유지되므로 HTTP 클라이언트의 타임아웃을 적용하지 않는다
+	httpClient := *c.httpClient
+	httpClient.Timeout = 0
+
+	attempt := 0
+	for {
+		received, fatal, err := c.streamOnce(ctx, &httpClient, query, &lastEventID, fn)
+		switch {
+		case errors.Is(fatal, ErrStopStream):
+			return nil
+		case fatal != nil:
+			return fatal
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case err != nil && !isReconnectable(err):
+			return err
+		}
+
+		if received {
+			attempt = 0
+		}
+		delay := c.retryPolicy.backoff(attempt)
+		if delay <= 0 {
+			delay = DefaultRetryPolicy.MinBackoff
+		}
+		attempt++
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// streamOnce reads the event stream until it ends and reports whether any event was received.
+// lastEventID is advanced after every event handed to fn. Errors of fn and undecodable events are
+// returned as fatal, since resuming would hit them again; err is the reason the stream ended.
+func (c *Client) streamOnce(ctx context.Context, httpClient *http.Client, query url.Values, lastEventID *uint64, fn func(*eventbus.Event) error) (received bool, fatal, err error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "/api/v1/events", query, nil)
+	if err != nil {
+		return false, err, nil
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if *lastEventID > 0 {
+		req.Header.Set("Last-Event-ID", strconv.FormatUint(*lastEventID, 10))
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return false, nil, fmt.Errorf("GET /api/v1/events failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(resp.Body)
+		return false, nil, newAPIError(resp.StatusCode, data)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventSize)
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			// 빈 줄이 이벤트 하나의 끝이다
+			if data.Len() == 0 {
+				continue
+			}
+			var event eventbus.Event
+			if err := json.Unmarshal([]byte(data.String()), &event); err != nil {
+				return received, fmt.Errorf("failed to decode event: %w", err), nil
+			}
+			data.Reset()
+			if err := fn(&event); err != nil {
+				return received, err, nil
+			}
+			received = true
+			*lastEventID = event.ID
+		case strings.HasPrefix(line, ":"):
+			// heartbeat comment
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+		// id: and event: lines repeat fields of the JSON data and are skipped
+	}
+	return received, nil, scanner.Err()
+}
+
+// isReconnectable reports whether the stream is resumed after err: transport errors and
+// transient statuses are, other API errors (e.g. 401, 400, a disabled event bus) are not
+func isReconnectable(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests ||
+			apiErr.StatusCode == http.StatusBadGateway ||
+			apiErr.StatusCode == http.StatusGatewayTimeout
+	}
+	return true
+}
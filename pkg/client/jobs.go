@@ -0,0 +1,291 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+)
+
+// ============================================================================
+// Migration
+// ============================================================================
+
+// CreateMigration starts a pod migration (POST /api/v1/migrations)
+func (c *Client) CreateMigration(ctx context.Context, req *types.MigrationRequest) (*types.MigrationResponse, error) {
+	var resp types.MigrationResponse
+	if err := c.do(ctx, http.MethodPost, "/api/v1/migrations", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetMigration returns a migration (GET /api/v1/migrations/:id)
+func (c *Client) GetMigration(ctx context.Context, id string) (*types.MigrationResponse, error) {
+	var resp types.MigrationResponse
+	if err := c.do(ctx, http.MethodGet, "/api/v1/migrations/"+pathID(id), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// MigrationStatus is the short status of a migration (GET /api/v1/migrations/:id/status)
+type MigrationStatus struct {
+	MigrationID     string                `json:"migration_id"`
+	Status          types.MigrationStatus `json:"status"`
+	Message         string                `json:"message"`
+	StartTime       *time.Time            `json:"start_time,omitempty"`
+	EndTime         *time.Time            `json:"end_time,omitempty"`
+	DurationSeconds float64               `json:"duration_seconds,omitempty"`
+}
+
+// GetMigrationStatus returns the status of a migration without its details (GET /api/v1/migrations/:id/status)
+func (c *Client) GetMigrationStatus(ctx context.Context, id string) (*MigrationStatus, error) {
+	var resp MigrationStatus
+	if err := c.do(ctx, http.MethodGet, "/api/v1/migrations/"+pathID(id)+"/status", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListMigrations returns every migration (GET /api/v1/migrations)
+func (c *Client) ListMigrations(ctx context.Context) ([]*types.MigrationResponse, error) {
+	return listAll[*types.MigrationResponse](ctx, c, "/api/v1/migrations", "migrations", nil)
+}
+
+// ListMigrationsPage returns one page of migrations (GET /api/v1/migrations?limit=&continue=)
+func (c *Client) ListMigrationsPage(ctx context.Context, opts ListOptions) (*Page[*types.MigrationResponse], error) {
+	return listPage[*types.MigrationResponse](ctx, c, "/api/v1/migrations", "migrations", nil, opts)
+}
+
+// CancelMigration cancels a running migration; the rollback continues asynchronously (DELETE /api/v1/migrations/:id)
+func (c *Client) CancelMigration(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/api/v1/migrations/"+pathID(id), nil, nil, nil)
+}
+
+// GetMigrationMetrics returns the migration metrics (GET /api/v1/metrics)
+func (c *Client) GetMigrationMetrics(ctx context.Context) (*types.MigrationMetrics, error) {
+	var resp types.MigrationMetrics
+	if err := c.do(ctx, http.MethodGet, "/api/v1/metrics", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ============================================================================
+// Autoscaling
+// ============================================================================
+
+// CreateAutoscaler creates an autoscaler (POST /api/v1/autoscaling)
+func (c *Client) CreateAutoscaler(ctx context.Context, req *types.AutoscalingRequest) (*types.AutoscalingResponse, error) {
+	var resp types.AutoscalingResponse
+	if err := c.do(ctx, http.MethodPost, "/api/v1/autoscaling", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetAutoscaler returns an autoscaler (GET /api/v1/autoscaling/:id)
+func (c *Client) GetAutoscaler(ctx context.Context, id string) (*types.AutoscalingResponse, error) {
+	var resp types.AutoscalingResponse
+	if err := c.do(ctx, http.MethodGet, "/api/v1/autoscaling/"+pathID(id), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAutoscalers returns every autoscaler (GET /api/v1/autoscaling)
+func (c *Client) ListAutoscalers(ctx context.Context) ([]*types.AutoscalingResponse, error) {
+	return listAll[*types.AutoscalingResponse](ctx, c, "/api/v1/autoscaling", "autoscalers", nil)
+}
+
+// ListAutoscalersPage returns one page of autoscalers (GET /api/v1/autoscaling?limit=&continue=)
+func (c *Client) ListAutoscalersPage(ctx context.Context, opts ListOptions) (*Page[*types.AutoscalingResponse], error) {
+	return listPage[*types.AutoscalingResponse](ctx, c, "/api/v1/autoscaling", "autoscalers", nil, opts)
+}
+
+// DeleteAutoscaler deletes an autoscaler (DELETE /api/v1/autoscaling/:id)
+func (c *Client) DeleteAutoscaler(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/api/v1/autoscaling/"+pathID(id), nil, nil, nil)
+}
+
+// WakeAutoscaler signals activity to an autoscaler with scale to zero (POST /api/v1/autoscaling/:id/wake)
+func (c *Client) WakeAutoscaler(ctx context.Context, id string, req *types.WakeRequest) (*types.AutoscalingResponse, error) {
+	var resp types.AutoscalingResponse
+	if err := c.do(ctx, http.MethodPost, "/api/v1/autoscaling/"+pathID(id)+"/wake", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// PreviewAutoscalerSchedule returns the replica bounds of an autoscaler over the next 24 hours
+// (GET /api/v1/autoscaling/:id/schedule)
+func (c *Client) PreviewAutoscalerSchedule(ctx context.Context, id string) (*types.SchedulePreview, error) {
+	var resp types.SchedulePreview
+	if err := c.do(ctx, http.MethodGet, "/api/v1/autoscaling/"+pathID(id)+"/schedule", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetAutoscalingMetrics returns the autoscaling metrics (GET /api/v1/autoscaling/metrics)
+func (c *Client) GetAutoscalingMetrics(ctx context.Context) (*types.AutoscalingMetrics, error) {
+	var resp types.AutoscalingMetrics
+	if err := c.do(ctx, http.MethodGet, "/api/v1/autoscaling/metrics", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ============================================================================
+// Loadbalancing
+// ============================================================================
+
+// StartLoadbalancing starts a loadbalancing job (POST /api/v1/loadbalancing)
+func (c *Client) StartLoadbalancing(ctx context.Context, req *types.LoadbalancingRequest) (*types.LoadbalancingResponse, error) {
+	var resp types.LoadbalancingResponse
+	if err := c.do(ctx, http.MethodPost, "/api/v1/loadbalancing", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// PlanLoadbalancing previews the migrations a loadbalancing job would make (POST /api/v1/loadbalancing/plan)
+func (c *Client) PlanLoadbalancing(ctx context.Context, req *types.LoadbalancingRequest) (*types.LoadbalancingPlan, error) {
+	var resp types.LoadbalancingPlan
+	if err := c.do(ctx, http.MethodPost, "/api/v1/loadbalancing/plan", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetLoadbalancing returns a loadbalancing job (GET /api/v1/loadbalancing/:id)
+func (c *Client) GetLoadbalancing(ctx context.Context, id string) (*types.LoadbalancingResponse, error) {
+	var resp types.LoadbalancingResponse
+	if err := c.do(ctx, http.MethodGet, "/api/v1/loadbalancing/"+pathID(id), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListLoadbalancing returns every loadbalancing job (GET /api/v1/loadbalancing)
+func (c *Client) ListLoadbalancing(ctx context.Context) ([]*types.LoadbalancingResponse, error) {
+	return listAll[*types.LoadbalancingResponse](ctx, c, "/api/v1/loadbalancing", "loadbalancing_jobs", nil)
+}
+
+// ListLoadbalancingPage returns one page of loadbalancing jobs (GET /api/v1/loadbalancing?limit=&continue=)
+func (c *Client) ListLoadbalancingPage(ctx context.Context, opts ListOptions) (*Page[*types.LoadbalancingResponse], error) {
+	return listPage[*types.LoadbalancingResponse](ctx, c, "/api/v1/loadbalancing", "loadbalancing_jobs", nil, opts)
+}
+
+// CancelLoadbalancing cancels a loadbalancing job (DELETE /api/v1/loadbalancing/:id)
+func (c *Client) CancelLoadbalancing(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/api/v1/loadbalancing/"+pathID(id), nil, nil, nil)
+}
+
+// GetLoadbalancingMetrics returns the loadbalancing metrics (GET /api/v1/loadbalancing/metrics)
+func (c *Client) GetLoadbalancingMetrics(ctx context.Context) (*types.LoadbalancingMetrics, error) {
+	var resp types.LoadbalancingMetrics
+	if err := c.do(ctx, http.MethodGet, "/api/v1/loadbalancing/metrics", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ============================================================================
+// Provisioning
+// ============================================================================
+
+// CreateProvisioning provisions storage for a workload (POST /api/v1/provisioning)
+func (c *Client) CreateProvisioning(ctx context.Context, req *types.ProvisioningRequest) (*types.ProvisioningResponse, error) {
+	var resp types.ProvisioningResponse
+	if err := c.do(ctx, http.MethodPost, "/api/v1/provisioning", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetProvisioning returns a provisioning (GET /api/v1/provisioning/:id)
+func (c *Client) GetProvisioning(ctx context.Context, id string) (*types.ProvisioningResponse, error) {
+	var resp types.ProvisioningResponse
+	if err := c.do(ctx, http.MethodGet, "/api/v1/provisioning/"+pathID(id), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListProvisionings returns every provisioning (GET /api/v1/provisioning)
+func (c *Client) ListProvisionings(ctx context.Context) ([]*types.ProvisioningResponse, error) {
+	return listAll[*types.ProvisioningResponse](ctx, c, "/api/v1/provisioning", "provisionings", nil)
+}
+
+// ListProvisioningsPage returns one page of provisionings (GET /api/v1/provisioning?limit=&continue=)
+func (c *Client) ListProvisioningsPage(ctx context.Context, opts ListOptions) (*Page[*types.ProvisioningResponse], error) {
+	return listPage[*types.ProvisioningResponse](ctx, c, "/api/v1/provisioning", "provisionings", nil, opts)
+}
+
+// DeleteProvisioning deletes a provisioning and its PVC (DELETE /api/v1/provisioning/:id)
+func (c *Client) DeleteProvisioning(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/api/v1/provisioning/"+pathID(id), nil, nil, nil)
+}
+
+// GetProvisioningRecommendation returns the storage recommended for a workload type
+// (GET /api/v1/provisioning/recommend/:workload_type)
+func (c *Client) GetProvisioningRecommendation(ctx context.Context, workloadType string) (*types.WorkloadStorageRecommendation, error) {
+	var resp types.WorkloadStorageRecommendation
+	if err := c.do(ctx, http.MethodGet, "/api/v1/provisioning/recommend/"+pathID(workloadType), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetProvisioningMetrics returns the provisioning metrics (GET /api/v1/provisioning/metrics)
+func (c *Client) GetProvisioningMetrics(ctx context.Context) (*types.ProvisioningMetrics, error) {
+	var resp types.ProvisioningMetrics
+	if err := c.do(ctx, http.MethodGet, "/api/v1/provisioning/metrics", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ============================================================================
+// Preemption
+// ============================================================================
+
+// StartPreemption starts a preemption job (POST /api/v1/preemption)
+func (c *Client) StartPreemption(ctx context.Context, req *types.PreemptionRequest) (*types.PreemptionResponse, error) {
+	var resp types.PreemptionResponse
+	if err := c.do(ctx, http.MethodPost, "/api/v1/preemption", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetPreemption returns a preemption job (GET /api/v1/preemption/:id)
+func (c *Client) GetPreemption(ctx context.Context, id string) (*types.PreemptionResponse, error) {
+	var resp types.PreemptionResponse
+	if err := c.do(ctx, http.MethodGet, "/api/v1/preemption/"+pathID(id), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListPreemptions returns every preemption job (GET /api/v1/preemption)
+func (c *Client) ListPreemptions(ctx context.Context) ([]*types.PreemptionResponse, error) {
+	return listAll[*types.PreemptionResponse](ctx, c, "/api/v1/preemption", "preemptions", nil)
+}
+
+// ListPreemptionsPage returns one page of preemptions (GET /api/v1/preemption?limit=&continue=)
+func (c *Client) ListPreemptionsPage(ctx context.Context, opts ListOptions) (*Page[*types.PreemptionResponse], error) {
+	return listPage[*types.PreemptionResponse](ctx, c, "/api/v1/preemption", "preemptions", nil, opts)
+}
+
+// GetPreemptionMetrics returns the preemption metrics (GET /api/v1/preemption/metrics)
+func (c *Client) GetPreemptionMetrics(ctx context.Context) (*types.PreemptionMetrics, error) {
+	var resp types.PreemptionMetrics
+	if err := c.do(ctx, http.MethodGet, "/api/v1/preemption/metrics", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
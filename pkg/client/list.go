@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// listPageSize is the page size used by the List methods that return every item
+const listPageSize = 200
+
+// ListOptions selects a page of a list endpoint
+type ListOptions struct {
+	// Limit is the maximum number of items of the page; 0 returns every item
+	Limit int
+	// Continue is the token of the previous page, from Page.Continue
+	Continue string
+}
+
+// Page is one page of a list endpoint. Paged lists are ordered by ID.
+type Page[T any] struct {
+	Items []T
+	// Continue is the token of the next page; empty on the last page
+	Continue string
+}
+
+// listPage requests one page of a list endpoint whose items are returned under key
+func listPage[T any](ctx context.Context, c *Client, path, key string, query url.Values, opts ListOptions) (*Page[T], error) {
+	q := url.Values{}
+	for name, values := range query {
+		q[name] = values
+	}
+	if opts.Limit > 0 {
+		q.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	if opts.Continue != "" {
+		q.Set("continue", opts.Continue)
+	}
+
+	var resp map[string]json.RawMessage
+	if err := c.do(ctx, http.MethodGet, path, q, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	page := &Page[T]{}
+	if raw, ok := resp[key]; ok {
+		if err := json.Unmarshal(raw, &page.Items); err != nil {
+			return nil, fmt.Errorf("failed to decode %s of GET %s: %w", key, path, err)
+		}
+	}
+	if raw, ok := resp["continue"]; ok {
+		if err := json.Unmarshal(raw, &page.Continue); err != nil {
+			return nil, fmt.Errorf("failed to decode continue token of GET %s: %w", path, err)
+		}
+	}
+	return page, nil
+}
+
+// listAll follows the continue tokens of a list endpoint and returns every item
+func listAll[T any](ctx context.Context, c *Client, path, key string, query url.Values) ([]T, error) {
+	items := []T{}
+	opts := ListOptions{Limit: listPageSize}
+	for {
+		page, err := listPage[T](ctx, c, path, key, query, opts)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+		if page.Continue == "" {
+			return items, nil
+		}
+		opts.Continue = page.Continue
+	}
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+)
+
+// ============================================================================
+// Health / Prometheus metrics
+// ============================================================================
+
+// Health is the response of the health endpoint
+type Health struct {
+	Status  string `json:"status"`
+	Service string `json:"service"`
+	Version string `json:"version"`
+}
+
+// Health checks that the orchestrator is up (GET /health)
+func (c *Client) Health(ctx context.Context) (*Health, error) {
+	var resp Health
+	if err := c.do(ctx, http.MethodGet, "/health", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetPrometheusMetrics returns the metrics of every controller in the Prometheus text format (GET /metrics)
+func (c *Client) GetPrometheusMetrics(ctx context.Context) (string, error) {
+	data, err := c.doRaw(ctx, http.MethodGet, "/metrics", nil, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ============================================================================
+// Audit
+// ============================================================================
+
+// QueryAudit returns the audit entries matching filter, newest first (GET /api/v1/audit).
+// A zero Limit returns the server default of types.DefaultAuditQueryLimit entries.
+func (c *Client) QueryAudit(ctx context.Context, filter types.AuditFilter) ([]*types.AuditEntry, error) {
+	query := url.Values{}
+	for name, value := range map[string]string{
+		"namespace": filter.Namespace,
+		"action":    filter.Action,
+		"actor":     filter.Actor,
+		"job_id":    filter.JobID,
+	} {
+		if value != "" {
+			query.Set(name, value)
+		}
+	}
+	if !filter.Since.IsZero() {
+		query.Set("since", filter.Since.Format(time.RFC3339))
+	}
+	if !filter.Until.IsZero() {
+		query.Set("until", filter.Until.Format(time.RFC3339))
+	}
+	if filter.Limit > 0 {
+		query.Set("limit", strconv.Itoa(filter.Limit))
+	}
+
+	var resp struct {
+		Entries []*types.AuditEntry `json:"entries"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/audit", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Entries, nil
+}
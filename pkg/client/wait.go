@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+)
+
+// DefaultPollInterval is the polling interval of the WaitFor helpers when 0 is given
+const DefaultPollInterval = 2 * time.Second
+
+// WaitForMigration polls a migration until it is completed, failed or cancelled and returns it
+// in that state. Check Status for the outcome; errors are API errors or ctx.Err().
+func (c *Client) WaitForMigration(ctx context.Context, id string, interval time.Duration) (*types.MigrationResponse, error) {
+	return poll(ctx, interval, func() (*types.MigrationResponse, bool, error) {
+		m, err := c.GetMigration(ctx, id)
+		if err != nil {
+			return nil, false, err
+		}
+		switch m.Status {
+		case types.MigrationStatusCompleted, types.MigrationStatusFailed, types.MigrationStatusCancelled:
+			return m, true, nil
+		}
+		return m, false, nil
+	})
+}
+
+// WaitForLoadbalancing polls a loadbalancing job until it is completed, failed or cancelled.
+// Continuous jobs only finish when cancelled.
+func (c *Client) WaitForLoadbalancing(ctx context.Context, id string, interval time.Duration) (*types.LoadbalancingResponse, error) {
+	return poll(ctx, interval, func() (*types.LoadbalancingResponse, bool, error) {
+		job, err := c.GetLoadbalancing(ctx, id)
+		if err != nil {
+			return nil, false, err
+		}
+		switch job.Status {
+		case types.LoadbalancingStatusCompleted, types.LoadbalancingStatusFailed, types.LoadbalancingStatusCancelled:
+			return job, true, nil
+		}
+		return job, false, nil
+	})
+}
+
+// WaitForProvisioning polls a provisioning until its storage is ready or it failed
+func (c *Client) WaitForProvisioning(ctx context.Context, id string, interval time.Duration) (*types.ProvisioningResponse, error) {
+	return poll(ctx, interval, func() (*types.ProvisioningResponse, bool, error) {
+		p, err := c.GetProvisioning(ctx, id)
+		if err != nil {
+			return nil, false, err
+		}
+		switch p.Status {
+		case types.ProvisioningStatusReady, types.ProvisioningStatusFailed:
+			return p, true, nil
+		}
+		return p, false, nil
+	})
+}
+
+// WaitForPreemption polls a preemption until it is completed or failed
+func (c *Client) WaitForPreemption(ctx context.Context, id string, interval time.Duration) (*types.PreemptionResponse, error) {
+	return poll(ctx, interval, func() (*types.PreemptionResponse, bool, error) {
+		p, err := c.GetPreemption(ctx, id)
+		if err != nil {
+			return nil, false, err
+		}
+		switch p.Status {
+		case types.PreemptionStatusCompleted, types.PreemptionStatusFailed:
+			return p, true, nil
+		}
+		return p, false, nil
+	})
+}
+
+// poll calls check every interval until it reports done or fails
+func poll[T any](ctx context.Context, interval time.Duration, check func() (T, bool, error)) (T, error) {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	for {
+		obj, done, err := check()
+		if err != nil || done {
+			return obj, err
+		}
+		if err := sleep(ctx, interval); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"ai-storage-orchestrator/pkg/types"
+)
+
+// ============================================================================
+// Webhooks (admin)
+// ============================================================================
+
+// CreateWebhook registers a webhook target (POST /api/v1/webhooks)
+func (c *Client) CreateWebhook(ctx context.Context, req *types.WebhookRequest) (*types.WebhookResponse, error) {
+	var resp types.WebhookResponse
+	if err := c.do(ctx, http.MethodPost, "/api/v1/webhooks", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetWebhook returns a webhook target (GET /api/v1/webhooks/:id)
+func (c *Client) GetWebhook(ctx context.Context, id string) (*types.WebhookResponse, error) {
+	var resp types.WebhookResponse
+	if err := c.do(ctx, http.MethodGet, "/api/v1/webhooks/"+pathID(id), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListWebhooks returns every webhook target (GET /api/v1/webhooks)
+func (c *Client) ListWebhooks(ctx context.Context) ([]*types.WebhookResponse, error) {
+	return listAll[*types.WebhookResponse](ctx, c, "/api/v1/webhooks", "webhooks", nil)
+}
+
+// ListWebhooksPage returns one page of webhook targets (GET /api/v1/webhooks?limit=&continue=)
+func (c *Client) ListWebhooksPage(ctx context.Context, opts ListOptions) (*Page[*types.WebhookResponse], error) {
+	return listPage[*types.WebhookResponse](ctx, c, "/api/v1/webhooks", "webhooks", nil, opts)
+}
+
+// UpdateWebhook replaces the settings of a webhook target; an empty secret keeps the current one
+// (PUT /api/v1/webhooks/:id)
+func (c *Client) UpdateWebhook(ctx context.Context, id string, req *types.WebhookRequest) (*types.WebhookResponse, error) {
+	var resp types.WebhookResponse
+	if err := c.do(ctx, http.MethodPut, "/api/v1/webhooks/"+pathID(id), nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteWebhook removes a webhook target (DELETE /api/v1/webhooks/:id)
+func (c *Client) DeleteWebhook(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/api/v1/webhooks/"+pathID(id), nil, nil, nil)
+}
+
+// ListWebhookDeadLetters returns the deliveries that failed after all retries, of every target
+// when webhookID is empty (GET /api/v1/webhooks/deadletters)
+func (c *Client) ListWebhookDeadLetters(ctx context.Context, webhookID string) ([]*types.WebhookDeadLetter, error) {
+	return listAll[*types.WebhookDeadLetter](ctx, c, "/api/v1/webhooks/deadletters", "deadletters", deadLetterQuery(webhookID))
+}
+
+// ListWebhookDeadLettersPage returns one page of dead letters (GET /api/v1/webhooks/deadletters?limit=&continue=)
+func (c *Client) ListWebhookDeadLettersPage(ctx context.Context, webhookID string, opts ListOptions) (*Page[*types.WebhookDeadLetter], error) {
+	return listPage[*types.WebhookDeadLetter](ctx, c, "/api/v1/webhooks/deadletters", "deadletters", deadLetterQuery(webhookID), opts)
+}
+
+func deadLetterQuery(webhookID string) url.Values {
+	if webhookID == "" {
+		return nil
+	}
+	return url.Values{"webhook_id": {webhookID}}
+}
@@ -0,0 +1,17 @@
+package controller
+
+import (
+	"log"
+
+	"ai-storage-orchestrator/pkg/audit"
+)
+
+// recordAudit writes an audit entry if an audit log is configured; failures are only logged
+func recordAudit(recorder audit.Recorder, entry *audit.Entry) {
+	if recorder == nil {
+		return
+	}
+	if err := recorder.Record(entry); err != nil {
+		log.Printf("Warning: Failed to record audit entry for %s: %v", entry.Action, err)
+	}
+}
@@ -2,14 +2,20 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"sync"
 	"time"
 
+	"ai-storage-orchestrator/pkg/k8s"
+	"ai-storage-orchestrator/pkg/eventbus"
+	"ai-storage-orchestrator/pkg/schedule"
+	"ai-storage-orchestrator/pkg/store"
 	"ai-storage-orchestrator/pkg/types"
 
 	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
 )
 
 // AutoscalingController manages autoscaling for workloads
@@ -18,6 +24,9 @@ type AutoscalingController struct {
 	autoscalers    map[string]*AutoscalingJob
 	autoscalersMux sync.RWMutex
 	metrics        *types.AutoscalingMetrics
+	jobStore       store.JobStore
+	events         *eventbus.Bus
+	coldStarts     int64 // cold starts in metrics.AverageColdStartSeconds
 }
 
 // AutoscalingJob represents an active autoscaling configuration
@@ -33,6 +42,18 @@ type AutoscalingJob struct {
 	// Stabilization tracking
 	scaleUpHistory   []scaleRecommendation
 	scaleDownHistory []scaleRecommendation
+
+	// Replica changes made by the autoscaler, for the rate limits of the scaling policies
+	scaleEvents []scaleEvent
+
+	// Predictive scaling (nil when off); the metric history is rebuilt after a restart
+	forecaster *demandForecaster
+
+	// Wake requests of a workload scaled to zero, handed to the monitoring loop
+	wakeCh chan string
+
+	// Scheduled scaling windows, in the order of Request.Schedules
+	schedules []*schedule.Window
 }
 
 // scaleRecommendation represents a scaling recommendation with timestamp
@@ -50,7 +71,106 @@ func NewAutoscalingController(k8sClient K8sClientInterface) *AutoscalingControll
 			TotalAutoscalers:  0,
 			ActiveAutoscalers: 0,
 		},
+		jobStore: store.NewNopStore(),
+	}
+}
+
+// SetJobStore configures the store that autoscalers are written through
+func (ac *AutoscalingController) SetJobStore(s store.JobStore) {
+	ac.jobStore = s
+}
+
+// SetEventBus publishes the scale operations of every autoscaler
+func (ac *AutoscalingController) SetEventBus(bus *eventbus.Bus) {
+	ac.events = bus
+}
+
+// RestoreJobs reloads persisted autoscalers and restarts the monitoring loop of active ones
+func (ac *AutoscalingController) RestoreJobs() error {
+	restored := 0
+	err := ac.jobStore.ForEach(store.KindAutoscaling, func(id string, data []byte) error {
+		var rec autoscalingRecord
+		if err := json.Unmarshal(data, &rec); err != nil {
+			log.Printf("Warning: Skipping corrupt autoscaler record %s: %v", id, err)
+			return nil
+		}
+		if rec.Request == nil {
+			log.Printf("Warning: Skipping autoscaler record %s without a request", id)
+			return nil
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		job := &AutoscalingJob{
+			ID:               rec.ID,
+			Request:          rec.Request,
+			Status:           rec.Status,
+			Details:          rec.Details,
+			CreatedAt:        rec.CreatedAt,
+			ctx:              ctx,
+			cancel:           cancel,
+			scaleUpHistory:   make([]scaleRecommendation, 0),
+			scaleDownHistory: make([]scaleRecommendation, 0),
+			wakeCh:           make(chan string, 1),
+		}
+		if job.Details == nil {
+			job.Details = &types.AutoscalingDetails{CreatedAt: rec.CreatedAt}
+		}
+		if job.Request.ScaleToZero != nil && job.Details.ScaleToZero == nil {
+			job.Details.ScaleToZero = &types.ScaleToZeroStatus{}
+		}
+		if forecaster, err := newAutoscalingForecaster(job.Request); err != nil {
+			log.Printf("Warning: Autoscaler %s: predictive scaling disabled: %v", job.ID, err)
+		} else {
+			job.forecaster = forecaster
+		}
+		if windows, err := newScheduleWindows(job.Request.Schedules); err != nil {
+			log.Printf("Warning: Autoscaler %s: scheduled scaling disabled: %v", job.ID, err)
+		} else {
+			job.schedules = windows
+		}
+
+		ac.autoscalersMux.Lock()
+		ac.autoscalers[job.ID] = job
+		ac.metrics.TotalAutoscalers++
+		ac.metrics.TotalScaleUps += job.Details.ScaleUpCount
+		ac.metrics.TotalScaleDowns += job.Details.ScaleDownCount
+		if job.Details.ScaleToZero != nil {
+			ac.metrics.TotalScaleToZero += job.Details.ScaleToZero.ScaleToZeroCount
+			ac.metrics.TotalWakes += job.Details.ScaleToZero.WakeCount
+		}
+		if job.Status == types.AutoscalingStatusActive {
+			ac.metrics.ActiveAutoscalers++
+		}
+		ac.autoscalersMux.Unlock()
+
+		if job.Status == types.AutoscalingStatusActive {
+			go ac.runAutoscaler(job)
+		} else {
+			cancel()
+		}
+		restored++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore autoscalers: %w", err)
 	}
+
+	log.Printf("Restored %d autoscalers", restored)
+	return nil
+}
+
+// persistJob writes the current state of an autoscaler to the job store
+func (ac *AutoscalingController) persistJob(job *AutoscalingJob) {
+	ac.autoscalersMux.RLock()
+	defer ac.autoscalersMux.RUnlock()
+
+	saveRecord(ac.jobStore, store.KindAutoscaling, job.ID, &autoscalingRecord{
+		ID:        job.ID,
+		Request:   job.Request,
+		Status:    job.Status,
+		Details:   job.Details,
+		CreatedAt: job.CreatedAt,
+	})
 }
 
 // CreateAutoscaler creates a new autoscaler for a workload
@@ -60,6 +180,15 @@ func (ac *AutoscalingController) CreateAutoscaler(req *types.AutoscalingRequest)
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	forecaster, err := newAutoscalingForecaster(req)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	windows, err := newScheduleWindows(req.Schedules)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
 	// Generate unique autoscaler ID
 	autoscalerID := fmt.Sprintf("autoscaler-%s", uuid.New().String()[:8])
 
@@ -86,6 +215,15 @@ func (ac *AutoscalingController) CreateAutoscaler(req *types.AutoscalingRequest)
 		cancel:           cancel,
 		scaleUpHistory:   make([]scaleRecommendation, 0),
 		scaleDownHistory: make([]scaleRecommendation, 0),
+		forecaster:       forecaster,
+		wakeCh:           make(chan string, 1),
+		schedules:        windows,
+	}
+	if forecaster != nil {
+		job.Details.Forecast = forecaster.status()
+	}
+	if req.ScaleToZero != nil {
+		job.Details.ScaleToZero = &types.ScaleToZeroStatus{}
 	}
 
 	// Store autoscaler job
@@ -94,6 +232,7 @@ func (ac *AutoscalingController) CreateAutoscaler(req *types.AutoscalingRequest)
 	ac.metrics.TotalAutoscalers++
 	ac.metrics.ActiveAutoscalers++
 	ac.autoscalersMux.Unlock()
+	ac.persistJob(job)
 
 	// Start autoscaler in background
 	go ac.runAutoscaler(job)
@@ -146,6 +285,7 @@ func (ac *AutoscalingController) DeleteAutoscaler(autoscalerID string) error {
 
 	delete(ac.autoscalers, autoscalerID)
 	ac.autoscalersMux.Unlock()
+	deleteRecord(ac.jobStore, store.KindAutoscaling, autoscalerID)
 
 	log.Printf("Autoscaler %s deleted", autoscalerID)
 	return nil
@@ -153,7 +293,7 @@ func (ac *AutoscalingController) DeleteAutoscaler(autoscalerID string) error {
 
 // runAutoscaler monitors and scales the workload
 func (ac *AutoscalingController) runAutoscaler(job *AutoscalingJob) {
-	ticker := time.NewTicker(15 * time.Second) // Check every 15 seconds
+	ticker := time.NewTicker(autoscalerInterval)
 	defer ticker.Stop()
 
 	log.Printf("Autoscaler %s started monitoring %s/%s",
@@ -165,7 +305,21 @@ func (ac *AutoscalingController) runAutoscaler(job *AutoscalingJob) {
 			log.Printf("Autoscaler %s stopped", job.ID)
 			return
 
+		case reason := <-job.wakeCh:
+			ac.wakeWorkload(job, reason)
+			ac.persistJob(job)
+
 		case <-ticker.C:
+			// Scheduled windows override min/max replicas; an opening window wakes a workload scaled to zero
+			if len(job.schedules) > 0 {
+				ac.applySchedules(job, time.Now())
+			}
+
+			// A workload scaled to zero has no metrics; a waking one is held until its first replica is ready
+			if job.Request.ScaleToZero != nil && ac.holdWhileScaledToZero(job) {
+				continue
+			}
+
 			// Get current workload status
 			currentReplicas, err := ac.getCurrentReplicas(job)
 			if err != nil {
@@ -173,11 +327,25 @@ func (ac *AutoscalingController) runAutoscaler(job *AutoscalingJob) {
 				continue
 			}
 
-			// Get current resource utilization (including storage I/O)
-			cpuUtil, memUtil, gpuUtil, storageRead, storageWrite, storageIOPS, err := ac.getResourceUtilization(job)
-			if err != nil {
-				log.Printf("Autoscaler %s: Failed to get resource utilization: %v", job.ID, err)
-				continue
+			// Get current resource utilization (including storage I/O); an autoscaler on custom metrics
+			// only does not read the pod metrics, so their outage does not stop it
+			var cpuUtil, memUtil, gpuUtil int32
+			var storageRead, storageWrite, storageIOPS int64
+			var signals types.WorkloadMetricsProvenance
+			metricsMessage := "autoscaler scales on custom metrics only"
+			readMetrics := usesWorkloadMetrics(job)
+			if readMetrics {
+				cpuUtil, memUtil, gpuUtil, storageRead, storageWrite, storageIOPS, signals, err = ac.getResourceUtilization(job)
+				if err != nil {
+					log.Printf("Autoscaler %s: Failed to get resource utilization: %v", job.ID, err)
+					continue
+				}
+			}
+
+			// Only the signals the autoscaler scales on have to be real
+			provenance := targetProvenance(job.Request, signals)
+			if readMetrics {
+				metricsMessage = fmt.Sprintf("workload metrics are %s (%s)", provenance, signals)
 			}
 
 			// Update details
@@ -189,24 +357,82 @@ func (ac *AutoscalingController) runAutoscaler(job *AutoscalingJob) {
 			job.Details.CurrentStorageReadThroughput = storageRead
 			job.Details.CurrentStorageWriteThroughput = storageWrite
 			job.Details.CurrentStorageIOPS = storageIOPS
+			job.Details.MetricsProvenance = provenance
+			job.Details.Conditions = types.SetCondition(job.Details.Conditions,
+				types.MetricsCondition(provenance, metricsMessage))
 			now := time.Now()
 			job.Details.UpdatedAt = &now
 			ac.autoscalersMux.Unlock()
 
+			// Never scale on stale or simulated metrics
+			if !provenance.IsReal() {
+				log.Printf("Autoscaler %s: Skipping scaling decision, workload metrics are %s", job.ID, provenance)
+				ac.persistJob(job)
+				continue
+			}
+
+			// Scale to zero after the idle timeout without traffic or I/O, never inside a scheduled window
+			if job.Request.ScaleToZero != nil && job.Details.ActiveSchedule == "" &&
+				ac.scaleToZeroIfIdle(job, currentReplicas, cpuUtil, gpuUtil, storageRead, storageWrite, storageIOPS, signals) {
+				ac.persistJob(job)
+				continue
+			}
+
+			// Predictive scaling: scale on the forecast GPU/storage read demand when it is higher
+			scaleGPU, scaleRead := gpuUtil, storageRead
+			if job.forecaster != nil {
+				scaleGPU, scaleRead = job.forecaster.observe(time.Now(), currentReplicas, gpuUtil, storageRead)
+				ac.autoscalersMux.Lock()
+				job.Details.Forecast = job.forecaster.status()
+				ac.autoscalersMux.Unlock()
+				if scaleGPU != gpuUtil || scaleRead != storageRead {
+					log.Printf("Autoscaler %s: Forecast ahead of demand (GPU %d%% -> %d%%, storage read %d -> %d MB/s)",
+						job.ID, gpuUtil, scaleGPU, storageRead, scaleRead)
+				}
+			}
+
+			// Custom metrics: PromQL targets evaluated alongside the built-in ones
+			if len(job.Request.CustomMetrics) > 0 {
+				customMetrics := evaluateCustomMetrics(ac.k8sClient.QueryMetric,
+					job.Request.WorkloadNamespace, job.Request.WorkloadName, currentReplicas, job.Request.CustomMetrics,
+					newScalingRules(job.Request.ScaleUpPolicy), newScalingRules(job.Request.ScaleDownPolicy))
+				for _, m := range customMetrics {
+					if m.Error != "" {
+						log.Printf("Autoscaler %s: Custom metric %s unavailable, holding scale-down: %s", job.ID, m.Name, m.Error)
+					} else {
+						log.Printf("Autoscaler %s: Custom metric %s = %g (target %s %g) -> %d replicas",
+							job.ID, m.Name, m.CurrentValue, m.TargetType, m.TargetValue, m.DesiredReplicas)
+					}
+				}
+				ac.autoscalersMux.Lock()
+				job.Details.CustomMetrics = customMetrics
+				ac.autoscalersMux.Unlock()
+			}
+
 			// Decide if scaling is needed (consider all resources including storage I/O)
-			desiredReplicas := ac.calculateDesiredReplicas(job, cpuUtil, memUtil, gpuUtil, storageRead, storageWrite, storageIOPS)
+			desiredReplicas := ac.calculateDesiredReplicas(job, cpuUtil, memUtil, scaleGPU, scaleRead, storageWrite, storageIOPS)
 
 			// Apply stabilization window to prevent flapping
 			stabilizedReplicas := ac.applyStabilizationWindow(job, currentReplicas, desiredReplicas)
 
+			// The bounds of a schedule apply at once, whatever the stabilization window recommends
+			minReplicas, maxReplicas := effectiveBounds(job)
+			if stabilizedReplicas < minReplicas {
+				stabilizedReplicas = minReplicas
+			}
+			if stabilizedReplicas > maxReplicas {
+				stabilizedReplicas = maxReplicas
+			}
+
 			if stabilizedReplicas != currentReplicas {
-				if err := ac.scaleWorkload(job, stabilizedReplicas); err != nil {
+				if err := ac.scaleWorkload(job, currentReplicas, stabilizedReplicas); err != nil {
 					log.Printf("Autoscaler %s: Failed to scale workload: %v", job.ID, err)
 				} else {
 					ac.autoscalersMux.Lock()
 					job.Details.DesiredReplicas = stabilizedReplicas
 					scaleTime := time.Now()
 					job.Details.LastScaleTime = &scaleTime
+					job.scaleEvents = recordScaleEvent(job.scaleEvents, currentReplicas, stabilizedReplicas, scaleTime)
 
 					if stabilizedReplicas > currentReplicas {
 						job.Details.ScaleUpCount++
@@ -222,10 +448,12 @@ func (ac *AutoscalingController) runAutoscaler(job *AutoscalingJob) {
 					ac.autoscalersMux.Unlock()
 				}
 			} else if desiredReplicas != currentReplicas {
-				// Log when stabilization window prevents scaling
-				log.Printf("Autoscaler %s: Scaling from %d to %d replicas delayed by stabilization window",
+				// Log when stabilization window, tolerance or scaling policies prevent scaling
+				log.Printf("Autoscaler %s: Scaling from %d to %d replicas held by stabilization window or scaling policy",
 					job.ID, currentReplicas, desiredReplicas)
 			}
+
+			ac.persistJob(job)
 		}
 	}
 }
@@ -241,27 +469,31 @@ func (ac *AutoscalingController) calculateDesiredReplicas(job *AutoscalingJob, c
 	var desiredReplicas int32 = currentReplicas
 	recommendations := []int32{}
 
+	// Tolerance applies to the ratio of each metric to its target
+	up := newScalingRules(job.Request.ScaleUpPolicy)
+	down := newScalingRules(job.Request.ScaleDownPolicy)
+
 	// Calculate based on CPU if target is set
 	if job.Request.TargetCPU > 0 && cpuUtil > 0 {
-		cpuDesired := int32(float64(currentReplicas) * float64(cpuUtil) / float64(job.Request.TargetCPU))
+		cpuDesired := metricReplicas(currentReplicas, float64(cpuUtil), float64(job.Request.TargetCPU), up, down)
 		recommendations = append(recommendations, cpuDesired)
 	}
 
 	// Calculate based on Memory if target is set
 	if job.Request.TargetMemory > 0 && memUtil > 0 {
-		memDesired := int32(float64(currentReplicas) * float64(memUtil) / float64(job.Request.TargetMemory))
+		memDesired := metricReplicas(currentReplicas, float64(memUtil), float64(job.Request.TargetMemory), up, down)
 		recommendations = append(recommendations, memDesired)
 	}
 
 	// Calculate based on GPU if target is set
 	if job.Request.TargetGPU > 0 && gpuUtil > 0 {
-		gpuDesired := int32(float64(currentReplicas) * float64(gpuUtil) / float64(job.Request.TargetGPU))
+		gpuDesired := metricReplicas(currentReplicas, float64(gpuUtil), float64(job.Request.TargetGPU), up, down)
 		recommendations = append(recommendations, gpuDesired)
 	}
 
 	// Calculate based on Storage Read Throughput (CRITICAL for AI/ML data loading)
 	if job.Request.TargetStorageReadThroughput > 0 && storageRead > 0 {
-		storageReadDesired := int32(float64(currentReplicas) * float64(storageRead) / float64(job.Request.TargetStorageReadThroughput))
+		storageReadDesired := metricReplicas(currentReplicas, float64(storageRead), float64(job.Request.TargetStorageReadThroughput), up, down)
 		recommendations = append(recommendations, storageReadDesired)
 		log.Printf("Autoscaler %s: Storage Read %d MB/s (target: %d MB/s) -> %d replicas",
 			job.ID, storageRead, job.Request.TargetStorageReadThroughput, storageReadDesired)
@@ -269,16 +501,20 @@ func (ac *AutoscalingController) calculateDesiredReplicas(job *AutoscalingJob, c
 
 	// Calculate based on Storage Write Throughput (for checkpoint saving)
 	if job.Request.TargetStorageWriteThroughput > 0 && storageWrite > 0 {
-		storageWriteDesired := int32(float64(currentReplicas) * float64(storageWrite) / float64(job.Request.TargetStorageWriteThroughput))
+		storageWriteDesired := metricReplicas(currentReplicas, float64(storageWrite), float64(job.Request.TargetStorageWriteThroughput), up, down)
 		recommendations = append(recommendations, storageWriteDesired)
 	}
 
 	// Calculate based on Storage IOPS (for mixed read/write workloads)
 	if job.Request.TargetStorageIOPS > 0 && storageIOPS > 0 {
-		iopsDesired := int32(float64(currentReplicas) * float64(storageIOPS) / float64(job.Request.TargetStorageIOPS))
+		iopsDesired := metricReplicas(currentReplicas, float64(storageIOPS), float64(job.Request.TargetStorageIOPS), up, down)
 		recommendations = append(recommendations, iopsDesired)
 	}
 
+	// Custom metrics join the built-in ones under the same max rule
+	customRecommendations, customMissing := customMetricRecommendations(job.Details.CustomMetrics)
+	recommendations = append(recommendations, customRecommendations...)
+
 	// Use the maximum recommendation (most conservative for scale-down, most responsive for scale-up)
 	if len(recommendations) > 0 {
 		desiredReplicas = recommendations[0]
@@ -289,12 +525,18 @@ func (ac *AutoscalingController) calculateDesiredReplicas(job *AutoscalingJob, c
 		}
 	}
 
-	// Apply min/max constraints
-	if desiredReplicas < job.Request.MinReplicas {
-		desiredReplicas = job.Request.MinReplicas
+	// Never scale down on partial information
+	if customMissing && desiredReplicas < currentReplicas {
+		desiredReplicas = currentReplicas
+	}
+
+	// Apply min/max constraints (overridden by an open schedule)
+	minReplicas, maxReplicas := effectiveBounds(job)
+	if desiredReplicas < minReplicas {
+		desiredReplicas = minReplicas
 	}
-	if desiredReplicas > job.Request.MaxReplicas {
-		desiredReplicas = job.Request.MaxReplicas
+	if desiredReplicas > maxReplicas {
+		desiredReplicas = maxReplicas
 	}
 
 	// Apply max scale change if policy exists
@@ -320,10 +562,19 @@ func (ac *AutoscalingController) calculateDesiredReplicas(job *AutoscalingJob, c
 }
 
 // applyStabilizationWindow applies stabilization window to prevent flapping
-// Returns the stabilized desired replicas based on the scaling history
+// Returns the stabilized desired replicas based on the scaling history, within the rate limits of
+// the scaling policies
 func (ac *AutoscalingController) applyStabilizationWindow(job *AutoscalingJob, currentReplicas, desiredReplicas int32) int32 {
 	now := time.Now()
+	up := newScalingRules(job.Request.ScaleUpPolicy)
+	down := newScalingRules(job.Request.ScaleDownPolicy)
 
+	stabilized := ac.stabilizeRecommendation(job, currentReplicas, desiredReplicas, now)
+	return limitScaling(currentReplicas, stabilized, up, down, job.scaleEvents, now)
+}
+
+// stabilizeRecommendation returns the recommendation selected within the stabilization window
+func (ac *AutoscalingController) stabilizeRecommendation(job *AutoscalingJob, currentReplicas, desiredReplicas int32, now time.Time) int32 {
 	// Add current recommendation to history
 	recommendation := scaleRecommendation{
 		replicas:  desiredReplicas,
@@ -419,32 +670,44 @@ func (ac *AutoscalingController) getCurrentReplicas(job *AutoscalingJob) (int32,
 	return replicas, nil
 }
 
-// getResourceUtilization returns current CPU, Memory, GPU, and Storage I/O metrics
-func (ac *AutoscalingController) getResourceUtilization(job *AutoscalingJob) (cpu, memory, gpu int32, storageRead, storageWrite, storageIOPS int64, err error) {
+// getResourceUtilization returns current CPU, Memory, GPU, and Storage I/O metrics and their provenance
+func (ac *AutoscalingController) getResourceUtilization(job *AutoscalingJob) (cpu, memory, gpu int32, storageRead, storageWrite, storageIOPS int64, provenance types.WorkloadMetricsProvenance, err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	cpuPercent, memoryPercent, gpuPercent, readMBps, writeMBps, iops, err := ac.k8sClient.GetWorkloadPodMetrics(ctx,
+	cpuPercent, memoryPercent, gpuPercent, readMBps, writeMBps, iops, provenance, err := ac.k8sClient.GetWorkloadPodMetrics(ctx,
 		job.Request.WorkloadNamespace,
 		job.Request.WorkloadName)
 	if err != nil {
-		// If metrics server is not available or no metrics found, return simulated values
-		log.Printf("Autoscaler %s: Failed to get real metrics, using simulated values: %v", job.ID, err)
-		cpu = 50 + int32(time.Now().Unix()%40)
-		memory = 45 + int32(time.Now().Unix()%35)
-		gpu = 40 + int32(time.Now().Unix()%50)
-		// Simulate storage I/O for AI/ML workload
-		storageRead = 300 + int64(time.Now().Unix()%200)   // 300-500 MB/s
-		storageWrite = 80 + int64(time.Now().Unix()%70)    // 80-150 MB/s
-		storageIOPS = 2000 + int64(time.Now().Unix()%2000) // 2000-4000 IOPS
-		return cpu, memory, gpu, storageRead, storageWrite, storageIOPS, nil
-	}
-
-	return cpuPercent, memoryPercent, gpuPercent, readMBps, writeMBps, iops, nil
+		// No scaling decision is made on missing metrics; the caller skips this cycle
+		return 0, 0, 0, 0, 0, 0, types.WorkloadMetricsProvenance{}, fmt.Errorf("workload metrics unavailable: %w", err)
+	}
+
+	return cpuPercent, memoryPercent, gpuPercent, readMBps, writeMBps, iops, provenance, nil
+}
+
+// usesWorkloadMetrics reports whether the autoscaler reads the pod metrics of its workload: for
+// built-in targets, idleness (scale to zero) or forecasting
+func usesWorkloadMetrics(job *AutoscalingJob) bool {
+	req := job.Request
+	return hasBuiltinTargets(req) || req.ScaleToZero != nil || job.forecaster != nil
+}
+
+// hasBuiltinTargets reports whether the autoscaler has a CPU, memory, GPU or storage I/O target
+func hasBuiltinTargets(req *types.AutoscalingRequest) bool {
+	return req.TargetCPU > 0 || req.TargetMemory > 0 || req.TargetGPU > 0 ||
+		req.TargetStorageReadThroughput > 0 || req.TargetStorageWriteThroughput > 0 || req.TargetStorageIOPS > 0
+}
+
+// targetProvenance returns the provenance of the workload signals the autoscaler has targets for
+func targetProvenance(req *types.AutoscalingRequest, signals types.WorkloadMetricsProvenance) types.MetricProvenance {
+	return signals.Of(req.TargetCPU > 0 || req.TargetMemory > 0, req.TargetGPU > 0,
+		req.TargetStorageReadThroughput > 0 || req.TargetStorageWriteThroughput > 0 || req.TargetStorageIOPS > 0)
 }
 
-// scaleWorkload scales the workload to the desired number of replicas
-func (ac *AutoscalingController) scaleWorkload(job *AutoscalingJob, desiredReplicas int32) error {
+// scaleWorkload scales the workload to the desired number of replicas and records the
+// result as an event on the Deployment/StatefulSet
+func (ac *AutoscalingController) scaleWorkload(job *AutoscalingJob, currentReplicas, desiredReplicas int32) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -454,14 +717,43 @@ func (ac *AutoscalingController) scaleWorkload(job *AutoscalingJob, desiredRepli
 		job.Request.WorkloadType,
 		desiredReplicas)
 	if err != nil {
+		message := fmt.Sprintf("Autoscaler %s failed to scale from %d to %d replicas: %v", job.ID, currentReplicas, desiredReplicas, err)
+		ac.k8sClient.RecordEvent(job.Request.WorkloadType, job.Request.WorkloadNamespace, job.Request.WorkloadName,
+			corev1.EventTypeWarning, k8s.EventReasonScaleFailed, message)
+		ac.publishScale(job, currentReplicas, desiredReplicas, k8s.EventReasonScaleFailed, message)
 		return fmt.Errorf("failed to scale workload: %w", err)
 	}
 
+	message := fmt.Sprintf("Autoscaler %s scaled from %d to %d replicas", job.ID, currentReplicas, desiredReplicas)
+	ac.k8sClient.RecordEvent(job.Request.WorkloadType, job.Request.WorkloadNamespace, job.Request.WorkloadName,
+		corev1.EventTypeNormal, k8s.EventReasonScaled, message)
+	reason := k8s.EventReasonScaled
+	if desiredReplicas == job.Request.MaxReplicas && desiredReplicas > currentReplicas {
+		reason = eventbus.ReasonMaxReplicasReached
+	}
+	ac.publishScale(job, currentReplicas, desiredReplicas, reason, message)
 	log.Printf("Successfully scaled %s/%s (%s) to %d replicas",
 		job.Request.WorkloadNamespace, job.Request.WorkloadName, job.Request.WorkloadType, desiredReplicas)
 	return nil
 }
 
+// publishScale publishes the outcome of a scale operation
+func (ac *AutoscalingController) publishScale(job *AutoscalingJob, currentReplicas, desiredReplicas int32, reason, message string) {
+	ac.events.Publish(eventbus.Event{
+		Kind:      eventbus.KindAutoscaling,
+		Type:      eventbus.TypeOutcome,
+		JobID:     job.ID,
+		Namespace: job.Request.WorkloadNamespace,
+		Status:    string(types.AutoscalingStatusActive),
+		Reason:    reason,
+		Message:   message,
+		Data: map[string]int32{
+			"current_replicas": currentReplicas,
+			"desired_replicas": desiredReplicas,
+		},
+	})
+}
+
 // validateRequest validates the autoscaling request
 func (ac *AutoscalingController) validateRequest(req *types.AutoscalingRequest) error {
 	if req.WorkloadName == "" {
@@ -474,14 +766,40 @@ func (ac *AutoscalingController) validateRequest(req *types.AutoscalingRequest)
 		return fmt.Errorf("workload_type is required")
 	}
 	if req.MinReplicas < 1 {
-		return fmt.Errorf("min_replicas must be at least 1")
+		return fmt.Errorf("min_replicas must be at least 1 (use scale_to_zero to release idle workloads)")
 	}
 	if req.MaxReplicas < req.MinReplicas {
 		return fmt.Errorf("max_replicas must be greater than or equal to min_replicas")
 	}
 	if req.TargetCPU == 0 && req.TargetMemory == 0 && req.TargetGPU == 0 &&
-		req.TargetStorageReadThroughput == 0 && req.TargetStorageWriteThroughput == 0 && req.TargetStorageIOPS == 0 {
-		return fmt.Errorf("at least one target metric (CPU, Memory, GPU, or Storage I/O) must be specified")
+		req.TargetStorageReadThroughput == 0 && req.TargetStorageWriteThroughput == 0 && req.TargetStorageIOPS == 0 &&
+		len(req.CustomMetrics) == 0 {
+		return fmt.Errorf("at least one target metric (CPU, Memory, GPU, Storage I/O, or custom metric) must be specified")
+	}
+	if err := validateCustomMetrics(req.CustomMetrics); err != nil {
+		return err
+	}
+	if p := req.Predictive; p != nil {
+		if err := validatePredictiveScaling(p.Mode, p.SeasonLengthSeconds, p.HorizonSeconds, autoscalerInterval); err != nil {
+			return err
+		}
+		if req.TargetGPU == 0 && req.TargetStorageReadThroughput == 0 {
+			return fmt.Errorf("predictive scaling requires target_gpu_percent or target_storage_read_throughput_mbps")
+		}
+	}
+	if z := req.ScaleToZero; z != nil {
+		if err := validateScaleToZero(z.IdleTimeoutSeconds, z.ActivationQueueDepth); err != nil {
+			return err
+		}
+	}
+	if err := validateSchedules(req.Schedules); err != nil {
+		return err
+	}
+	if err := validateScalingPolicy("scale_up", req.ScaleUpPolicy); err != nil {
+		return err
+	}
+	if err := validateScalingPolicy("scale_down", req.ScaleDownPolicy); err != nil {
+		return err
 	}
 	return nil
 }
@@ -500,6 +818,18 @@ func (ac *AutoscalingController) getStatusMessage(status types.AutoscalingStatus
 	}
 }
 
+// GetAutoscalerNamespace returns the namespace of the scaled workload
+func (ac *AutoscalingController) GetAutoscalerNamespace(id string) (string, error) {
+	ac.autoscalersMux.RLock()
+	defer ac.autoscalersMux.RUnlock()
+
+	job, exists := ac.autoscalers[id]
+	if !exists || job.Request == nil {
+		return "", fmt.Errorf("autoscaler %s not found", id)
+	}
+	return job.Request.WorkloadNamespace, nil
+}
+
 // GetMetrics returns current autoscaling metrics
 func (ac *AutoscalingController) GetMetrics() *types.AutoscalingMetrics {
 	ac.autoscalersMux.RLock()
@@ -0,0 +1,227 @@
+package controller
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+)
+
+const (
+	// maxScalingPolicyRules bounds the rate limit rules per scaling direction
+	maxScalingPolicyRules = 10
+
+	// maxScalingPolicyPeriod is the longest period of a rule, as in the Kubernetes HPA; scale events
+	// older than this are dropped
+	maxScalingPolicyPeriod = 1800 * time.Second
+)
+
+// scalingRules is the behavior of one scaling direction, shared by REST autoscalers and StorageHPAs
+type scalingRules struct {
+	selectPolicy string
+	rules        []types.ScalingPolicyRule
+	tolerance    float64
+}
+
+// scaleEvent is a replica change made by the autoscaler, positive for scale up
+type scaleEvent struct {
+	change    int32
+	timestamp time.Time
+}
+
+// newScalingRules returns the behavior of a REST scaling policy, which may be nil
+func newScalingRules(policy *types.ScalingPolicy) scalingRules {
+	if policy == nil {
+		return scalingRules{}
+	}
+	return scalingRules{
+		selectPolicy: policy.SelectPolicy,
+		rules:        policy.Policies,
+		tolerance:    policy.Tolerance,
+	}
+}
+
+// validateScalingPolicy checks the select policy, rules and tolerance of one scaling direction
+func validateScalingPolicy(direction string, policy *types.ScalingPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	switch policy.SelectPolicy {
+	case "", types.SelectPolicyMax, types.SelectPolicyMin, types.SelectPolicyDisabled:
+	default:
+		return fmt.Errorf("%s policy: select_policy must be %s, %s or %s", direction,
+			types.SelectPolicyMax, types.SelectPolicyMin, types.SelectPolicyDisabled)
+	}
+	if policy.Tolerance < 0 || policy.Tolerance >= 1 || math.IsNaN(policy.Tolerance) {
+		return fmt.Errorf("%s policy: tolerance must be between 0 and 1", direction)
+	}
+	if len(policy.Policies) > maxScalingPolicyRules {
+		return fmt.Errorf("%s policy: at most %d policies can be specified", direction, maxScalingPolicyRules)
+	}
+	for _, rule := range policy.Policies {
+		if rule.Type != types.ScalingPolicyPods && rule.Type != types.ScalingPolicyPercent {
+			return fmt.Errorf("%s policy: type must be %s or %s", direction, types.ScalingPolicyPods, types.ScalingPolicyPercent)
+		}
+		if rule.Value < 1 {
+			return fmt.Errorf("%s policy: value must be at least 1", direction)
+		}
+		if rule.PeriodSeconds < 1 || time.Duration(rule.PeriodSeconds)*time.Second > maxScalingPolicyPeriod {
+			return fmt.Errorf("%s policy: period_seconds must be between 1 and %d",
+				direction, int(maxScalingPolicyPeriod.Seconds()))
+		}
+	}
+	return nil
+}
+
+// withinTolerance reports whether the ratio of a metric to its target is close enough to 1 to keep
+// the current replicas, like the Kubernetes HPA; the tolerance of the direction the ratio points to applies
+func withinTolerance(ratio float64, up, down scalingRules) bool {
+	tolerance := up.tolerance
+	if ratio < 1 {
+		tolerance = down.tolerance
+	}
+	return math.Abs(ratio-1) <= tolerance
+}
+
+// metricReplicas returns the replicas a utilization metric asks for: the current replicas scaled by
+// the ratio of the usage to the target, or the current replicas when the ratio is within the tolerance
+func metricReplicas(currentReplicas int32, usage, target float64, up, down scalingRules) int32 {
+	ratio := usage / target
+	if withinTolerance(ratio, up, down) {
+		return currentReplicas
+	}
+	return int32(float64(currentReplicas) * ratio)
+}
+
+// replicasChangedInPeriod returns the replicas added (up) or removed (down) by the events within the period
+func replicasChangedInPeriod(events []scaleEvent, periodSeconds int32, up bool, now time.Time) int32 {
+	cutoff := now.Add(-time.Duration(periodSeconds) * time.Second)
+	var changed int32
+	for _, e := range events {
+		if !e.timestamp.After(cutoff) {
+			continue
+		}
+		if up && e.change > 0 {
+			changed += e.change
+		} else if !up && e.change < 0 {
+			changed -= e.change
+		}
+	}
+	return changed
+}
+
+// limitScaling rate-limits a stabilized recommendation by the rules of its direction, like the
+// Kubernetes HPA v2 behavior. Each rule limits the change from the replicas at the start of its
+// period; select policy Max applies the rule allowing the largest change, Min the smallest, and
+// Disabled keeps the current replicas.
+func limitScaling(currentReplicas, desiredReplicas int32, up, down scalingRules, events []scaleEvent, now time.Time) int32 {
+	switch {
+	case desiredReplicas > currentReplicas:
+		if up.selectPolicy == types.SelectPolicyDisabled {
+			return currentReplicas
+		}
+		if len(up.rules) == 0 {
+			return desiredReplicas
+		}
+		limit := scaleUpLimit(currentReplicas, up, events, now)
+		if limit < currentReplicas {
+			limit = currentReplicas
+		}
+		if desiredReplicas > limit {
+			return limit
+		}
+	case desiredReplicas < currentReplicas:
+		if down.selectPolicy == types.SelectPolicyDisabled {
+			return currentReplicas
+		}
+		if len(down.rules) == 0 {
+			return desiredReplicas
+		}
+		limit := scaleDownLimit(currentReplicas, down, events, now)
+		if limit > currentReplicas {
+			limit = currentReplicas
+		}
+		if desiredReplicas < limit {
+			return limit
+		}
+	}
+	return desiredReplicas
+}
+
+// scaleUpLimit returns the most replicas the scale-up rules allow
+func scaleUpLimit(currentReplicas int32, up scalingRules, events []scaleEvent, now time.Time) int32 {
+	var limit int32 = math.MinInt32
+	if up.selectPolicy == types.SelectPolicyMin {
+		limit = math.MaxInt32
+	}
+	for _, rule := range up.rules {
+		periodStart := currentReplicas - replicasChangedInPeriod(events, rule.PeriodSeconds, true, now) +
+			replicasChangedInPeriod(events, rule.PeriodSeconds, false, now)
+		var proposed int32
+		if rule.Type == types.ScalingPolicyPercent {
+			proposed = int32(math.Ceil(float64(periodStart) * (1 + float64(rule.Value)/100)))
+		} else {
+			proposed = periodStart + rule.Value
+		}
+		if up.selectPolicy == types.SelectPolicyMin {
+			limit = minInt32(limit, proposed)
+		} else {
+			limit = maxInt32(limit, proposed)
+		}
+	}
+	return limit
+}
+
+// scaleDownLimit returns the fewest replicas the scale-down rules allow
+func scaleDownLimit(currentReplicas int32, down scalingRules, events []scaleEvent, now time.Time) int32 {
+	var limit int32 = math.MaxInt32
+	if down.selectPolicy == types.SelectPolicyMin {
+		limit = math.MinInt32
+	}
+	for _, rule := range down.rules {
+		periodStart := currentReplicas + replicasChangedInPeriod(events, rule.PeriodSeconds, false, now) -
+			replicasChangedInPeriod(events, rule.PeriodSeconds, true, now)
+		var proposed int32
+		if rule.Type == types.ScalingPolicyPercent {
+			proposed = int32(float64(periodStart) * (1 - float64(rule.Value)/100))
+		} else {
+			proposed = periodStart - rule.Value
+		}
+		if down.selectPolicy == types.SelectPolicyMin {
+			limit = maxInt32(limit, proposed)
+		} else {
+			limit = minInt32(limit, proposed)
+		}
+	}
+	return limit
+}
+
+// recordScaleEvent appends a replica change to the events and drops those older than the longest period
+func recordScaleEvent(events []scaleEvent, fromReplicas, toReplicas int32, now time.Time) []scaleEvent {
+	cutoff := now.Add(-maxScalingPolicyPeriod)
+	kept := events[:0]
+	for _, e := range events {
+		if e.timestamp.After(cutoff) {
+			kept = append(kept, e)
+		}
+	}
+	if toReplicas != fromReplicas {
+		kept = append(kept, scaleEvent{change: toReplicas - fromReplicas, timestamp: now})
+	}
+	return kept
+}
+
+func minInt32(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt32(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+)
+
+const (
+	// maxCustomMetrics bounds the PromQL queries run per autoscaler every monitoring interval
+	maxCustomMetrics = 10
+
+	// customMetricQueryTimeout is the time allowed for all custom metric queries of one evaluation
+	customMetricQueryTimeout = 10 * time.Second
+)
+
+// metricQuerier runs a PromQL instant query, implemented by K8sClientInterface.QueryMetric
+type metricQuerier func(ctx context.Context, promql string) (float64, types.MetricProvenance, error)
+
+// validateCustomMetrics checks the custom metric targets shared by REST autoscalers and StorageHPAs
+func validateCustomMetrics(metrics []types.CustomMetricTarget) error {
+	if len(metrics) > maxCustomMetrics {
+		return fmt.Errorf("at most %d custom metrics can be specified", maxCustomMetrics)
+	}
+	names := make(map[string]bool, len(metrics))
+	for _, m := range metrics {
+		if m.Name == "" {
+			return fmt.Errorf("custom metric name is required")
+		}
+		if names[m.Name] {
+			return fmt.Errorf("custom metric %s is specified more than once", m.Name)
+		}
+		names[m.Name] = true
+		if strings.TrimSpace(m.Query) == "" {
+			return fmt.Errorf("custom metric %s: query is required", m.Name)
+		}
+		if m.TargetType != types.MetricTargetAverageValue && m.TargetType != types.MetricTargetValue {
+			return fmt.Errorf("custom metric %s: target type must be %s or %s",
+				m.Name, types.MetricTargetAverageValue, types.MetricTargetValue)
+		}
+		if m.TargetValue <= 0 || math.IsNaN(m.TargetValue) || math.IsInf(m.TargetValue, 0) {
+			return fmt.Errorf("custom metric %s: target value must be greater than 0", m.Name)
+		}
+	}
+	return nil
+}
+
+// expandQuery replaces ${namespace} and ${workload} so one query template can serve many workloads
+func expandQuery(query, namespace, workload string) string {
+	return strings.NewReplacer("${namespace}", namespace, "${workload}", workload).Replace(query)
+}
+
+// customMetricReplicas returns the replicas a custom metric asks for, like the Kubernetes HPA:
+// AverageValue divides the workload total by the per-replica target, Value scales the current
+// replicas by the ratio of the value to the target. The current replicas are kept while the
+// ratio of the value to the target of the current replicas is within the tolerance.
+func customMetricReplicas(targetType string, targetValue, value float64, currentReplicas int32, up, down scalingRules) int32 {
+	if currentReplicas < 1 {
+		currentReplicas = 1
+	}
+	ratio := value / targetValue
+	if targetType == types.MetricTargetAverageValue {
+		ratio /= float64(currentReplicas)
+	}
+	if withinTolerance(ratio, up, down) {
+		return currentReplicas
+	}
+	desired := float64(currentReplicas) * ratio
+	if desired > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(math.Ceil(desired))
+}
+
+// evaluateCustomMetrics runs the queries of the custom metrics and returns each value with its
+// recommendation. A failed query, stale or simulated data, or a negative value is reported in
+// Error; such a metric gives no recommendation.
+func evaluateCustomMetrics(query metricQuerier, namespace, workload string, currentReplicas int32, metrics []types.CustomMetricTarget, up, down scalingRules) []types.CustomMetricStatus {
+	ctx, cancel := context.WithTimeout(context.Background(), customMetricQueryTimeout)
+	defer cancel()
+
+	results := make([]types.CustomMetricStatus, 0, len(metrics))
+	for _, m := range metrics {
+		status := types.CustomMetricStatus{
+			Name:        m.Name,
+			TargetType:  m.TargetType,
+			TargetValue: m.TargetValue,
+		}
+		value, provenance, err := query(ctx, expandQuery(m.Query, namespace, workload))
+		status.Provenance = provenance
+		switch {
+		case err != nil:
+			status.Error = err.Error()
+		case !provenance.IsReal():
+			status.Error = fmt.Sprintf("metric is %s", provenance)
+		case value < 0 || math.IsNaN(value) || math.IsInf(value, 0):
+			status.Error = fmt.Sprintf("invalid value %v", value)
+		default:
+			status.CurrentValue = value
+			status.DesiredReplicas = customMetricReplicas(m.TargetType, m.TargetValue, value, currentReplicas, up, down)
+		}
+		results = append(results, status)
+	}
+	return results
+}
+
+// customMetricRecommendations returns the recommendations of the custom metrics that have a value,
+// and whether any metric is missing. Scale-down is held while a metric is missing, as the Kubernetes
+// HPA does, so that an unreachable Prometheus never shrinks a busy workload.
+func customMetricRecommendations(results []types.CustomMetricStatus) ([]int32, bool) {
+	var recommendations []int32
+	missing := false
+	for _, r := range results {
+		if r.Error != "" {
+			missing = true
+			continue
+		}
+		recommendations = append(recommendations, r.DesiredReplicas)
+	}
+	return recommendations, missing
+}
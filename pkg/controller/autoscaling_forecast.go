@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"ai-storage-orchestrator/pkg/forecast"
+	"ai-storage-orchestrator/pkg/types"
+)
+
+const (
+	// autoscalerInterval is the monitoring interval of REST autoscalers and the sampling step of
+	// their metric history
+	autoscalerInterval = 15 * time.Second
+
+	// defaultForecastHorizonSeconds is how far ahead predictive autoscalers scale by default
+	defaultForecastHorizonSeconds = 60
+
+	// maxForecastSeasonSeconds bounds the season so that the history of three seasons stays small
+	maxForecastSeasonSeconds = 8 * 60 * 60
+)
+
+// demandForecaster forecasts the GPU and storage read demand of a workload.
+// 레플리카 수가 바뀌어도 비교할 수 있도록 Pod당 메트릭에 레플리카 수를 곱한 전체 수요를 기록한다.
+type demandForecaster struct {
+	mode        string
+	gpu         *forecast.Series
+	storageRead *forecast.Series
+
+	replicas       int32
+	ready          bool
+	predictedGPU   float64 // total demand one horizon ahead
+	predictedRead  float64
+	horizonSeconds int32
+}
+
+// validatePredictiveScaling checks the forecasting settings shared by REST autoscalers and StorageHPAs
+func validatePredictiveScaling(mode string, seasonSeconds, horizonSeconds int32, step time.Duration) error {
+	if !forecast.IsMethod(mode) {
+		return fmt.Errorf("predictive mode must be one of %s", strings.Join(forecast.Methods, ", "))
+	}
+	minSeason := int32(2 * step / time.Second)
+	if seasonSeconds < minSeason || seasonSeconds > maxForecastSeasonSeconds {
+		return fmt.Errorf("predictive season length must be between %d and %d seconds", minSeason, maxForecastSeasonSeconds)
+	}
+	if horizonSeconds < 0 || horizonSeconds > seasonSeconds {
+		return fmt.Errorf("predictive horizon must be between 0 and the season length")
+	}
+	return nil
+}
+
+// newDemandForecaster creates the forecaster of an autoscaler sampled every step
+func newDemandForecaster(mode string, seasonSeconds, horizonSeconds int32, step time.Duration) (*demandForecaster, error) {
+	if horizonSeconds == 0 {
+		horizonSeconds = defaultForecastHorizonSeconds
+	}
+	season := time.Duration(seasonSeconds) * time.Second
+	horizon := time.Duration(horizonSeconds) * time.Second
+
+	gpu, err := forecast.NewSeries(mode, step, season, horizon)
+	if err != nil {
+		return nil, err
+	}
+	storageRead, err := forecast.NewSeries(mode, step, season, horizon)
+	if err != nil {
+		return nil, err
+	}
+	return &demandForecaster{
+		mode:           mode,
+		gpu:            gpu,
+		storageRead:    storageRead,
+		horizonSeconds: int32(gpu.Horizon() / time.Second),
+	}, nil
+}
+
+// newAutoscalingForecaster creates the forecaster of a REST autoscaler, nil when predictive scaling is off
+func newAutoscalingForecaster(req *types.AutoscalingRequest) (*demandForecaster, error) {
+	if req.Predictive == nil {
+		return nil, nil
+	}
+	return newDemandForecaster(req.Predictive.Mode, req.Predictive.SeasonLengthSeconds, req.Predictive.HorizonSeconds, autoscalerInterval)
+}
+
+// observe records the metrics of one sample and returns the GPU utilization and storage read
+// throughput to scale on: the larger of the measured and the forecast value per replica, so the
+// workload is scaled out before a predicted burst and never below the reactive recommendation
+func (f *demandForecaster) observe(now time.Time, replicas, gpuUtil int32, storageRead int64) (int32, int64) {
+	if replicas < 1 {
+		replicas = 1
+	}
+	f.replicas = replicas
+
+	gpuDemand, gpuReady := f.gpu.Observe(now, float64(gpuUtil)*float64(replicas))
+	readDemand, readReady := f.storageRead.Observe(now, float64(storageRead)*float64(replicas))
+	f.ready = gpuReady && readReady
+	if !f.ready {
+		return gpuUtil, storageRead
+	}
+	f.predictedGPU = gpuDemand
+	f.predictedRead = readDemand
+
+	predictedGPU, predictedRead := f.perReplica(gpuDemand, readDemand)
+	if predictedGPU > gpuUtil {
+		gpuUtil = predictedGPU
+	}
+	if predictedRead > storageRead {
+		storageRead = predictedRead
+	}
+	return gpuUtil, storageRead
+}
+
+// perReplica converts total demand to per-replica values at the current replica count
+func (f *demandForecaster) perReplica(gpuDemand, readDemand float64) (int32, int64) {
+	replicas := float64(f.replicas)
+	return int32(math.Round(gpuDemand / replicas)), int64(math.Round(readDemand / replicas))
+}
+
+// status reports the forecast and its accuracy for AutoscalingDetails
+func (f *demandForecaster) status() *types.AutoscalingForecast {
+	gpu := f.gpu.Snapshot()
+	read := f.storageRead.Snapshot()
+	status := &types.AutoscalingForecast{
+		Mode:                    f.mode,
+		HorizonSeconds:          f.horizonSeconds,
+		Samples:                 gpu.Samples,
+		Ready:                   f.ready,
+		GPUErrorPercent:         math.Round(gpu.ErrorPercent*10) / 10,
+		StorageReadErrorPercent: math.Round(read.ErrorPercent*10) / 10,
+	}
+	if f.replicas == 0 {
+		return status
+	}
+	if f.ready {
+		status.PredictedGPU, status.PredictedStorageReadThroughput = f.perReplica(f.predictedGPU, f.predictedRead)
+	}
+	if gpu.HasExpected && read.HasExpected {
+		expectedGPU, expectedRead := f.perReplica(gpu.Expected, read.Expected)
+		status.ExpectedGPU = &expectedGPU
+		status.ExpectedStorageReadThroughput = &expectedRead
+	}
+	return status
+}
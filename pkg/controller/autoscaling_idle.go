@@ -0,0 +1,274 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"ai-storage-orchestrator/pkg/k8s"
+	"ai-storage-orchestrator/pkg/types"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// idleUtilizationPercent is the CPU and GPU utilization at or below which a workload without
+	// storage I/O counts as idle
+	idleUtilizationPercent = 5
+
+	// minIdleTimeoutSeconds keeps at least two monitoring intervals between traffic and a scale to zero
+	minIdleTimeoutSeconds = 30
+
+	// defaultActivationQueueDepth is the queue depth that wakes a workload scaled to zero
+	defaultActivationQueueDepth = 1
+)
+
+// validateScaleToZero checks the scale to zero settings shared by REST autoscalers and StorageHPAs
+func validateScaleToZero(idleTimeoutSeconds int32, activationQueueDepth int64) error {
+	if idleTimeoutSeconds < minIdleTimeoutSeconds {
+		return fmt.Errorf("scale to zero idle timeout must be at least %d seconds", minIdleTimeoutSeconds)
+	}
+	if activationQueueDepth < 0 {
+		return fmt.Errorf("scale to zero activation queue depth must not be negative")
+	}
+	return nil
+}
+
+// activationQueueDepth returns the queue depth that wakes a workload, defaulting to one pending request
+func activationQueueDepth(depth int64) int64 {
+	if depth <= 0 {
+		return defaultActivationQueueDepth
+	}
+	return depth
+}
+
+// isIdle reports whether a workload has no traffic: no storage I/O and CPU/GPU at background level.
+// A zero is only trusted when the CPU, GPU and storage signals were all measured.
+func isIdle(cpuUtil, gpuUtil int32, storageRead, storageWrite, storageIOPS int64, provenance types.WorkloadMetricsProvenance) bool {
+	if !provenance.Of(true, true, true).IsReal() {
+		return false
+	}
+	return storageRead == 0 && storageWrite == 0 && storageIOPS == 0 &&
+		cpuUtil <= idleUtilizationPercent && gpuUtil <= idleUtilizationPercent
+}
+
+// coldStartSeconds rounds the time from a wake to the first ready replica to 0.1s
+func coldStartSeconds(d time.Duration) float64 {
+	return math.Round(d.Seconds()*10) / 10
+}
+
+// WakeAutoscaler handles an activation signal for an autoscaler with scale to zero. A workload at
+// zero replicas is scaled back to min_replicas by the monitoring loop; a running workload only
+// restarts its idle timeout. A queue depth below the activation threshold is ignored.
+func (ac *AutoscalingController) WakeAutoscaler(autoscalerID string, req *types.WakeRequest) (*types.AutoscalingResponse, error) {
+	ac.autoscalersMux.Lock()
+	defer ac.autoscalersMux.Unlock()
+
+	job, exists := ac.autoscalers[autoscalerID]
+	if !exists {
+		return nil, fmt.Errorf("autoscaler %s not found", autoscalerID)
+	}
+	if job.Request.ScaleToZero == nil || job.Details.ScaleToZero == nil {
+		return nil, fmt.Errorf("autoscaler %s does not have scale_to_zero enabled", autoscalerID)
+	}
+	if job.Status != types.AutoscalingStatusActive {
+		return nil, fmt.Errorf("autoscaler %s is %s", autoscalerID, job.Status)
+	}
+
+	state := job.Details.ScaleToZero
+	threshold := activationQueueDepth(job.Request.ScaleToZero.ActivationQueueDepth)
+	var message string
+	switch {
+	case req.QueueDepth != nil && *req.QueueDepth < threshold:
+		message = fmt.Sprintf("Queue depth %d is below the activation threshold %d", *req.QueueDepth, threshold)
+
+	case state.ScaledToZero:
+		reason := req.Reason
+		if reason == "" && req.QueueDepth != nil {
+			reason = fmt.Sprintf("queue depth %d", *req.QueueDepth)
+		} else if reason == "" {
+			reason = "wake request"
+		}
+		// 스케일 작업은 모니터링 루프에서만 수행한다; 이미 대기 중인 wake가 있으면 합친다
+		select {
+		case job.wakeCh <- reason:
+		default:
+		}
+		minReplicas, _ := effectiveBounds(job)
+		message = fmt.Sprintf("Waking workload to %d replicas", minReplicas)
+
+	case state.Waking:
+		message = "Workload is waking up"
+
+	default:
+		state.IdleSince = nil
+		message = "Workload is running, idle timeout restarted"
+	}
+
+	return &types.AutoscalingResponse{
+		AutoscalingID: job.ID,
+		Status:        job.Status,
+		Message:       message,
+		Details:       job.Details,
+	}, nil
+}
+
+// wakeWorkload scales a workload at zero replicas back to min_replicas (of an open schedule, if any)
+// and starts timing its cold start
+func (ac *AutoscalingController) wakeWorkload(job *AutoscalingJob, reason string) {
+	ac.autoscalersMux.RLock()
+	scaledToZero := job.Details.ScaleToZero != nil && job.Details.ScaleToZero.ScaledToZero
+	minReplicas, _ := effectiveBounds(job)
+	ac.autoscalersMux.RUnlock()
+	if !scaledToZero {
+		return
+	}
+
+	if err := ac.scaleWorkload(job, 0, minReplicas); err != nil {
+		log.Printf("Autoscaler %s: Failed to wake workload: %v", job.ID, err)
+		return
+	}
+
+	now := time.Now()
+	ac.autoscalersMux.Lock()
+	state := job.Details.ScaleToZero
+	state.ScaledToZero = false
+	state.Waking = true
+	state.IdleSince = nil
+	state.WakeCount++
+	state.LastWakeTime = &now
+	state.LastWakeReason = reason
+	job.Details.DesiredReplicas = minReplicas
+	job.Details.LastScaleTime = &now
+	job.Details.ScaleUpCount++
+	ac.metrics.TotalScaleUps++
+	ac.metrics.TotalWakes++
+	ac.autoscalersMux.Unlock()
+	job.scaleUpHistory = []scaleRecommendation{}
+	job.scaleDownHistory = []scaleRecommendation{}
+	job.scaleEvents = nil
+
+	log.Printf("Autoscaler %s: Woke %s/%s to %d replicas (%s)",
+		job.ID, job.Request.WorkloadNamespace, job.Request.WorkloadName, minReplicas, reason)
+	ac.k8sClient.RecordEvent(job.Request.WorkloadType, job.Request.WorkloadNamespace, job.Request.WorkloadName,
+		corev1.EventTypeNormal, k8s.EventReasonWoken,
+		fmt.Sprintf("Autoscaler %s woke the workload to %d replicas: %s", job.ID, minReplicas, reason))
+}
+
+// holdWhileScaledToZero reports whether the monitoring cycle must be skipped: a workload at zero
+// replicas has no metrics, and a waking one is left alone until its first replica is ready, at
+// which point the cold start time is recorded
+func (ac *AutoscalingController) holdWhileScaledToZero(job *AutoscalingJob) bool {
+	ac.autoscalersMux.RLock()
+	state := job.Details.ScaleToZero
+	scaledToZero, waking := state.ScaledToZero, state.Waking
+	var wokeAt time.Time
+	if state.LastWakeTime != nil {
+		wokeAt = *state.LastWakeTime
+	}
+	ac.autoscalersMux.RUnlock()
+
+	if scaledToZero {
+		return true
+	}
+	if !waking {
+		return false
+	}
+
+	ready, err := ac.getReadyReplicas(job)
+	if err != nil {
+		log.Printf("Autoscaler %s: Failed to get ready replicas: %v", job.ID, err)
+		return true
+	}
+	if ready < 1 {
+		return true
+	}
+
+	coldStart := coldStartSeconds(time.Since(wokeAt))
+	ac.autoscalersMux.Lock()
+	state.Waking = false
+	state.LastColdStartSeconds = coldStart
+	ac.coldStarts++
+	ac.metrics.AverageColdStartSeconds += (coldStart - ac.metrics.AverageColdStartSeconds) / float64(ac.coldStarts)
+	ac.autoscalersMux.Unlock()
+
+	log.Printf("Autoscaler %s: Cold start of %s/%s took %.1fs",
+		job.ID, job.Request.WorkloadNamespace, job.Request.WorkloadName, coldStart)
+	ac.k8sClient.RecordEvent(job.Request.WorkloadType, job.Request.WorkloadNamespace, job.Request.WorkloadName,
+		corev1.EventTypeNormal, k8s.EventReasonColdStarted,
+		fmt.Sprintf("Autoscaler %s: first replica ready %.1fs after wake", job.ID, coldStart))
+	return false
+}
+
+// scaleToZeroIfIdle tracks how long the workload has been idle and scales it to zero once the idle
+// timeout has passed. It returns true when the workload was scaled to zero.
+func (ac *AutoscalingController) scaleToZeroIfIdle(job *AutoscalingJob, currentReplicas, cpuUtil, gpuUtil int32, storageRead, storageWrite, storageIOPS int64, signals types.WorkloadMetricsProvenance) bool {
+	now := time.Now()
+	idle := isIdle(cpuUtil, gpuUtil, storageRead, storageWrite, storageIOPS, signals)
+
+	ac.autoscalersMux.Lock()
+	state := job.Details.ScaleToZero
+	if !idle {
+		state.IdleSince = nil
+		ac.autoscalersMux.Unlock()
+		return false
+	}
+	if state.IdleSince == nil {
+		state.IdleSince = &now
+	}
+	idleFor := now.Sub(*state.IdleSince)
+	ac.autoscalersMux.Unlock()
+
+	timeout := time.Duration(job.Request.ScaleToZero.IdleTimeoutSeconds) * time.Second
+	if idleFor < timeout || currentReplicas == 0 {
+		return false
+	}
+	// Scaling to zero is a scale-down; a disabled scale-down direction keeps the workload running
+	if newScalingRules(job.Request.ScaleDownPolicy).selectPolicy == types.SelectPolicyDisabled {
+		return false
+	}
+
+	if err := ac.scaleWorkload(job, currentReplicas, 0); err != nil {
+		log.Printf("Autoscaler %s: Failed to scale idle workload to zero: %v", job.ID, err)
+		return false
+	}
+
+	ac.autoscalersMux.Lock()
+	state.ScaledToZero = true
+	state.ScaledToZeroAt = &now
+	state.ScaleToZeroCount++
+	state.IdleSince = nil
+	job.Details.DesiredReplicas = 0
+	job.Details.LastScaleTime = &now
+	job.Details.ScaleDownCount++
+	ac.metrics.TotalScaleDowns++
+	ac.metrics.TotalScaleToZero++
+	ac.autoscalersMux.Unlock()
+	job.scaleUpHistory = []scaleRecommendation{}
+	job.scaleDownHistory = []scaleRecommendation{}
+	job.scaleEvents = nil
+
+	log.Printf("Autoscaler %s: Scaled %s/%s to zero after %s idle",
+		job.ID, job.Request.WorkloadNamespace, job.Request.WorkloadName, idleFor.Round(time.Second))
+	ac.k8sClient.RecordEvent(job.Request.WorkloadType, job.Request.WorkloadNamespace, job.Request.WorkloadName,
+		corev1.EventTypeNormal, k8s.EventReasonScaledToZero,
+		fmt.Sprintf("Autoscaler %s scaled the workload to zero after %s without traffic", job.ID, idleFor.Round(time.Second)))
+	return true
+}
+
+// getReadyReplicas returns the number of ready replicas of the workload
+func (ac *AutoscalingController) getReadyReplicas(job *AutoscalingJob) (int32, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ready, err := ac.k8sClient.GetWorkloadReadyReplicas(ctx,
+		job.Request.WorkloadNamespace,
+		job.Request.WorkloadName,
+		job.Request.WorkloadType)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get workload ready replicas: %w", err)
+	}
+	return ready, nil
+}
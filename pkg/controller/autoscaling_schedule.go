@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"ai-storage-orchestrator/pkg/k8s"
+	"ai-storage-orchestrator/pkg/schedule"
+	"ai-storage-orchestrator/pkg/types"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// maxSchedules bounds the windows evaluated per autoscaler every monitoring interval
+	maxSchedules = 10
+
+	// schedulePreviewDuration is how far ahead GET /autoscaling/:id/schedule previews the bounds
+	schedulePreviewDuration = 24 * time.Hour
+)
+
+// newScheduleWindows parses the windows of the scheduled scaling settings, in order
+func newScheduleWindows(schedules []types.ScalingSchedule) ([]*schedule.Window, error) {
+	windows := make([]*schedule.Window, 0, len(schedules))
+	for _, s := range schedules {
+		w, err := schedule.NewWindow(s.Start, s.End, s.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %s: %w", s.Name, err)
+		}
+		windows = append(windows, w)
+	}
+	return windows, nil
+}
+
+// validateSchedules checks the scheduled scaling windows of an autoscaler
+func validateSchedules(schedules []types.ScalingSchedule) error {
+	if len(schedules) > maxSchedules {
+		return fmt.Errorf("at most %d schedules can be specified", maxSchedules)
+	}
+	names := make(map[string]bool, len(schedules))
+	for _, s := range schedules {
+		if s.Name == "" {
+			return fmt.Errorf("schedule name is required")
+		}
+		if names[s.Name] {
+			return fmt.Errorf("schedule %s is specified more than once", s.Name)
+		}
+		names[s.Name] = true
+		if s.MinReplicas < 1 {
+			return fmt.Errorf("schedule %s: min_replicas must be at least 1", s.Name)
+		}
+		if s.MaxReplicas < s.MinReplicas {
+			return fmt.Errorf("schedule %s: max_replicas must be greater than or equal to min_replicas", s.Name)
+		}
+	}
+	_, err := newScheduleWindows(schedules)
+	return err
+}
+
+// replicaBounds returns the min/max replicas given which windows are open: the first open schedule
+// in the list, or the bounds of the request when none is open
+func replicaBounds(req *types.AutoscalingRequest, open []bool) (minReplicas, maxReplicas int32, name string) {
+	for i, isOpen := range open {
+		if isOpen {
+			s := req.Schedules[i]
+			return s.MinReplicas, s.MaxReplicas, s.Name
+		}
+	}
+	return req.MinReplicas, req.MaxReplicas, ""
+}
+
+// effectiveBounds returns the min/max replicas currently in effect for an autoscaler
+func effectiveBounds(job *AutoscalingJob) (int32, int32) {
+	if job.Details.EffectiveMinReplicas > 0 && job.Details.EffectiveMaxReplicas > 0 {
+		return job.Details.EffectiveMinReplicas, job.Details.EffectiveMaxReplicas
+	}
+	return job.Request.MinReplicas, job.Request.MaxReplicas
+}
+
+// applySchedules updates the replica bounds of an autoscaler from its schedules. When a window
+// opens for a workload scaled to zero, the workload is woken to the scheduled min_replicas.
+func (ac *AutoscalingController) applySchedules(job *AutoscalingJob, now time.Time) {
+	open := make([]bool, len(job.schedules))
+	for i, w := range job.schedules {
+		open[i] = w.Active(now)
+	}
+	minReplicas, maxReplicas, name := replicaBounds(job.Request, open)
+
+	ac.autoscalersMux.Lock()
+	previous := job.Details.ActiveSchedule
+	job.Details.ActiveSchedule = name
+	job.Details.EffectiveMinReplicas = minReplicas
+	job.Details.EffectiveMaxReplicas = maxReplicas
+	scaledToZero := job.Details.ScaleToZero != nil && job.Details.ScaleToZero.ScaledToZero
+	ac.autoscalersMux.Unlock()
+
+	if name == previous {
+		return
+	}
+
+	var message string
+	if name == "" {
+		message = fmt.Sprintf("Schedule %s ended, replica bounds %d-%d", previous, minReplicas, maxReplicas)
+	} else {
+		message = fmt.Sprintf("Schedule %s started, replica bounds %d-%d", name, minReplicas, maxReplicas)
+	}
+	log.Printf("Autoscaler %s: %s", job.ID, message)
+	ac.k8sClient.RecordEvent(job.Request.WorkloadType, job.Request.WorkloadNamespace, job.Request.WorkloadName,
+		corev1.EventTypeNormal, k8s.EventReasonScheduleChanged, fmt.Sprintf("Autoscaler %s: %s", job.ID, message))
+
+	if name != "" && scaledToZero {
+		ac.wakeWorkload(job, fmt.Sprintf("schedule %s", name))
+	}
+}
+
+// PreviewSchedule returns the replica bounds of an autoscaler over the next 24 hours as periods with
+// constant bounds
+func (ac *AutoscalingController) PreviewSchedule(autoscalerID string) (*types.SchedulePreview, error) {
+	ac.autoscalersMux.RLock()
+	job, exists := ac.autoscalers[autoscalerID]
+	ac.autoscalersMux.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("autoscaler %s not found", autoscalerID)
+	}
+	return previewSchedule(job.ID, job.Request, job.schedules, time.Now().Truncate(time.Minute), schedulePreviewDuration), nil
+}
+
+// previewSchedule merges the openings and closings of all windows between from and from+d
+func previewSchedule(id string, req *types.AutoscalingRequest, windows []*schedule.Window, from time.Time, d time.Duration) *types.SchedulePreview {
+	type change struct {
+		at     time.Time
+		window int
+		open   bool
+	}
+	open := make([]bool, len(windows))
+	var changes []change
+	for i, w := range windows {
+		active, windowChanges := w.Changes(from, d)
+		open[i] = active
+		for _, c := range windowChanges {
+			changes = append(changes, change{at: c.At, window: i, open: c.Active})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].at.Before(changes[j].at) })
+
+	to := from.Add(d)
+	preview := &types.SchedulePreview{AutoscalingID: id, From: from, To: to}
+	minReplicas, maxReplicas, name := replicaBounds(req, open)
+	current := types.SchedulePreviewPeriod{Start: from, Schedule: name, MinReplicas: minReplicas, MaxReplicas: maxReplicas}
+
+	for i := 0; i < len(changes); {
+		at := changes[i].at
+		for ; i < len(changes) && changes[i].at.Equal(at); i++ {
+			open[changes[i].window] = changes[i].open
+		}
+		minReplicas, maxReplicas, name = replicaBounds(req, open)
+		if name == current.Schedule && minReplicas == current.MinReplicas && maxReplicas == current.MaxReplicas {
+			continue
+		}
+		current.End = at
+		preview.Periods = append(preview.Periods, current)
+		current = types.SchedulePreviewPeriod{Start: at, Schedule: name, MinReplicas: minReplicas, MaxReplicas: maxReplicas}
+	}
+	current.End = to
+	preview.Periods = append(preview.Periods, current)
+	return preview
+}
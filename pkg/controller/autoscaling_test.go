@@ -2,18 +2,26 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
+	"ai-storage-orchestrator/pkg/store"
 	"ai-storage-orchestrator/pkg/types"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockK8sClient is a mock implementation of K8sClientInterface for testing
 type MockK8sClient struct {
 	mock.Mock
+
+	eventsMux sync.Mutex
+	events    []string
 }
 
 func (m *MockK8sClient) GetWorkloadReplicas(ctx context.Context, namespace, name, workloadType string) (int32, error) {
@@ -21,9 +29,21 @@ func (m *MockK8sClient) GetWorkloadReplicas(ctx context.Context, namespace, name
 	return args.Get(0).(int32), args.Error(1)
 }
 
-func (m *MockK8sClient) GetWorkloadPodMetrics(ctx context.Context, namespace, workloadName string) (cpuPercent, memoryPercent, gpuPercent int32, err error) {
+func (m *MockK8sClient) GetWorkloadReadyReplicas(ctx context.Context, namespace, name, workloadType string) (int32, error) {
+	args := m.Called(ctx, namespace, name, workloadType)
+	return args.Get(0).(int32), args.Error(1)
+}
+
+// realWorkloadMetrics is the provenance of workload metrics that were all measured
+var realWorkloadMetrics = types.WorkloadMetricsProvenance{
+	Resource: types.ProvenanceReal,
+	GPU:      types.ProvenanceReal,
+	Storage:  types.ProvenanceReal,
+}
+
+func (m *MockK8sClient) GetWorkloadPodMetrics(ctx context.Context, namespace, workloadName string) (cpuPercent, memoryPercent, gpuPercent int32, storageReadMBps, storageWriteMBps, storageIOPS int64, provenance types.WorkloadMetricsProvenance, err error) {
 	args := m.Called(ctx, namespace, workloadName)
-	return args.Get(0).(int32), args.Get(1).(int32), args.Get(2).(int32), args.Error(3)
+	return args.Get(0).(int32), args.Get(1).(int32), args.Get(2).(int32), args.Get(3).(int64), args.Get(4).(int64), args.Get(5).(int64), args.Get(6).(types.WorkloadMetricsProvenance), args.Error(7)
 }
 
 func (m *MockK8sClient) ScaleWorkload(ctx context.Context, namespace, name, workloadType string, replicas int32) error {
@@ -31,6 +51,98 @@ func (m *MockK8sClient) ScaleWorkload(ctx context.Context, namespace, name, work
 	return args.Error(0)
 }
 
+func (m *MockK8sClient) QueryMetric(ctx context.Context, promql string) (float64, types.MetricProvenance, error) {
+	args := m.Called(ctx, promql)
+	return args.Get(0).(float64), args.Get(1).(types.MetricProvenance), args.Error(2)
+}
+
+func (m *MockK8sClient) ListNodes(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockK8sClient) GetNodeMetrics(ctx context.Context, nodeName string) (cpuPercent, memoryPercent int32, provenance types.MetricProvenance, err error) {
+	args := m.Called(ctx, nodeName)
+	return args.Get(0).(int32), args.Get(1).(int32), args.Get(2).(types.MetricProvenance), args.Error(3)
+}
+
+func (m *MockK8sClient) GetNodeCapacity(ctx context.Context, nodeName string) (cpuCapacity, memoryCapacity string, gpuCapacity int32, err error) {
+	args := m.Called(ctx, nodeName)
+	return args.String(0), args.String(1), args.Get(2).(int32), args.Error(3)
+}
+
+func (m *MockK8sClient) GetNodePodCount(ctx context.Context, nodeName string) (int32, error) {
+	args := m.Called(ctx, nodeName)
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (m *MockK8sClient) GetNodeLabel(ctx context.Context, nodeName string, labelKey string) (string, error) {
+	args := m.Called(ctx, nodeName, labelKey)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockK8sClient) GetNodeGPUUtilization(ctx context.Context, nodeName string) (int32, error) {
+	args := m.Called(ctx, nodeName)
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (m *MockK8sClient) ListPodsOnNode(ctx context.Context, nodeName string) ([]types.PodRef, error) {
+	args := m.Called(ctx, nodeName)
+	return args.Get(0).([]types.PodRef), args.Error(1)
+}
+
+func (m *MockK8sClient) GetNodeStorageMetrics(ctx context.Context, nodeName string) (readMBps, writeMBps, iops int64, utilization int32, provenance types.MetricProvenance, err error) {
+	args := m.Called(ctx, nodeName)
+	return args.Get(0).(int64), args.Get(1).(int64), args.Get(2).(int64), args.Get(3).(int32), args.Get(4).(types.MetricProvenance), args.Error(5)
+}
+
+func (m *MockK8sClient) GetPodResourceInfo(ctx context.Context, namespace, name string) (*types.PodResourceInfo, error) {
+	args := m.Called(ctx, namespace, name)
+	return args.Get(0).(*types.PodResourceInfo), args.Error(1)
+}
+
+func (m *MockK8sClient) EvictPod(ctx context.Context, namespace, name string, gracePeriodSeconds int64) error {
+	args := m.Called(ctx, namespace, name, gracePeriodSeconds)
+	return args.Error(0)
+}
+
+func (m *MockK8sClient) CreatePVC(ctx context.Context, spec *types.PVCSpec) error {
+	args := m.Called(ctx, spec)
+	return args.Error(0)
+}
+
+func (m *MockK8sClient) GetPVCStatus(ctx context.Context, namespace, name string) (*types.PVCStatus, error) {
+	args := m.Called(ctx, namespace, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.PVCStatus), args.Error(1)
+}
+
+func (m *MockK8sClient) ListPodsUsingPVC(ctx context.Context, namespace, pvcName string) ([]string, error) {
+	args := m.Called(ctx, namespace, pvcName)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockK8sClient) DeletePVC(ctx context.Context, namespace, name string) error {
+	args := m.Called(ctx, namespace, name)
+	return args.Error(0)
+}
+
+// RecordEvent keeps events as "type reason kind/namespace/name" instead of expecting them,
+// so tests that do not care about events need no setup
+func (m *MockK8sClient) RecordEvent(kind, namespace, name, eventType, reason, message string) {
+	m.eventsMux.Lock()
+	defer m.eventsMux.Unlock()
+	m.events = append(m.events, fmt.Sprintf("%s %s %s/%s/%s", eventType, reason, kind, namespace, name))
+}
+
+func (m *MockK8sClient) recordedEvents() []string {
+	m.eventsMux.Lock()
+	defer m.eventsMux.Unlock()
+	return append([]string(nil), m.events...)
+}
+
 // TestCreateAutoscaler tests the creation of an autoscaler
 func TestCreateAutoscaler(t *testing.T) {
 	mockClient := new(MockK8sClient)
@@ -122,7 +234,47 @@ func TestCreateAutoscalerValidation(t *testing.T) {
 				MinReplicas:       1,
 				MaxReplicas:       5,
 			},
-			expectedErr: "at least one target metric (CPU, Memory, or GPU) must be specified",
+			expectedErr: "at least one target metric (CPU, Memory, GPU, Storage I/O, or custom metric) must be specified",
+		},
+		{
+			name: "invalid custom metric target type",
+			req: &types.AutoscalingRequest{
+				WorkloadName:      "test",
+				WorkloadNamespace: "default",
+				WorkloadType:      "Deployment",
+				MinReplicas:       1,
+				MaxReplicas:       5,
+				CustomMetrics: []types.CustomMetricTarget{
+					{Name: "queue_length", Query: "sum(queue_length)", TargetType: "Utilization", TargetValue: 10},
+				},
+			},
+			expectedErr: "custom metric queue_length: target type must be AverageValue or Value",
+		},
+		{
+			name: "unknown predictive mode",
+			req: &types.AutoscalingRequest{
+				WorkloadName:      "test",
+				WorkloadNamespace: "default",
+				WorkloadType:      "Deployment",
+				MinReplicas:       1,
+				MaxReplicas:       5,
+				TargetGPU:         70,
+				Predictive:        &types.PredictiveScaling{Mode: "linear", SeasonLengthSeconds: 600},
+			},
+			expectedErr: "predictive mode must be one of",
+		},
+		{
+			name: "predictive without GPU or storage read target",
+			req: &types.AutoscalingRequest{
+				WorkloadName:      "test",
+				WorkloadNamespace: "default",
+				WorkloadType:      "Deployment",
+				MinReplicas:       1,
+				MaxReplicas:       5,
+				TargetCPU:         70,
+				Predictive:        &types.PredictiveScaling{Mode: "holt_winters", SeasonLengthSeconds: 600},
+			},
+			expectedErr: "predictive scaling requires target_gpu_percent or target_storage_read_throughput_mbps",
 		},
 	}
 
@@ -295,7 +447,7 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := ac.calculateDesiredReplicas(tt.job, tt.cpuUtil, tt.memUtil, tt.gpuUtil)
+			result := ac.calculateDesiredReplicas(tt.job, tt.cpuUtil, tt.memUtil, tt.gpuUtil, 0, 0, 0)
 			assert.Equal(t, tt.expectedReplicas, result)
 		})
 	}
@@ -394,6 +546,39 @@ func TestApplyStabilizationWindow(t *testing.T) {
 	})
 }
 
+// TestDemandForecaster tests that a predictive autoscaler scales out ahead of a periodic burst
+func TestDemandForecaster(t *testing.T) {
+	// Season of 4 samples (one minute) with a storage read burst at the epoch boundary
+	f, err := newDemandForecaster("seasonal_moving_average", 60, 15, autoscalerInterval)
+	assert.NoError(t, err)
+
+	epoch := []int64{100, 100, 100, 500}
+	start := time.Now()
+	var gpu int32
+	var read int64
+	for i := 0; i < 11; i++ {
+		gpu, read = f.observe(start.Add(time.Duration(i)*autoscalerInterval), 2, 50, epoch[i%4])
+	}
+	// The last sample is just before the burst: scale on the forecast instead of the current metric
+	assert.Equal(t, int32(50), gpu)
+	assert.Equal(t, int64(500), read)
+
+	status := f.status()
+	assert.True(t, status.Ready)
+	assert.Equal(t, 11, status.Samples)
+	assert.Equal(t, int64(500), status.PredictedStorageReadThroughput)
+	if assert.NotNil(t, status.ExpectedStorageReadThroughput) {
+		assert.Equal(t, int64(100), *status.ExpectedStorageReadThroughput)
+	}
+	assert.Zero(t, status.StorageReadErrorPercent)
+
+	// The forecast is never below the measured metric
+	gpu, read = f.observe(start.Add(11*autoscalerInterval), 2, 90, 800)
+	assert.Equal(t, int32(90), gpu)
+	assert.Equal(t, int64(800), read)
+	assert.Greater(t, f.status().StorageReadErrorPercent, 0.0)
+}
+
 // TestListAutoscalers tests listing all autoscalers
 func TestListAutoscalers(t *testing.T) {
 	mockClient := new(MockK8sClient)
@@ -474,7 +659,7 @@ func TestMaxScaleChange(t *testing.T) {
 		}
 
 		// CPU suggests scaling to 10 replicas
-		result := ac.calculateDesiredReplicas(job, 350, 0, 0)
+		result := ac.calculateDesiredReplicas(job, 350, 0, 0, 0, 0, 0)
 		// Should be limited to current + 3 = 5
 		assert.LessOrEqual(t, result, int32(5))
 	})
@@ -495,8 +680,401 @@ func TestMaxScaleChange(t *testing.T) {
 		}
 
 		// CPU suggests scaling to 1 replica
-		result := ac.calculateDesiredReplicas(job, 7, 0, 0)
+		result := ac.calculateDesiredReplicas(job, 7, 0, 0, 0, 0, 0)
 		// Should be limited to current - 2 = 8
 		assert.GreaterOrEqual(t, result, int32(8))
 	})
 }
+
+// TestScaleWorkloadEvents tests that scaling records Scaled / ScaleFailed events on the workload
+func TestScaleWorkloadEvents(t *testing.T) {
+	mockClient := new(MockK8sClient)
+	ac := NewAutoscalingController(mockClient)
+	job := &AutoscalingJob{
+		ID: "autoscaler-1234",
+		Request: &types.AutoscalingRequest{
+			WorkloadName:      "trainer",
+			WorkloadNamespace: "default",
+			WorkloadType:      "Deployment",
+		},
+	}
+
+	mockClient.On("ScaleWorkload", mock.Anything, "default", "trainer", "Deployment", int32(4)).Return(nil).Once()
+	mockClient.On("ScaleWorkload", mock.Anything, "default", "trainer", "Deployment", int32(6)).Return(fmt.Errorf("conflict")).Once()
+
+	assert.NoError(t, ac.scaleWorkload(job, 2, 4))
+	assert.Error(t, ac.scaleWorkload(job, 4, 6))
+	assert.Equal(t, []string{
+		"Normal Scaled Deployment/default/trainer",
+		"Warning ScaleFailed Deployment/default/trainer",
+	}, mockClient.recordedEvents())
+	mockClient.AssertExpectations(t)
+}
+
+// TestScaleToZeroAndWake tests the idle timeout, the activation threshold and the cold start time
+func TestScaleToZeroAndWake(t *testing.T) {
+	mockClient := new(MockK8sClient)
+	ac := NewAutoscalingController(mockClient)
+	job := &AutoscalingJob{
+		ID: "autoscaler-1234",
+		Request: &types.AutoscalingRequest{
+			WorkloadName:      "llm-server",
+			WorkloadNamespace: "inference",
+			WorkloadType:      "Deployment",
+			MinReplicas:       2,
+			MaxReplicas:       4,
+			TargetGPU:         70,
+			ScaleToZero:       &types.ScaleToZero{IdleTimeoutSeconds: 300, ActivationQueueDepth: 5},
+		},
+		Status:  types.AutoscalingStatusActive,
+		Details: &types.AutoscalingDetails{ScaleToZero: &types.ScaleToZeroStatus{}},
+		wakeCh:  make(chan string, 1),
+	}
+	ac.autoscalers[job.ID] = job
+
+	mockClient.On("ScaleWorkload", mock.Anything, "inference", "llm-server", "Deployment", int32(0)).Return(nil).Once()
+	mockClient.On("ScaleWorkload", mock.Anything, "inference", "llm-server", "Deployment", int32(2)).Return(nil).Once()
+	mockClient.On("GetWorkloadReadyReplicas", mock.Anything, "inference", "llm-server", "Deployment").Return(int32(0), nil).Once()
+	mockClient.On("GetWorkloadReadyReplicas", mock.Anything, "inference", "llm-server", "Deployment").Return(int32(1), nil).Once()
+
+	// Traffic keeps the workload running; idleness starts the timeout
+	assert.False(t, ac.scaleToZeroIfIdle(job, 2, 3, 40, 0, 0, 0, realWorkloadMetrics))
+	assert.Nil(t, job.Details.ScaleToZero.IdleSince)
+	assert.False(t, ac.scaleToZeroIfIdle(job, 2, 2, 0, 0, 0, 0, realWorkloadMetrics))
+	require.NotNil(t, job.Details.ScaleToZero.IdleSince)
+
+	// Zero storage I/O that could not be read is not idleness
+	idleSince := time.Now().Add(-6 * time.Minute)
+	job.Details.ScaleToZero.IdleSince = &idleSince
+	noStorageMetrics := realWorkloadMetrics
+	noStorageMetrics.Storage = types.ProvenanceUnavailable
+	assert.False(t, ac.scaleToZeroIfIdle(job, 2, 2, 0, 0, 0, 0, noStorageMetrics))
+	assert.Nil(t, job.Details.ScaleToZero.IdleSince)
+
+	// A disabled scale-down direction also holds the scale to zero
+	job.Details.ScaleToZero.IdleSince = &idleSince
+	job.Request.ScaleDownPolicy = &types.ScalingPolicy{SelectPolicy: types.SelectPolicyDisabled}
+	assert.False(t, ac.scaleToZeroIfIdle(job, 2, 2, 0, 0, 0, 0, realWorkloadMetrics))
+	assert.False(t, job.Details.ScaleToZero.ScaledToZero)
+	job.Request.ScaleDownPolicy = nil
+
+	// Idle for longer than the timeout
+	job.Details.ScaleToZero.IdleSince = &idleSince
+	assert.True(t, ac.scaleToZeroIfIdle(job, 2, 2, 0, 0, 0, 0, realWorkloadMetrics))
+	assert.True(t, job.Details.ScaleToZero.ScaledToZero)
+	assert.Equal(t, int32(0), job.Details.DesiredReplicas)
+	assert.True(t, ac.holdWhileScaledToZero(job))
+
+	// A queue depth below the activation threshold does not wake the workload
+	depth := int64(3)
+	resp, err := ac.WakeAutoscaler(job.ID, &types.WakeRequest{QueueDepth: &depth})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Message, "below the activation threshold")
+	assert.Empty(t, job.wakeCh)
+
+	depth = 8
+	_, err = ac.WakeAutoscaler(job.ID, &types.WakeRequest{QueueDepth: &depth})
+	require.NoError(t, err)
+	ac.wakeWorkload(job, <-job.wakeCh)
+	state := job.Details.ScaleToZero
+	assert.False(t, state.ScaledToZero)
+	assert.True(t, state.Waking)
+	assert.Equal(t, "queue depth 8", state.LastWakeReason)
+	assert.Equal(t, int32(2), job.Details.DesiredReplicas)
+
+	// Held until the first replica is ready, then the cold start is recorded
+	assert.True(t, ac.holdWhileScaledToZero(job))
+	wokeAt := time.Now().Add(-42 * time.Second)
+	state.LastWakeTime = &wokeAt
+	assert.False(t, ac.holdWhileScaledToZero(job))
+	assert.False(t, state.Waking)
+	assert.InDelta(t, 42, state.LastColdStartSeconds, 1)
+
+	metrics := ac.GetMetrics()
+	assert.Equal(t, int64(1), metrics.TotalScaleToZero)
+	assert.Equal(t, int64(1), metrics.TotalWakes)
+	assert.InDelta(t, 42, metrics.AverageColdStartSeconds, 1)
+	assert.Equal(t, []string{
+		"Normal Scaled Deployment/inference/llm-server",
+		"Normal ScaledToZero Deployment/inference/llm-server",
+		"Normal Scaled Deployment/inference/llm-server",
+		"Normal Woken Deployment/inference/llm-server",
+		"Normal ColdStarted Deployment/inference/llm-server",
+	}, mockClient.recordedEvents())
+	mockClient.AssertExpectations(t)
+
+	// Autoscalers without scale to zero cannot be woken
+	job.Request.ScaleToZero = nil
+	_, err = ac.WakeAutoscaler(job.ID, &types.WakeRequest{})
+	assert.Error(t, err)
+}
+
+// TestCustomMetrics tests custom metric recommendations, query expansion and holding scale-down
+func TestCustomMetrics(t *testing.T) {
+	none := scalingRules{}
+	assert.Equal(t, int32(4), customMetricReplicas(types.MetricTargetAverageValue, 25, 90, 2, none, none))
+	assert.Equal(t, int32(5), customMetricReplicas(types.MetricTargetValue, 100, 250, 2, none, none))
+	assert.Equal(t, int32(0), customMetricReplicas(types.MetricTargetAverageValue, 25, 0, 2, none, none))
+	// 90 against 2 × 50 is within a 0.1 tolerance, though ceil(90 / 50) would drop a replica
+	assert.Equal(t, int32(2), customMetricReplicas(types.MetricTargetAverageValue, 50, 90, 2, none, scalingRules{tolerance: 0.1}))
+
+	mockClient := new(MockK8sClient)
+	ac := NewAutoscalingController(mockClient)
+	job := &AutoscalingJob{
+		ID: "autoscaler-1234",
+		Request: &types.AutoscalingRequest{
+			WorkloadName:      "llm-server",
+			WorkloadNamespace: "inference",
+			MinReplicas:       1,
+			MaxReplicas:       10,
+			TargetCPU:         70,
+			CustomMetrics: []types.CustomMetricTarget{
+				{Name: "queue_length", Query: `sum(vllm_num_requests_waiting{namespace="${namespace}"})`, TargetType: types.MetricTargetAverageValue, TargetValue: 10},
+				{Name: "tokens_per_second", Query: `sum(rate(tokens_total{app="${workload}"}[1m]))`, TargetType: types.MetricTargetValue, TargetValue: 1000},
+			},
+		},
+		Details: &types.AutoscalingDetails{CurrentReplicas: 4},
+	}
+
+	mockClient.On("QueryMetric", mock.Anything, `sum(vllm_num_requests_waiting{namespace="inference"})`).
+		Return(float64(65), types.ProvenanceReal, nil).Once()
+	mockClient.On("QueryMetric", mock.Anything, `sum(rate(tokens_total{app="llm-server"}[1m]))`).
+		Return(float64(500), types.ProvenanceReal, nil).Once()
+
+	// Queue length asks for 7 replicas, more than CPU (1) and tokens per second (2)
+	job.Details.CustomMetrics = evaluateCustomMetrics(mockClient.QueryMetric, "inference", "llm-server", 4, job.Request.CustomMetrics, none, none)
+	require.Len(t, job.Details.CustomMetrics, 2)
+	assert.Equal(t, int32(7), job.Details.CustomMetrics[0].DesiredReplicas)
+	assert.Equal(t, int32(2), job.Details.CustomMetrics[1].DesiredReplicas)
+	assert.Equal(t, int32(7), ac.calculateDesiredReplicas(job, 10, 0, 0, 0, 0, 0))
+
+	// Prometheus down: the queue metric is missing, so the workload is not scaled down
+	mockClient.On("QueryMetric", mock.Anything, `sum(vllm_num_requests_waiting{namespace="inference"})`).
+		Return(float64(0), types.MetricProvenance(""), fmt.Errorf("connection refused")).Once()
+	mockClient.On("QueryMetric", mock.Anything, `sum(rate(tokens_total{app="llm-server"}[1m]))`).
+		Return(float64(500), types.ProvenanceStale, nil).Once()
+
+	job.Details.CustomMetrics = evaluateCustomMetrics(mockClient.QueryMetric, "inference", "llm-server", 4, job.Request.CustomMetrics, none, none)
+	assert.Equal(t, "connection refused", job.Details.CustomMetrics[0].Error)
+	assert.Equal(t, "metric is stale", job.Details.CustomMetrics[1].Error)
+	assert.Equal(t, int32(4), ac.calculateDesiredReplicas(job, 10, 0, 0, 0, 0, 0))
+	mockClient.AssertExpectations(t)
+
+	// Without built-in targets the pod metrics are not read, unless idleness or forecasting needs them
+	assert.True(t, usesWorkloadMetrics(job))
+	job.Request.TargetCPU = 0
+	assert.False(t, usesWorkloadMetrics(job))
+	job.Request.ScaleToZero = &types.ScaleToZero{IdleTimeoutSeconds: 300}
+	assert.True(t, usesWorkloadMetrics(job))
+}
+
+// TestScheduledScaling tests schedule validation, the bounds of open windows and the 24 hour preview
+func TestScheduledScaling(t *testing.T) {
+	req := &types.AutoscalingRequest{
+		WorkloadName:      "llm-server",
+		WorkloadNamespace: "inference",
+		WorkloadType:      "Deployment",
+		MinReplicas:       1,
+		MaxReplicas:       4,
+		TargetGPU:         70,
+		Schedules: []types.ScalingSchedule{
+			{Name: "business-hours", Start: "0 9 * * 1-5", End: "0 18 * * 1-5", Timezone: "Asia/Seoul", MinReplicas: 4, MaxReplicas: 12},
+			{Name: "daily-report", Start: "0 17 * * *", End: "0 19 * * *", Timezone: "Asia/Seoul", MinReplicas: 2, MaxReplicas: 6},
+		},
+	}
+	require.NoError(t, validateSchedules(req.Schedules))
+	assert.Error(t, validateSchedules([]types.ScalingSchedule{{Name: "x", Start: "0 9 * *", End: "0 18 * * *", MinReplicas: 1, MaxReplicas: 2}}))
+	assert.Error(t, validateSchedules([]types.ScalingSchedule{{Name: "x", Start: "0 9 * * *", End: "0 18 * * *", Timezone: "Nowhere", MinReplicas: 1, MaxReplicas: 2}}))
+	assert.Error(t, validateSchedules([]types.ScalingSchedule{{Name: "x", Start: "0 9 * * *", End: "0 18 * * *", MinReplicas: 3, MaxReplicas: 2}}))
+
+	// The first open schedule applies
+	minReplicas, maxReplicas, name := replicaBounds(req, []bool{true, true})
+	assert.Equal(t, []interface{}{int32(4), int32(12), "business-hours"}, []interface{}{minReplicas, maxReplicas, name})
+	minReplicas, maxReplicas, name = replicaBounds(req, []bool{false, false})
+	assert.Equal(t, []interface{}{int32(1), int32(4), ""}, []interface{}{minReplicas, maxReplicas, name})
+
+	// Friday 2026-10-16 08:00 in Seoul
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+	windows, err := newScheduleWindows(req.Schedules)
+	require.NoError(t, err)
+	from := time.Date(2026, 10, 16, 8, 0, 0, 0, seoul)
+	preview := previewSchedule("autoscaler-1234", req, windows, from, 24*time.Hour)
+
+	at := func(hour int) time.Time { return time.Date(2026, 10, 16, hour, 0, 0, 0, seoul) }
+	require.Len(t, preview.Periods, 4)
+	expected := []types.SchedulePreviewPeriod{
+		{Start: from, End: at(9), MinReplicas: 1, MaxReplicas: 4},
+		{Start: at(9), End: at(18), Schedule: "business-hours", MinReplicas: 4, MaxReplicas: 12},
+		{Start: at(18), End: at(19), Schedule: "daily-report", MinReplicas: 2, MaxReplicas: 6},
+		{Start: at(19), End: from.Add(24 * time.Hour), MinReplicas: 1, MaxReplicas: 4},
+	}
+	for i, period := range preview.Periods {
+		assert.True(t, expected[i].Start.Equal(period.Start), "period %d start %s", i, period.Start)
+		assert.True(t, expected[i].End.Equal(period.End), "period %d end %s", i, period.End)
+		assert.Equal(t, expected[i].Schedule, period.Schedule)
+		assert.Equal(t, expected[i].MinReplicas, period.MinReplicas)
+		assert.Equal(t, expected[i].MaxReplicas, period.MaxReplicas)
+	}
+
+	// Opening a window raises the bounds, records an event and wakes a workload scaled to zero
+	mockClient := new(MockK8sClient)
+	ac := NewAutoscalingController(mockClient)
+	req.ScaleToZero = &types.ScaleToZero{IdleTimeoutSeconds: 300}
+	job := &AutoscalingJob{
+		ID:        "autoscaler-1234",
+		Request:   req,
+		Status:    types.AutoscalingStatusActive,
+		Details:   &types.AutoscalingDetails{ScaleToZero: &types.ScaleToZeroStatus{ScaledToZero: true}},
+		schedules: windows,
+	}
+	mockClient.On("ScaleWorkload", mock.Anything, "inference", "llm-server", "Deployment", int32(4)).Return(nil).Once()
+
+	ac.applySchedules(job, at(10))
+	assert.Equal(t, "business-hours", job.Details.ActiveSchedule)
+	assert.Equal(t, int32(4), job.Details.EffectiveMinReplicas)
+	assert.True(t, job.Details.ScaleToZero.Waking)
+	job.Details.CurrentReplicas = 4
+	assert.Equal(t, int32(12), ac.calculateDesiredReplicas(job, 0, 0, 300, 0, 0, 0))
+
+	ac.applySchedules(job, at(20))
+	assert.Empty(t, job.Details.ActiveSchedule)
+	assert.Equal(t, int32(4), job.Details.EffectiveMaxReplicas)
+	assert.Equal(t, []string{
+		"Normal ScheduleChanged Deployment/inference/llm-server",
+		"Normal Scaled Deployment/inference/llm-server",
+		"Normal Woken Deployment/inference/llm-server",
+		"Normal ScheduleChanged Deployment/inference/llm-server",
+	}, mockClient.recordedEvents())
+	mockClient.AssertExpectations(t)
+}
+
+// TestScalingPolicies tests the percent/pods rate limits, select policy and tolerance of the scaling policies
+func TestScalingPolicies(t *testing.T) {
+	mockClient := new(MockK8sClient)
+	ac := NewAutoscalingController(mockClient)
+
+	assert.Error(t, validateScalingPolicy("scale_up", &types.ScalingPolicy{SelectPolicy: "Average"}))
+	assert.Error(t, validateScalingPolicy("scale_up", &types.ScalingPolicy{Tolerance: 1.5}))
+	assert.Error(t, validateScalingPolicy("scale_up", &types.ScalingPolicy{
+		Policies: []types.ScalingPolicyRule{{Type: "Replicas", Value: 1, PeriodSeconds: 60}}}))
+	assert.Error(t, validateScalingPolicy("scale_down", &types.ScalingPolicy{
+		Policies: []types.ScalingPolicyRule{{Type: types.ScalingPolicyPods, Value: 1, PeriodSeconds: 3600}}}))
+
+	newJob := func(up, down *types.ScalingPolicy) *AutoscalingJob {
+		req := &types.AutoscalingRequest{MinReplicas: 1, MaxReplicas: 50, ScaleUpPolicy: up, ScaleDownPolicy: down}
+		require.NoError(t, validateScalingPolicy("scale_up", up))
+		require.NoError(t, validateScalingPolicy("scale_down", down))
+		return &AutoscalingJob{Request: req}
+	}
+	upRules := []types.ScalingPolicyRule{
+		{Type: types.ScalingPolicyPercent, Value: 100, PeriodSeconds: 15},
+		{Type: types.ScalingPolicyPods, Value: 4, PeriodSeconds: 15},
+	}
+	downRules := []types.ScalingPolicyRule{
+		{Type: types.ScalingPolicyPercent, Value: 10, PeriodSeconds: 60},
+		{Type: types.ScalingPolicyPods, Value: 2, PeriodSeconds: 60},
+	}
+
+	t.Run("select policy picks the largest or smallest change", func(t *testing.T) {
+		job := newJob(&types.ScalingPolicy{Policies: upRules}, &types.ScalingPolicy{Policies: downRules})
+		assert.Equal(t, int32(20), ac.applyStabilizationWindow(job, 10, 30)) // 100% of 10
+		assert.Equal(t, int32(8), ac.applyStabilizationWindow(job, 10, 2))   // 2 pods
+
+		job = newJob(&types.ScalingPolicy{SelectPolicy: types.SelectPolicyMin, Policies: upRules},
+			&types.ScalingPolicy{SelectPolicy: types.SelectPolicyMin, Policies: downRules})
+		assert.Equal(t, int32(14), ac.applyStabilizationWindow(job, 10, 30)) // 4 pods
+		assert.Equal(t, int32(9), ac.applyStabilizationWindow(job, 10, 2))   // 10% of 10
+	})
+
+	t.Run("changes within the period count against the limit", func(t *testing.T) {
+		job := newJob(&types.ScalingPolicy{Policies: upRules}, nil)
+		now := time.Now()
+		job.scaleEvents = recordScaleEvent(nil, 4, 8, now.Add(-10*time.Second))
+		// Started the period at 4 and already doubled
+		assert.Equal(t, int32(8), ac.applyStabilizationWindow(job, 8, 30))
+
+		// The period is over
+		job.scaleEvents = recordScaleEvent(nil, 4, 8, now.Add(-20*time.Second))
+		assert.Equal(t, int32(16), ac.applyStabilizationWindow(job, 8, 30))
+	})
+
+	t.Run("disabled direction keeps the current replicas", func(t *testing.T) {
+		job := newJob(nil, &types.ScalingPolicy{SelectPolicy: types.SelectPolicyDisabled})
+		assert.Equal(t, int32(10), ac.applyStabilizationWindow(job, 10, 2))
+		assert.Equal(t, int32(12), ac.applyStabilizationWindow(job, 10, 12))
+	})
+
+	t.Run("metric changes within the tolerance are ignored", func(t *testing.T) {
+		job := newJob(nil, &types.ScalingPolicy{Tolerance: 0.1})
+		job.Request.TargetCPU = 50
+		job.Details = &types.AutoscalingDetails{CurrentReplicas: 10}
+		assert.Equal(t, int32(10), ac.calculateDesiredReplicas(job, 46, 0, 0, 0, 0, 0))
+		assert.Equal(t, int32(11), ac.calculateDesiredReplicas(job, 56, 0, 0, 0, 0, 0))
+		assert.Equal(t, int32(8), ac.calculateDesiredReplicas(job, 40, 0, 0, 0, 0, 0))
+
+		// The tolerance applies to the usage ratio, not to the rounded replicas: 45% against 50% on
+		// 2 replicas asks for 1.8, which would be a 50% change once rounded down
+		job.Details.CurrentReplicas = 2
+		assert.Equal(t, int32(2), ac.calculateDesiredReplicas(job, 45, 0, 0, 0, 0, 0))
+		assert.Equal(t, int32(1), ac.calculateDesiredReplicas(job, 40, 0, 0, 0, 0, 0))
+	})
+}
+
+// TestRestoreJobsSkipsRecordsWithoutRequest tests that a stored record with a null request does not stop startup
+func TestRestoreJobsSkipsRecordsWithoutRequest(t *testing.T) {
+	jobStore, err := store.NewBoltStore(filepath.Join(t.TempDir(), "jobs.db"))
+	require.NoError(t, err)
+	defer jobStore.Close()
+
+	// 진행 중 상태로 저장되어 재개 경로까지 타는 레코드
+	records := map[string]string{
+		store.KindAutoscaling:   string(types.AutoscalingStatusActive),
+		store.KindCache:         string(types.CachingStatusActive),
+		store.KindLoadbalancing: string(types.LoadbalancingStatusExecuting),
+		store.KindMigration:     string(types.MigrationStatusRunning),
+		store.KindPreemption:    string(types.PreemptionStatusExecuting),
+		store.KindProvisioning:  string(types.ProvisioningStatusCreating),
+		store.KindWebhook:       "",
+	}
+	for kind, status := range records {
+		require.NoError(t, jobStore.Save(kind, "null-request", map[string]interface{}{
+			"id": "null-request", "status": status, "request": nil,
+		}))
+	}
+
+	ac := NewAutoscalingController(nil)
+	ac.SetJobStore(jobStore)
+	require.NoError(t, ac.RestoreJobs())
+	assert.Empty(t, ac.ListAutoscalers())
+
+	cc := NewCachingController(nil)
+	cc.SetJobStore(jobStore)
+	require.NoError(t, cc.RestoreJobs())
+	assert.Empty(t, cc.ListCaches())
+
+	lc := NewLoadbalancingController(nil, nil)
+	lc.SetJobStore(jobStore)
+	require.NoError(t, lc.RestoreJobs())
+	assert.Empty(t, lc.ListLoadbalancingJobs())
+
+	mc := NewMigrationController(nil)
+	mc.SetJobStore(jobStore)
+	require.NoError(t, mc.RestoreJobs())
+	assert.Empty(t, mc.ListMigrations())
+
+	pc := NewPreemptionController(nil)
+	pc.SetJobStore(jobStore)
+	require.NoError(t, pc.RestoreJobs())
+	assert.Empty(t, pc.ListPreemptions())
+
+	prc := NewProvisioningController(nil)
+	prc.SetJobStore(jobStore)
+	require.NoError(t, prc.RestoreJobs())
+	assert.Empty(t, prc.ListProvisionings())
+
+	wc := NewWebhookController()
+	wc.SetJobStore(jobStore)
+	require.NoError(t, wc.RestoreJobs())
+	assert.Empty(t, wc.ListWebhooks())
+}
@@ -2,11 +2,14 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"sync"
 	"time"
 
+	"ai-storage-orchestrator/pkg/metrics"
+	"ai-storage-orchestrator/pkg/store"
 	"ai-storage-orchestrator/pkg/types"
 
 	"github.com/google/uuid"
@@ -15,10 +18,12 @@ import (
 // CachingController manages global caching for AI workloads
 // 글로벌 캐싱 컨트롤러: Manta 스토리지 티어 간 데이터 캐싱 관리
 type CachingController struct {
-	k8sClient   K8sClientInterface
-	caches      map[string]*CacheJob
-	cachesMux   sync.RWMutex
-	metrics     *types.CachingMetrics
+	k8sClient       K8sClientInterface
+	caches          map[string]*CacheJob
+	cachesMux       sync.RWMutex
+	metrics         *types.CachingMetrics
+	metricsProvider metrics.Provider
+	jobStore        store.JobStore
 }
 
 // CacheJob represents an active cache
@@ -41,9 +46,91 @@ func NewCachingController(k8sClient K8sClientInterface) *CachingController {
 			TotalCaches:  0,
 			ActiveCaches: 0,
 		},
+		jobStore: store.NewNopStore(),
 	}
 }
 
+// SetJobStore configures the store that caches are written through
+func (cc *CachingController) SetJobStore(s store.JobStore) {
+	cc.jobStore = s
+}
+
+// SetMetricsProvider configures where cache statistics are read from
+func (cc *CachingController) SetMetricsProvider(provider metrics.Provider) {
+	cc.metricsProvider = provider
+}
+
+// RestoreJobs reloads persisted caches and resumes loading / statistics collection
+func (cc *CachingController) RestoreJobs() error {
+	restored := 0
+	err := cc.jobStore.ForEach(store.KindCache, func(id string, data []byte) error {
+		var rec cacheRecord
+		if err := json.Unmarshal(data, &rec); err != nil {
+			log.Printf("Warning: Skipping corrupt cache record %s: %v", id, err)
+			return nil
+		}
+		if rec.Request == nil {
+			log.Printf("Warning: Skipping cache record %s without a request", id)
+			return nil
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		job := &CacheJob{
+			ID:        rec.ID,
+			Request:   rec.Request,
+			Status:    rec.Status,
+			Details:   rec.Details,
+			CreatedAt: rec.CreatedAt,
+			ctx:       ctx,
+			cancel:    cancel,
+		}
+		if job.Details == nil {
+			job.Details = &types.CacheDetails{CreatedAt: rec.CreatedAt}
+		}
+
+		cc.cachesMux.Lock()
+		cc.caches[job.ID] = job
+		cc.metrics.TotalCaches++
+		if isActiveCache(job.Status) {
+			cc.metrics.ActiveCaches++
+		}
+		cc.updateTierCount(job.Request.TargetTier, 1)
+		cc.cachesMux.Unlock()
+
+		// 중단된 지점부터 캐시 작업 재개
+		switch job.Status {
+		case types.CachingStatusPending, types.CachingStatusLoading:
+			go cc.runCacheJob(job)
+		case types.CachingStatusActive:
+			go cc.collectStats(job)
+		case types.CachingStatusEvicting:
+			go cc.performEviction(job)
+		}
+		restored++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore caches: %w", err)
+	}
+
+	log.Printf("Restored %d caches", restored)
+	return nil
+}
+
+// persistJob writes the current state of a cache to the job store
+func (cc *CachingController) persistJob(job *CacheJob) {
+	cc.cachesMux.RLock()
+	defer cc.cachesMux.RUnlock()
+
+	saveRecord(cc.jobStore, store.KindCache, job.ID, &cacheRecord{
+		ID:        job.ID,
+		Request:   job.Request,
+		Status:    job.Status,
+		Details:   job.Details,
+		CreatedAt: job.CreatedAt,
+	})
+}
+
 // CreateCache creates a new cache for the specified data
 func (cc *CachingController) CreateCache(req *types.CachingRequest) (*types.CachingResponse, error) {
 	// Validate request
@@ -105,6 +192,7 @@ func (cc *CachingController) CreateCache(req *types.CachingRequest) (*types.Cach
 	cc.metrics.ActiveCaches++
 	cc.updateTierCount(req.TargetTier, 1)
 	cc.cachesMux.Unlock()
+	cc.persistJob(job)
 
 	// Start cache loading in background
 	go cc.runCacheJob(job)
@@ -152,12 +240,15 @@ func (cc *CachingController) DeleteCache(cacheID string) error {
 		job.cancel()
 	}
 
+	if isActiveCache(job.Status) {
+		cc.metrics.ActiveCaches--
+	}
 	job.Status = types.CachingStatusInactive
-	cc.metrics.ActiveCaches--
 	cc.updateTierCount(job.Request.TargetTier, -1)
 
 	delete(cc.caches, cacheID)
 	cc.cachesMux.Unlock()
+	deleteRecord(cc.jobStore, store.KindCache, cacheID)
 
 	log.Printf("Cache %s deleted", cacheID)
 	return nil
@@ -174,6 +265,7 @@ func (cc *CachingController) EvictCache(cacheID string) error {
 
 	job.Status = types.CachingStatusEvicting
 	cc.cachesMux.Unlock()
+	cc.persistJob(job)
 
 	// Perform eviction in background
 	go cc.performEviction(job)
@@ -202,6 +294,7 @@ func (cc *CachingController) MigrateTier(req *types.TierMigrationRequest) error
 	now := time.Now()
 	job.Details.UpdatedAt = &now
 	cc.cachesMux.Unlock()
+	cc.persistJob(job)
 
 	// Perform tier migration in background
 	go cc.performTierMigration(job, oldTier, req.TargetTier)
@@ -295,6 +388,18 @@ func (cc *CachingController) ListCaches() []*types.CachingResponse {
 	return result
 }
 
+// GetCacheNamespace returns the namespace of the cached PVC
+func (cc *CachingController) GetCacheNamespace(id string) (string, error) {
+	cc.cachesMux.RLock()
+	defer cc.cachesMux.RUnlock()
+
+	job, exists := cc.caches[id]
+	if !exists || job.Request == nil {
+		return "", fmt.Errorf("cache %s not found", id)
+	}
+	return job.Request.SourceNamespace, nil
+}
+
 // GetMetrics returns overall caching metrics
 func (cc *CachingController) GetMetrics() *types.CachingMetrics {
 	cc.cachesMux.RLock()
@@ -345,6 +450,7 @@ func (cc *CachingController) runCacheJob(job *CacheJob) {
 	cc.cachesMux.Lock()
 	job.Status = types.CachingStatusLoading
 	cc.cachesMux.Unlock()
+	cc.persistJob(job)
 
 	log.Printf("Cache %s: Loading data from %s/%s to %s tier",
 		job.ID, job.Request.SourceNamespace, job.Request.SourcePVC, job.Request.TargetTier)
@@ -368,6 +474,7 @@ func (cc *CachingController) runCacheJob(job *CacheJob) {
 	now := time.Now()
 	job.Details.UpdatedAt = &now
 	cc.cachesMux.Unlock()
+	cc.persistJob(job)
 
 	log.Printf("Cache %s: Active (size: %d bytes)", job.ID, sourceSize)
 
@@ -386,12 +493,24 @@ func (cc *CachingController) collectStats(job *CacheJob) {
 			return
 		case <-ticker.C:
 			cc.updateCacheStats(job)
+			cc.persistJob(job)
 		}
 	}
 }
 
-// updateCacheStats updates cache statistics (simulated)
+// updateCacheStats updates cache statistics from the metrics provider
+// 메트릭이 없으면 이전 통계를 유지하고 UnavailableReason에 사유를 기록
 func (cc *CachingController) updateCacheStats(job *CacheJob) {
+	var (
+		sample *metrics.CacheStats
+		err    = fmt.Errorf("%w: no metrics provider configured", metrics.ErrUnavailable)
+	)
+	if cc.metricsProvider != nil {
+		ctx, cancel := context.WithTimeout(job.ctx, 10*time.Second)
+		sample, err = cc.metricsProvider.CacheStats(ctx, job.Request.SourceNamespace, job.ID)
+		cancel()
+	}
+
 	cc.cachesMux.Lock()
 	defer cc.cachesMux.Unlock()
 
@@ -399,36 +518,29 @@ func (cc *CachingController) updateCacheStats(job *CacheJob) {
 		return
 	}
 
-	// Simulate statistics (in real implementation, get from Manta)
-	job.Details.Stats.TotalRequests += int64(100 + time.Now().Unix()%50)
-	job.Details.Stats.CacheHits += int64(80 + time.Now().Unix()%40)
+	if err != nil {
+		if job.Details.Stats.UnavailableReason == "" {
+			log.Printf("Cache %s: Statistics unavailable, keeping last values: %v", job.ID, err)
+		}
+		job.Details.Stats.UnavailableReason = err.Error()
+		return
+	}
+	job.Details.Stats.UnavailableReason = ""
+
+	// Manta exporter counters are cumulative
+	job.Details.Stats.TotalRequests = sample.Requests
+	job.Details.Stats.CacheHits = sample.Hits
 	job.Details.Stats.CacheMisses = job.Details.Stats.TotalRequests - job.Details.Stats.CacheHits
 
 	if job.Details.Stats.TotalRequests > 0 {
 		job.Details.Stats.HitRatio = float64(job.Details.Stats.CacheHits) / float64(job.Details.Stats.TotalRequests)
 	}
 
-	// Simulate I/O statistics based on tier
-	switch job.Request.TargetTier {
-	case types.TierNVMe:
-		job.Details.Stats.ReadThroughputMBps = 3000 + int64(time.Now().Unix()%500)
-		job.Details.Stats.WriteThroughputMBps = 2000 + int64(time.Now().Unix()%300)
-		job.Details.Stats.IOPS = 500000 + int64(time.Now().Unix()%100000)
-		job.Details.Stats.AvgReadLatencyUs = 50 + int64(time.Now().Unix()%20)
-		job.Details.Stats.AvgWriteLatencyUs = 80 + int64(time.Now().Unix()%30)
-	case types.TierSSD:
-		job.Details.Stats.ReadThroughputMBps = 500 + int64(time.Now().Unix()%100)
-		job.Details.Stats.WriteThroughputMBps = 400 + int64(time.Now().Unix()%80)
-		job.Details.Stats.IOPS = 100000 + int64(time.Now().Unix()%20000)
-		job.Details.Stats.AvgReadLatencyUs = 200 + int64(time.Now().Unix()%50)
-		job.Details.Stats.AvgWriteLatencyUs = 300 + int64(time.Now().Unix()%80)
-	case types.TierHDD:
-		job.Details.Stats.ReadThroughputMBps = 150 + int64(time.Now().Unix()%30)
-		job.Details.Stats.WriteThroughputMBps = 100 + int64(time.Now().Unix()%20)
-		job.Details.Stats.IOPS = 200 + int64(time.Now().Unix()%50)
-		job.Details.Stats.AvgReadLatencyUs = 5000 + int64(time.Now().Unix()%1000)
-		job.Details.Stats.AvgWriteLatencyUs = 8000 + int64(time.Now().Unix()%2000)
-	}
+	job.Details.Stats.ReadThroughputMBps = sample.ReadMBps
+	job.Details.Stats.WriteThroughputMBps = sample.WriteMBps
+	job.Details.Stats.IOPS = sample.IOPS
+	job.Details.Stats.AvgReadLatencyUs = sample.AvgReadLatencyUs
+	job.Details.Stats.AvgWriteLatencyUs = sample.AvgWriteLatencyUs
 
 	now := time.Now()
 	job.Details.Stats.LastAccessTime = &now
@@ -450,6 +562,9 @@ func (cc *CachingController) performEviction(job *CacheJob) {
 		job.Details.Stats.EvictedDataBytes += job.Details.CacheSizeBytes
 	}
 	job.Details.CacheSizeBytes = 0
+	if isActiveCache(job.Status) {
+		cc.metrics.ActiveCaches--
+	}
 	job.Status = types.CachingStatusInactive
 	now := time.Now()
 	job.Details.UpdatedAt = &now
@@ -457,6 +572,7 @@ func (cc *CachingController) performEviction(job *CacheJob) {
 		job.Details.Stats.LastEvictionTime = &now
 	}
 	cc.cachesMux.Unlock()
+	cc.persistJob(job)
 
 	log.Printf("Cache %s: Eviction completed", job.ID)
 }
@@ -476,6 +592,7 @@ func (cc *CachingController) performTierMigration(job *CacheJob, oldTier, newTie
 	now := time.Now()
 	job.Details.UpdatedAt = &now
 	cc.cachesMux.Unlock()
+	cc.persistJob(job)
 
 	log.Printf("Cache %s: Tier migration completed (%s -> %s)", job.ID, oldTier, newTier)
 }
@@ -499,6 +616,7 @@ func (cc *CachingController) performWarmup(job *CacheJob, paths []string, patter
 	now := time.Now()
 	job.Details.UpdatedAt = &now
 	cc.cachesMux.Unlock()
+	cc.persistJob(job)
 
 	log.Printf("Cache %s: Warmup completed", job.ID)
 }
@@ -548,6 +666,12 @@ func (cc *CachingController) updateTierCount(tier types.StorageTier, delta int64
 	}
 }
 
+// isActiveCache reports whether a cache in the status counts as active: it is loading, serving
+// or being evicted, as opposed to evicted (inactive) or failed
+func isActiveCache(status types.CachingStatus) bool {
+	return status != types.CachingStatusInactive && status != types.CachingStatusFailed
+}
+
 // getStatusMessage returns a human-readable status message
 func (cc *CachingController) getStatusMessage(status types.CachingStatus) string {
 	switch status {
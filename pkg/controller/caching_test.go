@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"ai-storage-orchestrator/pkg/store"
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCachingRestoreJobs tests that caches survive a restart and only the ones still serving count as active
+func TestCachingRestoreJobs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.db")
+	jobStore, err := store.NewBoltStore(path)
+	require.NoError(t, err)
+
+	request := func(tier types.StorageTier) *types.CachingRequest {
+		return &types.CachingRequest{SourcePVC: "dataset", SourceNamespace: "default", TargetTier: tier}
+	}
+	now := time.Now()
+	require.NoError(t, jobStore.Save(store.KindCache, "cache-active", &cacheRecord{
+		ID: "cache-active", Request: request(types.TierNVMe), Status: types.CachingStatusActive, CreatedAt: now,
+	}))
+	require.NoError(t, jobStore.Save(store.KindCache, "cache-evicted", &cacheRecord{
+		ID: "cache-evicted", Request: request(types.TierSSD), Status: types.CachingStatusInactive, CreatedAt: now,
+	}))
+	require.NoError(t, jobStore.Save(store.KindCache, "cache-corrupt", "not a cache record"))
+	require.NoError(t, jobStore.Close())
+
+	// Restart: a new controller reads the store back
+	jobStore, err = store.NewBoltStore(path)
+	require.NoError(t, err)
+	defer jobStore.Close()
+	cc := NewCachingController(nil)
+	cc.SetJobStore(jobStore)
+	require.NoError(t, cc.RestoreJobs())
+	defer func() {
+		for _, id := range []string{"cache-active", "cache-evicted"} {
+			require.NoError(t, cc.DeleteCache(id))
+		}
+		assert.Equal(t, int64(0), cc.GetMetrics().ActiveCaches)
+	}()
+
+	active, err := cc.GetCache("cache-active")
+	require.NoError(t, err)
+	assert.Equal(t, types.CachingStatusActive, active.Status)
+	evicted, err := cc.GetCache("cache-evicted")
+	require.NoError(t, err)
+	assert.Equal(t, types.CachingStatusInactive, evicted.Status)
+	_, err = cc.GetCache("cache-corrupt")
+	assert.Error(t, err)
+
+	m := cc.GetMetrics()
+	assert.Equal(t, int64(2), m.TotalCaches)
+	assert.Equal(t, int64(1), m.ActiveCaches)
+}
@@ -0,0 +1,192 @@
+// Package controller implements the DataCache CRD controller
+// kubectl apply로 선언한 DataCache를 CachingController 캐시로 생성하고 상태를 반영
+// targetTier 변경 시 티어 마이그레이션, 리소스 삭제 시 finalizer로 캐시 삭제
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	apollov1 "ai-storage-orchestrator/api/v1"
+	"ai-storage-orchestrator/pkg/types"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// DataCacheReconciler reconciles a DataCache object
+type DataCacheReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Caches *CachingController
+
+	started *jobTracker
+}
+
+// NewDataCacheReconciler creates a new reconciler
+func NewDataCacheReconciler(client client.Client, scheme *runtime.Scheme, caches *CachingController) *DataCacheReconciler {
+	return &DataCacheReconciler{
+		Client:  client,
+		Scheme:  scheme,
+		Caches:  caches,
+		started: newJobTracker(),
+	}
+}
+
+// +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=datacaches,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=datacaches/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=datacaches/finalizers,verbs=update
+
+// Reconcile creates the cache described by a DataCache and keeps its status up to date
+func (r *DataCacheReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var dc apollov1.DataCache
+	if err := r.Get(ctx, req.NamespacedName, &dc); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// 삭제 처리: 캐시 삭제 후 finalizer 제거
+	if !dc.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(&dc, apollov1.JobFinalizer) {
+			if dc.Status.JobID != "" {
+				if err := r.Caches.DeleteCache(dc.Status.JobID); err != nil {
+					log.Printf("[DataCache] %s/%s: 캐시 정리 생략: %v", dc.Namespace, dc.Name, err)
+				}
+			}
+			controllerutil.RemoveFinalizer(&dc, apollov1.JobFinalizer)
+			if err := r.Update(ctx, &dc); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		r.started.forget(dc.UID)
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(&dc, apollov1.JobFinalizer) {
+		controllerutil.AddFinalizer(&dc, apollov1.JobFinalizer)
+		if err := r.Update(ctx, &dc); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// 실패/비활성화된 캐시는 다시 생성하지 않음 (재시도는 리소스 재생성으로)
+	if dc.Status.CompletionTime != nil {
+		return ctrl.Result{}, nil
+	}
+
+	// 1. 캐시 생성
+	if dc.Status.JobID == "" {
+		jobID := r.started.get(dc.UID)
+		if jobID == "" {
+			resp, err := r.Caches.CreateCache(r.buildRequest(&dc))
+			if err != nil {
+				setJobStatus(&dc.Status.JobStatus, dc.Generation, string(types.CachingStatusFailed), err.Error(), false, true)
+				return ctrl.Result{}, r.Status().Update(ctx, &dc)
+			}
+			jobID = resp.CacheID
+			r.started.set(dc.UID, jobID)
+			log.Printf("[DataCache] %s/%s: 캐시 생성 (%s, %s → %s)",
+				dc.Namespace, dc.Name, jobID, dc.Spec.SourcePVC, dc.Spec.TargetTier)
+		}
+		startJobStatus(&dc.Status.JobStatus, jobID, dc.Generation)
+	} else if dc.Generation != dc.Status.ObservedGeneration {
+		// 2. spec 변경 반영 (티어 변경만 지원)
+		if err := r.applySpecChange(&dc); err != nil {
+			log.Printf("[DataCache] %s/%s: 티어 마이그레이션 실패: %v", dc.Namespace, dc.Name, err)
+		}
+		dc.Status.ObservedGeneration = dc.Generation
+	}
+
+	// 3. 캐시 상태 반영
+	finished := r.mirrorStatus(&dc)
+	if err := r.Status().Update(ctx, &dc); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.started.forget(dc.UID)
+
+	if finished {
+		log.Printf("[DataCache] %s/%s: %s", dc.Namespace, dc.Name, dc.Status.Phase)
+		return ctrl.Result{}, nil
+	}
+	if dc.Status.Phase == jobPhase(string(types.CachingStatusActive)) {
+		// 활성 캐시는 통계만 주기적으로 갱신
+		return ctrl.Result{RequeueAfter: defaultRequeueInterval}, nil
+	}
+	return ctrl.Result{RequeueAfter: jobRequeueInterval}, nil
+}
+
+// buildRequest converts a DataCache spec into a caching request
+func (r *DataCacheReconciler) buildRequest(dc *apollov1.DataCache) *types.CachingRequest {
+	req := &types.CachingRequest{
+		SourcePVC:       dc.Spec.SourcePVC,
+		SourceNamespace: dc.Namespace,
+		SourcePath:      dc.Spec.SourcePath,
+		TargetTier:      types.StorageTier(dc.Spec.TargetTier),
+		CacheSize:       dc.Spec.CacheSize,
+		CachePolicy:     types.CacheEvictionPolicy(dc.Spec.CachePolicy),
+		TTLSeconds:      dc.Spec.TTLSeconds,
+		Priority:        dc.Spec.Priority,
+		Prefetch:        dc.Spec.Prefetch,
+		Reason:          dc.Spec.Reason,
+	}
+	if len(dc.Spec.WorkloadLabels) > 0 || len(dc.Spec.WorkloadNames) > 0 {
+		req.WorkloadSelector = &types.WorkloadSelector{
+			Namespace:     dc.Namespace,
+			Labels:        dc.Spec.WorkloadLabels,
+			WorkloadNames: dc.Spec.WorkloadNames,
+		}
+	}
+	return req
+}
+
+// applySpecChange migrates the cache to a new tier when spec.targetTier changed
+func (r *DataCacheReconciler) applySpecChange(dc *apollov1.DataCache) error {
+	targetTier := types.StorageTier(dc.Spec.TargetTier)
+	if targetTier == types.TierAuto || string(targetTier) == dc.Status.CurrentTier {
+		return nil
+	}
+
+	return r.Caches.MigrateTier(&types.TierMigrationRequest{
+		CacheID:    dc.Status.JobID,
+		TargetTier: targetTier,
+		Reason:     fmt.Sprintf("DataCache %s/%s targetTier changed", dc.Namespace, dc.Name),
+	})
+}
+
+// mirrorStatus copies the cache state into the DataCache status
+// and reports whether the cache has stopped for good
+func (r *DataCacheReconciler) mirrorStatus(dc *apollov1.DataCache) bool {
+	resp, err := r.Caches.GetCache(dc.Status.JobID)
+	if err != nil {
+		setJobStatus(&dc.Status.JobStatus, dc.Generation, string(types.CachingStatusFailed),
+			fmt.Sprintf("%s: %s", jobLostMessage, dc.Status.JobID), false, true)
+		return true
+	}
+
+	message := resp.Message
+	if resp.Details != nil {
+		dc.Status.CurrentTier = string(resp.Details.TargetTier)
+		if resp.Details.Stats != nil {
+			dc.Status.CachedDataBytes = resp.Details.Stats.CachedDataBytes
+			dc.Status.HitRatioPercent = int32(resp.Details.Stats.HitRatio * 100)
+		}
+		if resp.Details.ErrorMessage != "" {
+			message = resp.Details.ErrorMessage
+		}
+	}
+
+	succeeded := resp.Status == types.CachingStatusActive
+	finished := resp.Status == types.CachingStatusFailed || resp.Status == types.CachingStatusInactive
+	setJobStatus(&dc.Status.JobStatus, dc.Generation, string(resp.Status), message, succeeded, finished)
+	return finished
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *DataCacheReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&apollov1.DataCache{}, builder.WithPredicates(jobObjectPredicate())).
+		Complete(r)
+}
@@ -1,11 +1,13 @@
 package controller
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"sync"
 	"time"
 
+	"ai-storage-orchestrator/pkg/store"
 	"ai-storage-orchestrator/pkg/types"
 )
 
@@ -20,6 +22,8 @@ type InsightController struct {
 	reportsByType      map[string]int64
 	reportsByNamespace map[string]int64
 	lastReportTime     time.Time
+
+	jobStore store.JobStore
 }
 
 // NewInsightController creates a new insight controller
@@ -28,9 +32,37 @@ func NewInsightController() *InsightController {
 		signatures:         make(map[string]*types.WorkloadSignature),
 		reportsByType:      make(map[string]int64),
 		reportsByNamespace: make(map[string]int64),
+		jobStore:           store.NewNopStore(),
 	}
 }
 
+// SetJobStore configures the store that workload signatures are written through
+func (c *InsightController) SetJobStore(s store.JobStore) {
+	c.jobStore = s
+}
+
+// RestoreJobs reloads persisted workload signatures
+func (c *InsightController) RestoreJobs() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := c.jobStore.ForEach(store.KindSignature, func(key string, data []byte) error {
+		var sig types.WorkloadSignature
+		if err := json.Unmarshal(data, &sig); err != nil {
+			log.Printf("[Insight] Skipping corrupt signature record %s: %v", key, err)
+			return nil
+		}
+		c.signatures[key] = &sig
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore signatures: %w", err)
+	}
+
+	log.Printf("[Insight] Restored %d workload signatures", len(c.signatures))
+	return nil
+}
+
 // ReceiveReport processes an incoming insight report from a sidecar
 func (c *InsightController) ReceiveReport(report *types.InsightReport) (*types.InsightReportResponse, error) {
 	if report == nil {
@@ -49,7 +81,15 @@ func (c *InsightController) ReceiveReport(report *types.InsightReport) (*types.I
 
 	// Store or update the signature
 	if report.Signature != nil {
+		// 시그니처의 Pod 식별자는 목록 페이지 순서의 키로 쓰이므로 리포트 값으로 채운다
+		if report.Signature.PodName == "" {
+			report.Signature.PodName = report.PodName
+		}
+		if report.Signature.PodNamespace == "" {
+			report.Signature.PodNamespace = report.PodNamespace
+		}
 		c.signatures[key] = report.Signature
+		saveRecord(c.jobStore, store.KindSignature, key, report.Signature)
 
 		// Update metrics
 		if report.Signature.WorkloadType != "" {
@@ -151,6 +191,7 @@ func (c *InsightController) CleanupStaleSignatures(maxAge time.Duration) int {
 	for key, sig := range c.signatures {
 		if sig.LastUpdated.Before(cutoff) {
 			delete(c.signatures, key)
+			deleteRecord(c.jobStore, store.KindSignature, key)
 			removed++
 			log.Printf("[Insight] Cleaned up stale signature for %s", key)
 		}
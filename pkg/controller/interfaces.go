@@ -10,12 +10,14 @@ import (
 type K8sClientInterface interface {
 	// Autoscaling operations
 	GetWorkloadReplicas(ctx context.Context, namespace, name, workloadType string) (int32, error)
-	GetWorkloadPodMetrics(ctx context.Context, namespace, workloadName string) (cpuPercent, memoryPercent, gpuPercent int32, storageReadMBps, storageWriteMBps, storageIOPS int64, err error)
+	GetWorkloadReadyReplicas(ctx context.Context, namespace, name, workloadType string) (int32, error)
+	GetWorkloadPodMetrics(ctx context.Context, namespace, workloadName string) (cpuPercent, memoryPercent, gpuPercent int32, storageReadMBps, storageWriteMBps, storageIOPS int64, provenance types.WorkloadMetricsProvenance, err error)
 	ScaleWorkload(ctx context.Context, namespace, name, workloadType string, replicas int32) error
+	QueryMetric(ctx context.Context, promql string) (float64, types.MetricProvenance, error)
 
 	// Loadbalancing operations
 	ListNodes(ctx context.Context) ([]string, error)
-	GetNodeMetrics(ctx context.Context, nodeName string) (cpuPercent, memoryPercent int32, err error)
+	GetNodeMetrics(ctx context.Context, nodeName string) (cpuPercent, memoryPercent int32, provenance types.MetricProvenance, err error)
 	GetNodeCapacity(ctx context.Context, nodeName string) (cpuCapacity, memoryCapacity string, gpuCapacity int32, err error)
 	GetNodePodCount(ctx context.Context, nodeName string) (int32, error)
 	GetNodeLabel(ctx context.Context, nodeName string, labelKey string) (string, error)
@@ -23,9 +25,25 @@ type K8sClientInterface interface {
 	ListPodsOnNode(ctx context.Context, nodeName string) ([]types.PodRef, error)
 
 	// Storage I/O operations for AI/ML workloads
-	GetNodeStorageMetrics(ctx context.Context, nodeName string) (readMBps, writeMBps, iops int64, utilization int32, err error)
+	GetNodeStorageMetrics(ctx context.Context, nodeName string) (readMBps, writeMBps, iops int64, utilization int32, provenance types.MetricProvenance, err error)
 
 	// Preemption operations
 	GetPodResourceInfo(ctx context.Context, namespace, name string) (*types.PodResourceInfo, error)
 	EvictPod(ctx context.Context, namespace, name string, gracePeriodSeconds int64) error
+
+	// Provisioning operations
+	CreatePVC(ctx context.Context, spec *types.PVCSpec) error
+	GetPVCStatus(ctx context.Context, namespace, name string) (*types.PVCStatus, error)
+	ListPodsUsingPVC(ctx context.Context, namespace, pvcName string) ([]string, error)
+	DeletePVC(ctx context.Context, namespace, name string) error
+
+	// Events on the objects the controllers act on
+	RecordEvent(kind, namespace, name, eventType, reason, message string)
+}
+
+// MigrationRunner defines the migration operations the loadbalancing controller delegates to
+type MigrationRunner interface {
+	StartMigration(req *types.MigrationRequest) (*types.MigrationResponse, error)
+	WaitForMigration(ctx context.Context, migrationID string) (*types.MigrationResponse, error)
+	CancelMigration(migrationID string) error
 }
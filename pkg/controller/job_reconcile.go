@@ -0,0 +1,177 @@
+// 작업 기반 CRD Reconciler 공통 헬퍼
+//
+// PodMigration, LoadbalancingPolicy, PreemptionRequest, StorageProvisioning, DataCache
+// Reconciler는 모두 같은 흐름을 따름:
+//  1. status.jobID가 없으면 spec으로 기존 컨트롤러의 작업을 시작하고 jobID 기록
+//  2. jobID가 있으면 컨트롤러에서 작업 상태를 조회해 status/conditions에 반영
+//  3. 작업이 진행 중이면 다시 큐에 넣고 반복
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	apollov1 "ai-storage-orchestrator/api/v1"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	// 진행 중인 작업의 상태 확인 주기
+	jobRequeueInterval = 5 * time.Second
+
+	// 작업을 찾을 수 없을 때 사용하는 메시지 (오케스트레이터 재시작 등)
+	jobLostMessage = "job not found in orchestrator"
+)
+
+// jobPhase converts an orchestrator job status ("running") into a CRD phase ("Running")
+func jobPhase(status string) string {
+	if status == "" {
+		return ""
+	}
+	return strings.ToUpper(status[:1]) + status[1:]
+}
+
+// startJobStatus records a newly started orchestrator job in the CRD status
+func startJobStatus(st *apollov1.JobStatus, jobID string, generation int64) {
+	now := metav1.Now()
+	st.JobID = jobID
+	st.ObservedGeneration = generation
+	st.StartTime = &now
+	st.CompletionTime = nil
+}
+
+// setJobStatus mirrors the orchestrator job status into the CRD status
+// succeeded: 작업이 목표 상태에 도달 (Ready=True)
+// finished:  작업이 더 이상 진행되지 않음 (Progressing=False, completionTime 기록)
+func setJobStatus(st *apollov1.JobStatus, generation int64, status, message string, succeeded, finished bool) {
+	now := metav1.Now()
+	phase := jobPhase(status)
+
+	st.Phase = phase
+	st.Message = message
+	st.LastUpdated = &now
+	if finished && st.CompletionTime == nil {
+		st.CompletionTime = &now
+	}
+
+	ready := metav1.Condition{
+		Type:               apollov1.ConditionTypeReady,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             phase,
+		Message:            message,
+	}
+	if succeeded {
+		ready.Status = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(&st.Conditions, ready)
+
+	progressing := metav1.Condition{
+		Type:               apollov1.ConditionTypeProgressing,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             phase,
+		Message:            message,
+	}
+	if finished {
+		progressing.Status = metav1.ConditionFalse
+	}
+	meta.SetStatusCondition(&st.Conditions, progressing)
+}
+
+// jobObjectPredicate reconciles on spec changes and deletion but ignores the
+// reconciler's own status updates (진행 상황은 RequeueAfter로 확인)
+func jobObjectPredicate() predicate.Predicate {
+	return predicate.Or(
+		predicate.GenerationChangedPredicate{},
+		predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return !e.ObjectNew.GetDeletionTimestamp().IsZero()
+			},
+		},
+	)
+}
+
+// jobTracker remembers job IDs started for an object until they are persisted in its status,
+// so a failed status update does not start the same job twice
+type jobTracker struct {
+	mu   sync.Mutex
+	jobs map[ktypes.UID]string
+}
+
+func newJobTracker() *jobTracker {
+	return &jobTracker{jobs: make(map[ktypes.UID]string)}
+}
+
+// get returns the job ID started for the object, if any
+func (t *jobTracker) get(uid ktypes.UID) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.jobs[uid]
+}
+
+// set records the job ID started for the object
+func (t *jobTracker) set(uid ktypes.UID, jobID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.jobs[uid] = jobID
+}
+
+// forget drops the object once its job ID is persisted or the object is gone
+func (t *jobTracker) forget(uid ktypes.UID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.jobs, uid)
+}
+
+// namespaceScope decides which namespaces a namespaced CR may target. An empty spec.namespace is the
+// namespace of the CR itself; other namespaces and all namespaces ("*") are only allowed for CRs in
+// the namespaces given to allow, so creating a CR in one namespace never moves pods of another.
+type namespaceScope struct {
+	mu      sync.RWMutex
+	allowed map[string]bool
+}
+
+// allow lets CRs in the given namespaces target any namespace
+func (s *namespaceScope) allow(namespaces []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.allowed = make(map[string]bool, len(namespaces))
+	for _, ns := range namespaces {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			s.allowed[ns] = true
+		}
+	}
+}
+
+// crossNamespace reports whether resources in the namespace may reach other namespaces
+func (s *namespaceScope) crossNamespace(namespace string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.allowed[namespace]
+}
+
+// resolve returns the namespace a job started from a CR in objNamespace acts on, "" for all namespaces
+func (s *namespaceScope) resolve(objNamespace, target string) (string, error) {
+	if target == "" || target == objNamespace {
+		return objNamespace, nil
+	}
+	if !s.crossNamespace(objNamespace) {
+		scope := "namespace " + target
+		if target == apollov1.AllNamespaces {
+			scope = "all namespaces"
+		}
+		return "", fmt.Errorf("resources in namespace %s cannot target %s", objNamespace, scope)
+	}
+	if target == apollov1.AllNamespaces {
+		return "", nil
+	}
+	return target, nil
+}
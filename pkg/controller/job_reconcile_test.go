@@ -0,0 +1,224 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	apollov1 "ai-storage-orchestrator/api/v1"
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func TestSetJobStatus(t *testing.T) {
+	var st apollov1.JobStatus
+	startJobStatus(&st, "migration-1234", 1)
+
+	setJobStatus(&st, 1, "running", "Migration in progress", false, false)
+	assert.Equal(t, "migration-1234", st.JobID)
+	assert.Equal(t, "Running", st.Phase)
+	assert.Nil(t, st.CompletionTime)
+	assert.True(t, meta.IsStatusConditionTrue(st.Conditions, apollov1.ConditionTypeProgressing))
+	assert.True(t, meta.IsStatusConditionFalse(st.Conditions, apollov1.ConditionTypeReady))
+
+	setJobStatus(&st, 1, "completed", "Migration completed successfully", true, true)
+	assert.Equal(t, "Completed", st.Phase)
+	assert.NotNil(t, st.CompletionTime)
+	assert.True(t, meta.IsStatusConditionFalse(st.Conditions, apollov1.ConditionTypeProgressing))
+	assert.True(t, meta.IsStatusConditionTrue(st.Conditions, apollov1.ConditionTypeReady))
+	assert.Len(t, st.Conditions, 2)
+}
+
+func TestStorageProvisioningReconcilerLifecycle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, apollov1.AddToScheme(scheme))
+
+	sp := &apollov1.StorageProvisioning{
+		ObjectMeta: metav1.ObjectMeta{Name: "dataset", Namespace: "ai-workloads", Generation: 1},
+		Spec: apollov1.StorageProvisioningSpec{
+			WorkloadName: "pytorch-training",
+			WorkloadType: "training",
+			AutoSize:     true,
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(sp).
+		WithStatusSubresource(&apollov1.StorageProvisioning{}).
+		Build()
+
+	mockClient := new(MockK8sClient)
+	mockClient.On("CreatePVC", mock.Anything, mock.Anything).Return(nil)
+	mockClient.On("GetPVCStatus", mock.Anything, "ai-workloads", mock.Anything).
+		Return(&types.PVCStatus{Phase: "Pending", WaitForFirstConsumer: true}, nil)
+	mockClient.On("ListPodsUsingPVC", mock.Anything, "ai-workloads", mock.Anything).Return([]string{}, nil)
+	mockClient.On("DeletePVC", mock.Anything, "ai-workloads", mock.Anything).Return(nil)
+
+	provisionings := NewProvisioningController(mockClient)
+	r := NewStorageProvisioningReconciler(c, scheme, provisionings)
+	ctx := context.Background()
+	key := ktypes.NamespacedName{Namespace: "ai-workloads", Name: "dataset"}
+
+	// 첫 Reconcile: finalizer 추가 + 프로비저닝 작업 시작
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Equal(t, jobRequeueInterval, result.RequeueAfter)
+
+	var current apollov1.StorageProvisioning
+	require.NoError(t, c.Get(ctx, key, &current))
+	assert.True(t, controllerutil.ContainsFinalizer(&current, apollov1.JobFinalizer))
+	require.NotEmpty(t, current.Status.JobID)
+	assert.Equal(t, "500Gi", current.Status.ActualSize)
+	assert.Equal(t, "high-throughput", current.Status.ActualClass)
+	assert.NotEmpty(t, current.Status.PVCName)
+
+	jobID := current.Status.JobID
+	_, err = provisionings.GetProvisioning(jobID)
+	require.NoError(t, err)
+
+	// 재조정해도 같은 작업을 유지
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	require.NoError(t, c.Get(ctx, key, &current))
+	assert.Equal(t, jobID, current.Status.JobID)
+	assert.Len(t, provisionings.ListProvisionings(), 1)
+
+	// 삭제: 프로비저닝 정리 후 finalizer 제거
+	require.NoError(t, c.Delete(ctx, &current))
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	_, err = provisionings.GetProvisioning(jobID)
+	assert.Error(t, err)
+	assert.Error(t, c.Get(ctx, key, &current), "object should be gone once the finalizer is removed")
+}
+
+// newJobReconcileClient returns a fake API client holding the given objects
+func newJobReconcileClient(t *testing.T, objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, apollov1.AddToScheme(scheme))
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&apollov1.PreemptionRequest{}, &apollov1.LoadbalancingPolicy{}).
+		Build()
+}
+
+func TestPreemptionRequestReconcilerNamespaceScope(t *testing.T) {
+	// 노드 분석에서 바로 실패시켜 실제 선점은 일어나지 않음
+	mockClient := new(MockK8sClient)
+	mockClient.On("GetNodeMetrics", mock.Anything, "gpu-node-1").
+		Return(int32(0), int32(0), types.MetricProvenance(""), fmt.Errorf("metrics unavailable"))
+
+	newRequest := func(namespace, name, target string) *apollov1.PreemptionRequest {
+		return &apollov1.PreemptionRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: ktypes.UID(namespace + "/" + name), Generation: 1},
+			Spec: apollov1.PreemptionRequestSpec{
+				NodeName:     "gpu-node-1",
+				Namespace:    target,
+				ResourceType: "gpu",
+				TargetAmount: "1",
+			},
+		}
+	}
+	c := newJobReconcileClient(t,
+		newRequest("team-a", "default-scope", ""),
+		newRequest("team-a", "other-namespace", "team-b"),
+		newRequest("team-a", "all-namespaces", apollov1.AllNamespaces),
+		newRequest("ai-storage-system", "cluster-wide", apollov1.AllNamespaces),
+	)
+	preemptions := NewPreemptionController(mockClient)
+	r := NewPreemptionRequestReconciler(c, c.Scheme(), preemptions)
+	r.AllowCrossNamespace([]string{"ai-storage-system"})
+	ctx := context.Background()
+
+	reconcile := func(namespace, name string) apollov1.PreemptionRequest {
+		key := ktypes.NamespacedName{Namespace: namespace, Name: name}
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		require.NoError(t, err)
+		var pr apollov1.PreemptionRequest
+		require.NoError(t, c.Get(ctx, key, &pr))
+		return pr
+	}
+	jobNamespace := func(pr apollov1.PreemptionRequest) string {
+		require.NotEmpty(t, pr.Status.JobID)
+		preemptions.jobsMux.RLock()
+		defer preemptions.jobsMux.RUnlock()
+		return preemptions.jobs[pr.Status.JobID].Request.Namespace
+	}
+
+	// 비어있으면 리소스와 같은 네임스페이스
+	assert.Equal(t, "team-a", jobNamespace(reconcile("team-a", "default-scope")))
+
+	// 다른 네임스페이스와 전체는 거부
+	for _, name := range []string{"other-namespace", "all-namespaces"} {
+		pr := reconcile("team-a", name)
+		assert.Empty(t, pr.Status.JobID, name)
+		assert.Equal(t, "Failed", pr.Status.Phase, name)
+		assert.Contains(t, pr.Status.Message, "resources in namespace team-a cannot target", name)
+	}
+
+	// 허용된 네임스페이스의 리소스는 전체 네임스페이스 대상 가능
+	assert.Equal(t, "", jobNamespace(reconcile("ai-storage-system", "cluster-wide")))
+}
+
+func TestLoadbalancingPolicyReconcilerNamespaceScope(t *testing.T) {
+	// 클러스터 분석에서 바로 실패시켜 실제 마이그레이션은 일어나지 않음
+	mockClient := new(MockK8sClient)
+	mockClient.On("ListNodes", mock.Anything).Return([]string(nil), fmt.Errorf("nodes unavailable"))
+
+	newPolicy := func(namespace, name, target string) *apollov1.LoadbalancingPolicy {
+		return &apollov1.LoadbalancingPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: ktypes.UID(namespace + "/" + name), Generation: 1},
+			Spec:       apollov1.LoadbalancingPolicySpec{Namespace: target, Strategy: string(types.StrategyLeastLoaded)},
+		}
+	}
+	c := newJobReconcileClient(t,
+		newPolicy("team-a", "default-scope", ""),
+		newPolicy("team-a", "same-namespace", "team-a"),
+		newPolicy("team-a", "other-namespace", "team-b"),
+		newPolicy("team-a", "all-namespaces", apollov1.AllNamespaces),
+		newPolicy("ai-storage-system", "team-b", "team-b"),
+	)
+	loadbalancing := NewLoadbalancingController(mockClient, nil)
+	r := NewLoadbalancingPolicyReconciler(c, c.Scheme(), loadbalancing)
+	r.AllowCrossNamespace([]string{"ai-storage-system"})
+	ctx := context.Background()
+
+	reconcile := func(namespace, name string) apollov1.LoadbalancingPolicy {
+		key := ktypes.NamespacedName{Namespace: namespace, Name: name}
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		require.NoError(t, err)
+		var lp apollov1.LoadbalancingPolicy
+		require.NoError(t, c.Get(ctx, key, &lp))
+		return lp
+	}
+	jobNamespace := func(lp apollov1.LoadbalancingPolicy) string {
+		require.NotEmpty(t, lp.Status.JobID)
+		loadbalancing.jobsMux.RLock()
+		defer loadbalancing.jobsMux.RUnlock()
+		return loadbalancing.jobs[lp.Status.JobID].Request.Namespace
+	}
+
+	assert.Equal(t, "team-a", jobNamespace(reconcile("team-a", "default-scope")))
+	assert.Equal(t, "team-a", jobNamespace(reconcile("team-a", "same-namespace")))
+	for _, name := range []string{"other-namespace", "all-namespaces"} {
+		lp := reconcile("team-a", name)
+		assert.Empty(t, lp.Status.JobID, name)
+		assert.Equal(t, "Failed", lp.Status.Phase, name)
+		assert.NotNil(t, lp.Status.CompletionTime, name)
+		assert.Contains(t, lp.Status.Message, "cannot target", name)
+	}
+	assert.Equal(t, "team-b", jobNamespace(reconcile("ai-storage-system", "team-b")))
+}
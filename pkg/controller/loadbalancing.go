@@ -2,25 +2,45 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"math"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	"ai-storage-orchestrator/pkg/eventbus"
+	"ai-storage-orchestrator/pkg/store"
 	"ai-storage-orchestrator/pkg/types"
 
 	"github.com/google/uuid"
 )
 
+// errMetricsNotReal means a decision was skipped because its input metrics were not real
+var errMetricsNotReal = errors.New("metrics are not real")
+
+// ErrInvalidLoadbalancingRequest is returned for loadbalancing requests that fail validation
+var ErrInvalidLoadbalancingRequest = errors.New("invalid request")
+
+const (
+	// loadbalancingMigrationTimeout is the timeout in seconds given to each child migration
+	loadbalancingMigrationTimeout = 600
+	// defaultMaxConcurrentMigrations bounds how many child migrations run at once
+	defaultMaxConcurrentMigrations = 2
+)
+
 // LoadbalancingController manages loadbalancing operations
 type LoadbalancingController struct {
 	k8sClient          K8sClientInterface
-	migrationController *MigrationController
+	migrationController MigrationRunner
 	jobs               map[string]*LoadbalancingJob
 	jobsMux            sync.RWMutex
 	metrics            *types.LoadbalancingMetrics
+	jobStore           store.JobStore
+	events             *eventbus.Bus
 }
 
 // LoadbalancingJob represents an active loadbalancing job
@@ -35,7 +55,7 @@ type LoadbalancingJob struct {
 }
 
 // NewLoadbalancingController creates a new loadbalancing controller
-func NewLoadbalancingController(k8sClient K8sClientInterface, migrationController *MigrationController) *LoadbalancingController {
+func NewLoadbalancingController(k8sClient K8sClientInterface, migrationController MigrationRunner) *LoadbalancingController {
 	return &LoadbalancingController{
 		k8sClient:          k8sClient,
 		migrationController: migrationController,
@@ -44,14 +64,109 @@ func NewLoadbalancingController(k8sClient K8sClientInterface, migrationControlle
 			TotalLoadbalancingJobs:  0,
 			ActiveLoadbalancingJobs: 0,
 		},
+		jobStore: store.NewNopStore(),
+	}
+}
+
+// SetJobStore configures the store that loadbalancing jobs are written through
+func (lc *LoadbalancingController) SetJobStore(s store.JobStore) {
+	lc.jobStore = s
+}
+
+// SetEventBus publishes status transitions and cycle results of every loadbalancing job
+func (lc *LoadbalancingController) SetEventBus(bus *eventbus.Bus) {
+	lc.events = bus
+}
+
+// RestoreJobs reloads persisted loadbalancing jobs and resumes periodic ones.
+// One-time jobs that were interrupted mid-cycle are marked failed instead of re-run,
+// since some of their migrations may already have been executed.
+func (lc *LoadbalancingController) RestoreJobs() error {
+	restored := 0
+	err := lc.jobStore.ForEach(store.KindLoadbalancing, func(id string, data []byte) error {
+		var rec loadbalancingRecord
+		if err := json.Unmarshal(data, &rec); err != nil {
+			log.Printf("Warning: Skipping corrupt loadbalancing record %s: %v", id, err)
+			return nil
+		}
+		if rec.Request == nil {
+			log.Printf("Warning: Skipping loadbalancing record %s without a request", id)
+			return nil
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		job := &LoadbalancingJob{
+			ID:        rec.ID,
+			Request:   rec.Request,
+			Status:    rec.Status,
+			Details:   rec.Details,
+			CreatedAt: rec.CreatedAt,
+			ctx:       ctx,
+			cancel:    cancel,
+		}
+		if job.Details == nil {
+			job.Details = &types.LoadbalancingDetails{CreatedAt: rec.CreatedAt}
+		}
+
+		inFlight := job.Status != types.LoadbalancingStatusCompleted &&
+			job.Status != types.LoadbalancingStatusFailed &&
+			job.Status != types.LoadbalancingStatusCancelled
+		resume := inFlight && job.Request.Interval > 0
+
+		lc.jobsMux.Lock()
+		lc.jobs[job.ID] = job
+		lc.metrics.TotalLoadbalancingJobs++
+		lc.metrics.SuccessfulMigrations += job.Details.SuccessfulMigrations
+		lc.metrics.FailedMigrations += job.Details.FailedMigrations
+		lc.metrics.TotalMigrationsExecuted += job.Details.SuccessfulMigrations
+		if resume {
+			lc.metrics.ActiveLoadbalancingJobs++
+		} else if inFlight {
+			job.Status = types.LoadbalancingStatusFailed
+			job.Details.ErrorMessage = interruptedMessage
+			completedAt := time.Now()
+			job.Details.CompletedAt = &completedAt
+		}
+		lc.jobsMux.Unlock()
+
+		if resume {
+			go lc.runLoadbalancing(job)
+		} else {
+			cancel()
+			if inFlight {
+				lc.persistJob(job)
+			}
+		}
+		restored++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore loadbalancing jobs: %w", err)
 	}
+
+	log.Printf("Restored %d loadbalancing jobs", restored)
+	return nil
+}
+
+// persistJob writes the current state of a loadbalancing job to the job store
+func (lc *LoadbalancingController) persistJob(job *LoadbalancingJob) {
+	lc.jobsMux.RLock()
+	defer lc.jobsMux.RUnlock()
+
+	saveRecord(lc.jobStore, store.KindLoadbalancing, job.ID, &loadbalancingRecord{
+		ID:        job.ID,
+		Request:   job.Request,
+		Status:    job.Status,
+		Details:   job.Details,
+		CreatedAt: job.CreatedAt,
+	})
 }
 
 // StartLoadbalancing initiates a new loadbalancing job
 func (lc *LoadbalancingController) StartLoadbalancing(req *types.LoadbalancingRequest) (string, error) {
 	// Validate request
 	if err := lc.validateRequest(req); err != nil {
-		return "", fmt.Errorf("invalid request: %w", err)
+		return "", fmt.Errorf("%w: %v", ErrInvalidLoadbalancingRequest, err)
 	}
 
 	// Create loadbalancing job
@@ -78,6 +193,7 @@ func (lc *LoadbalancingController) StartLoadbalancing(req *types.LoadbalancingRe
 	lc.metrics.TotalLoadbalancingJobs++
 	lc.metrics.ActiveLoadbalancingJobs++
 	lc.jobsMux.Unlock()
+	lc.persistJob(job)
 
 	// Start loadbalancing goroutine
 	go lc.runLoadbalancing(job)
@@ -125,6 +241,9 @@ func (lc *LoadbalancingController) validateRequest(req *types.LoadbalancingReque
 	if req.MaxMigrationsPerCycle == 0 {
 		req.MaxMigrationsPerCycle = 5
 	}
+	if req.MaxConcurrentMigrations <= 0 {
+		req.MaxConcurrentMigrations = defaultMaxConcurrentMigrations
+	}
 
 	// Set default Storage I/O thresholds for AI/ML workloads
 	if req.StorageReadThreshold == 0 {
@@ -152,7 +271,13 @@ func (lc *LoadbalancingController) runLoadbalancing(job *LoadbalancingJob) {
 
 	// One-time execution (interval == 0)
 	if job.Request.Interval == 0 {
-		if err := lc.executeCycle(job); err != nil {
+		err := lc.executeCycle(job)
+		// executeMigrations stops early without an error once the job is cancelled
+		if job.ctx.Err() != nil {
+			lc.markCancelled(job)
+			return
+		}
+		if err != nil {
 			log.Printf("Loadbalancing job %s failed: %v", job.ID, err)
 			lc.jobsMux.Lock()
 			job.Status = types.LoadbalancingStatusFailed
@@ -160,6 +285,8 @@ func (lc *LoadbalancingController) runLoadbalancing(job *LoadbalancingJob) {
 			completedAt := time.Now()
 			job.Details.CompletedAt = &completedAt
 			lc.jobsMux.Unlock()
+			lc.persistJob(job)
+			lc.publishStatus(job, types.LoadbalancingStatusFailed, err.Error())
 			return
 		}
 		lc.jobsMux.Lock()
@@ -167,6 +294,8 @@ func (lc *LoadbalancingController) runLoadbalancing(job *LoadbalancingJob) {
 		completedAt := time.Now()
 		job.Details.CompletedAt = &completedAt
 		lc.jobsMux.Unlock()
+		lc.persistJob(job)
+		lc.publishStatus(job, types.LoadbalancingStatusCompleted, lc.getStatusMessage(types.LoadbalancingStatusCompleted))
 		log.Printf("Loadbalancing job %s completed", job.ID)
 		return
 	}
@@ -184,17 +313,14 @@ func (lc *LoadbalancingController) runLoadbalancing(job *LoadbalancingJob) {
 			completedAt := time.Now()
 			job.Details.CompletedAt = &completedAt
 			lc.jobsMux.Unlock()
+			lc.persistJob(job)
+			lc.publishStatus(job, types.LoadbalancingStatusFailed, err.Error())
 			return
 		}
 
 		select {
 		case <-job.ctx.Done():
-			lc.jobsMux.Lock()
-			job.Status = types.LoadbalancingStatusCancelled
-			completedAt := time.Now()
-			job.Details.CompletedAt = &completedAt
-			lc.jobsMux.Unlock()
-			log.Printf("Loadbalancing job %s cancelled", job.ID)
+			lc.markCancelled(job)
 			return
 		case <-ticker.C:
 			// Continue to next cycle
@@ -202,12 +328,28 @@ func (lc *LoadbalancingController) runLoadbalancing(job *LoadbalancingJob) {
 	}
 }
 
+// markCancelled records that a job stopped because it was cancelled
+func (lc *LoadbalancingController) markCancelled(job *LoadbalancingJob) {
+	lc.jobsMux.Lock()
+	job.Status = types.LoadbalancingStatusCancelled
+	completedAt := time.Now()
+	job.Details.CompletedAt = &completedAt
+	lc.jobsMux.Unlock()
+	lc.persistJob(job)
+	lc.publishStatus(job, types.LoadbalancingStatusCancelled, lc.getStatusMessage(types.LoadbalancingStatusCancelled))
+	log.Printf("Loadbalancing job %s cancelled", job.ID)
+}
+
 // executeCycle executes one cycle of loadbalancing
-func (lc *LoadbalancingController) executeCycle(job *LoadbalancingJob) error {
+func (lc *LoadbalancingController) executeCycle(job *LoadbalancingJob) (err error) {
+	defer lc.persistJob(job)
+	defer func() { lc.publishCycle(job, err) }()
+
 	// Phase 1: Analyze cluster state
 	lc.jobsMux.Lock()
 	job.Status = types.LoadbalancingStatusAnalyzing
 	lc.jobsMux.Unlock()
+	lc.publishStatus(job, types.LoadbalancingStatusAnalyzing, lc.getStatusMessage(types.LoadbalancingStatusAnalyzing))
 
 	clusterState, err := lc.analyzeClusterState(job)
 	if err != nil {
@@ -220,15 +362,30 @@ func (lc *LoadbalancingController) executeCycle(job *LoadbalancingJob) error {
 
 	// Phase 2: Calculate migration plan
 	migrationPlan, err := lc.calculateMigrationPlan(job, clusterState)
+	if errors.Is(err, errMetricsNotReal) {
+		log.Printf("Loadbalancing job %s: Skipping cycle, %v", job.ID, err)
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("failed to calculate migration plan: %w", err)
 	}
 
+	// Predict the outcome of the plan with the cluster model
+	predicted := lc.predictClusterState(context.Background(), clusterState, migrationPlan)
+
 	lc.jobsMux.Lock()
 	job.Details.PlannedMigrations = migrationPlan
 	job.Details.PodsToMigrate = int32(len(migrationPlan))
+	job.Details.PredictedState = predicted
 	lc.jobsMux.Unlock()
 
+	// Dry run: report the predicted outcome instead of migrating pods
+	if job.Request.DryRun {
+		log.Printf("Loadbalancing job %s (dry run): %d migrations planned, balance score %.1f -> %.1f (predicted)",
+			job.ID, len(migrationPlan), clusterState.BalanceScore, predicted.BalanceScore)
+		return nil
+	}
+
 	// If no migrations needed, return success
 	if len(migrationPlan) == 0 {
 		log.Printf("Loadbalancing job %s: Cluster is already balanced", job.ID)
@@ -239,6 +396,8 @@ func (lc *LoadbalancingController) executeCycle(job *LoadbalancingJob) error {
 	lc.jobsMux.Lock()
 	job.Status = types.LoadbalancingStatusExecuting
 	lc.jobsMux.Unlock()
+	lc.persistJob(job)
+	lc.publishStatus(job, types.LoadbalancingStatusExecuting, fmt.Sprintf("Executing %d migrations", len(migrationPlan)))
 
 	if err := lc.executeMigrations(job, migrationPlan); err != nil {
 		return fmt.Errorf("failed to execute migrations: %w", err)
@@ -250,6 +409,12 @@ func (lc *LoadbalancingController) executeCycle(job *LoadbalancingJob) error {
 		log.Printf("Warning: Failed to analyze final cluster state: %v", err)
 	} else {
 		improvement := lc.calculateImprovement(&job.Details.InitialState, finalState)
+		improvement.PredictedBalanceScoreImprovement = predicted.BalanceScore - clusterState.BalanceScore
+		improvement.PredictedCPUVarianceAfter = lc.calculateCoefficientOfVariation(predicted.Nodes, "cpu")
+		improvement.PredictedMemoryVarianceAfter = lc.calculateCoefficientOfVariation(predicted.Nodes, "memory")
+		improvement.PredictedGPUVarianceAfter = lc.calculateCoefficientOfVariation(predicted.Nodes, "gpu")
+		log.Printf("Loadbalancing job %s: balance score improvement %.1f (predicted %.1f)",
+			job.ID, improvement.BalanceScoreImprovement, improvement.PredictedBalanceScoreImprovement)
 		lc.jobsMux.Lock()
 		job.Details.ResourceImprovement = improvement
 		lc.metrics.AverageBalanceScore = finalState.BalanceScore
@@ -259,6 +424,102 @@ func (lc *LoadbalancingController) executeCycle(job *LoadbalancingJob) error {
 	return nil
 }
 
+// publishStatus publishes a status transition of a loadbalancing job
+func (lc *LoadbalancingController) publishStatus(job *LoadbalancingJob, status types.LoadbalancingStatus, message string) {
+	lc.events.Publish(eventbus.Event{
+		Kind:      eventbus.KindLoadbalancing,
+		Type:      eventbus.TypeStatus,
+		JobID:     job.ID,
+		Namespace: job.Request.Namespace,
+		Status:    string(status),
+		Message:   message,
+	})
+}
+
+// publishCycle publishes the result of one loadbalancing cycle
+func (lc *LoadbalancingController) publishCycle(job *LoadbalancingJob, cycleErr error) {
+	lc.jobsMux.RLock()
+	result := map[string]interface{}{
+		"dry_run":               job.Request.DryRun,
+		"balance_score":         job.Details.InitialState.BalanceScore,
+		"planned_migrations":    len(job.Details.PlannedMigrations),
+		"successful_migrations": job.Details.SuccessfulMigrations,
+		"failed_migrations":     job.Details.FailedMigrations,
+	}
+	if job.Details.PredictedState != nil {
+		result["predicted_balance_score"] = job.Details.PredictedState.BalanceScore
+	}
+	if job.Details.ResourceImprovement != nil {
+		result["balance_score_improvement"] = job.Details.ResourceImprovement.BalanceScoreImprovement
+	}
+	status := job.Status
+	lc.jobsMux.RUnlock()
+
+	message := fmt.Sprintf("Cycle finished: %v migrations planned", result["planned_migrations"])
+	if cycleErr != nil {
+		message = fmt.Sprintf("Cycle failed: %v", cycleErr)
+	}
+	lc.events.Publish(eventbus.Event{
+		Kind:      eventbus.KindLoadbalancing,
+		Type:      eventbus.TypeCycle,
+		JobID:     job.ID,
+		Namespace: job.Request.Namespace,
+		Status:    string(status),
+		Message:   message,
+		Data:      result,
+	})
+}
+
+// PlanLoadbalancing analyzes the cluster and calculates a migration plan with its predicted
+// outcome, without creating a job or migrating any pods
+func (lc *LoadbalancingController) PlanLoadbalancing(req *types.LoadbalancingRequest) (*types.LoadbalancingPlan, error) {
+	if err := lc.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLoadbalancingRequest, err)
+	}
+
+	job := &LoadbalancingJob{
+		ID:      "plan",
+		Request: req,
+		Details: &types.LoadbalancingDetails{CreatedAt: time.Now()},
+	}
+
+	clusterState, err := lc.analyzeClusterState(job)
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze cluster state: %w", err)
+	}
+
+	migrationPlan, err := lc.calculateMigrationPlan(job, clusterState)
+	if errors.Is(err, errMetricsNotReal) {
+		// Report the observed state without a plan rather than failing the request
+		log.Printf("Loadbalancing plan (%s): no plan, %v", req.Strategy, err)
+		return &types.LoadbalancingPlan{
+			Strategy:              req.Strategy,
+			ClusterState:          *clusterState,
+			PlannedMigrations:     []types.MigrationPlan{},
+			PredictedState:        *clusterState,
+			PredictedBalanceScore: clusterState.BalanceScore,
+			Conditions:            job.Details.Conditions,
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate migration plan: %w", err)
+	}
+
+	predicted := lc.predictClusterState(context.Background(), clusterState, migrationPlan)
+	log.Printf("Loadbalancing plan (%s): %d migrations, balance score %.1f -> %.1f (predicted)",
+		req.Strategy, len(migrationPlan), clusterState.BalanceScore, predicted.BalanceScore)
+
+	return &types.LoadbalancingPlan{
+		Strategy:              req.Strategy,
+		ClusterState:          *clusterState,
+		PlannedMigrations:     migrationPlan,
+		PredictedState:        *predicted,
+		PredictedBalanceScore: predicted.BalanceScore,
+		PredictedImprovement:  lc.calculateImprovement(clusterState, predicted),
+		Conditions:            job.Details.Conditions,
+	}, nil
+}
+
 // analyzeClusterState analyzes the current resource utilization of the cluster
 func (lc *LoadbalancingController) analyzeClusterState(job *LoadbalancingJob) (*types.ClusterState, error) {
 	ctx := context.Background()
@@ -270,8 +531,9 @@ func (lc *LoadbalancingController) analyzeClusterState(job *LoadbalancingJob) (*
 	}
 
 	clusterState := &types.ClusterState{
-		Timestamp: time.Now(),
-		Nodes:     make([]types.NodeState, 0),
+		Timestamp:         time.Now(),
+		Nodes:             make([]types.NodeState, 0),
+		MetricsProvenance: types.ProvenanceReal,
 	}
 
 	// Filter nodes based on request
@@ -297,6 +559,7 @@ func (lc *LoadbalancingController) analyzeClusterState(job *LoadbalancingJob) (*
 
 		clusterState.Nodes = append(clusterState.Nodes, *nodeState)
 		clusterState.TotalPods += nodeState.PodCount
+		clusterState.MetricsProvenance = types.WorstProvenance(clusterState.MetricsProvenance, nodeState.MetricsProvenance)
 	}
 
 	// Calculate balance score
@@ -308,7 +571,7 @@ func (lc *LoadbalancingController) analyzeClusterState(job *LoadbalancingJob) (*
 // getNodeState gets the resource state of a single node
 func (lc *LoadbalancingController) getNodeState(ctx context.Context, nodeName string) (*types.NodeState, error) {
 	// Get node metrics
-	cpuPercent, memoryPercent, err := lc.k8sClient.GetNodeMetrics(ctx, nodeName)
+	cpuPercent, memoryPercent, provenance, err := lc.k8sClient.GetNodeMetrics(ctx, nodeName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get node metrics: %w", err)
 	}
@@ -331,12 +594,17 @@ func (lc *LoadbalancingController) getNodeState(ctx context.Context, nodeName st
 		layer = ""
 	}
 
+	var missing []string
+
 	// Get GPU utilization for node
 	gpuPercent := int32(0)
 	if gpuCapacity > 0 {
 		gpuUtil, err := lc.k8sClient.GetNodeGPUUtilization(ctx, nodeName)
 		if err == nil {
 			gpuPercent = gpuUtil
+		} else {
+			log.Printf("Warning: GPU metrics unavailable for node %s: %v", nodeName, err)
+			missing = append(missing, "gpu")
 		}
 	}
 
@@ -345,12 +613,18 @@ func (lc *LoadbalancingController) getNodeState(ctx context.Context, nodeName st
 	storageWriteMBps := int64(0)
 	storageIOPS := int64(0)
 	storageUtilization := int32(0)
-	readMBps, writeMBps, iops, util, err := lc.k8sClient.GetNodeStorageMetrics(ctx, nodeName)
+	readMBps, writeMBps, iops, util, storageProvenance, err := lc.k8sClient.GetNodeStorageMetrics(ctx, nodeName)
 	if err == nil {
 		storageReadMBps = readMBps
 		storageWriteMBps = writeMBps
 		storageIOPS = iops
 		storageUtilization = util
+		provenance = types.WorstProvenance(provenance, storageProvenance)
+	} else {
+		log.Printf("Warning: Storage metrics unavailable for node %s: %v", nodeName, err)
+		missing = append(missing, "storage")
+		// 스토리지 값은 0으로 남으므로 스토리지 기반 전략이 이 노드를 저부하로 오인하지 않도록 표시
+		storageProvenance = types.ProvenanceUnavailable
 	}
 
 	return &types.NodeState{
@@ -367,6 +641,10 @@ func (lc *LoadbalancingController) getNodeState(ctx context.Context, nodeName st
 		StorageWriteMBps:   storageWriteMBps,
 		StorageIOPS:        storageIOPS,
 		StorageUtilization: storageUtilization,
+		MissingMetrics:     missing,
+		MetricsProvenance:  provenance,
+
+		StorageMetricsProvenance: storageProvenance,
 	}, nil
 }
 
@@ -384,9 +662,11 @@ func (lc *LoadbalancingController) calculateBalanceScore(state *types.ClusterSta
 	podCV := lc.calculateCoefficientOfVariation(state.Nodes, "pod")
 
 	// Calculate Storage I/O coefficient of variation for AI/ML workloads
-	storageReadCV := lc.calculateCoefficientOfVariation(state.Nodes, "storage_read")
-	storageWriteCV := lc.calculateCoefficientOfVariation(state.Nodes, "storage_write")
-	storageIOPSCV := lc.calculateCoefficientOfVariation(state.Nodes, "storage_iops")
+	// Nodes without storage metrics report zeros and are left out rather than counted as idle
+	storageNodes := nodesWithStorageMetrics(state.Nodes)
+	storageReadCV := lc.calculateCoefficientOfVariation(storageNodes, "storage_read")
+	storageWriteCV := lc.calculateCoefficientOfVariation(storageNodes, "storage_write")
+	storageIOPSCV := lc.calculateCoefficientOfVariation(storageNodes, "storage_iops")
 	storageCV := (storageReadCV + storageWriteCV + storageIOPSCV) / 3.0
 
 	// Lower CV means more balanced, convert to 0-100 score
@@ -398,6 +678,17 @@ func (lc *LoadbalancingController) calculateBalanceScore(state *types.ClusterSta
 	return score
 }
 
+// nodesWithStorageMetrics returns the nodes whose storage I/O metrics could be read
+func nodesWithStorageMetrics(nodes []types.NodeState) []types.NodeState {
+	result := make([]types.NodeState, 0, len(nodes))
+	for _, node := range nodes {
+		if node.StorageMetricsProvenance != types.ProvenanceUnavailable {
+			result = append(result, node)
+		}
+	}
+	return result
+}
+
 // calculateCoefficientOfVariation calculates the coefficient of variation for a resource
 func (lc *LoadbalancingController) calculateCoefficientOfVariation(nodes []types.NodeState, resourceType string) float64 {
 	if len(nodes) == 0 {
@@ -453,8 +744,33 @@ func (lc *LoadbalancingController) calculateCoefficientOfVariation(nodes []types
 }
 
 // calculateMigrationPlan calculates which pods should be migrated to which nodes
+// No plan is made from stale or simulated node metrics: the MetricsUnavailable condition is set
+// on the job and errMetricsNotReal is returned so the cycle is skipped instead of failed.
+// Storage-based strategies also require every node's storage metrics to be real.
 func (lc *LoadbalancingController) calculateMigrationPlan(job *LoadbalancingJob, state *types.ClusterState) ([]types.MigrationPlan, error) {
 	strategy := types.LoadbalancingStrategy(job.Request.Strategy)
+	usesStorageMetrics := strategy == types.LBStrategyStorageIOBalanced || strategy == types.LBStrategyStorageAwareWeighted
+
+	provenance := state.MetricsProvenance
+	var notReal []string
+	for _, node := range state.Nodes {
+		if !node.MetricsProvenance.IsReal() {
+			notReal = append(notReal, fmt.Sprintf("%s (%s)", node.NodeName, node.MetricsProvenance))
+		} else if usesStorageMetrics && node.StorageMetricsProvenance == types.ProvenanceUnavailable {
+			notReal = append(notReal, fmt.Sprintf("%s (storage %s)", node.NodeName, node.StorageMetricsProvenance))
+			provenance = types.WorstProvenance(provenance, node.StorageMetricsProvenance)
+		}
+	}
+	message := "node metrics are real"
+	if len(notReal) > 0 {
+		message = fmt.Sprintf("node metrics are not real: %s", strings.Join(notReal, ", "))
+	}
+	lc.jobsMux.Lock()
+	job.Details.Conditions = types.SetCondition(job.Details.Conditions, types.MetricsCondition(provenance, message))
+	lc.jobsMux.Unlock()
+	if !provenance.IsReal() {
+		return nil, fmt.Errorf("%w: %s", errMetricsNotReal, message)
+	}
 
 	switch strategy {
 	case types.StrategyLeastLoaded:
@@ -507,6 +823,8 @@ func (lc *LoadbalancingController) calculateLoadSpreadingPlan(job *Loadbalancing
 	}
 
 	// For each overloaded node, find pods to migrate
+	model := lc.newClusterModel(state)
+	candidates := nodeNames(underloadedNodes)
 	migrationsCount := 0
 	for _, sourceNode := range overloadedNodes {
 		if migrationsCount >= int(job.Request.MaxMigrationsPerCycle) {
@@ -534,16 +852,21 @@ func (lc *LoadbalancingController) calculateLoadSpreadingPlan(job *Loadbalancing
 				break
 			}
 
-			// Find best target node (least loaded)
-			targetNode := underloadedNodes[0].NodeName
+			// Find the target node with the best predicted balance
+			targetNode, gain, ok := lc.pickTargetNode(ctx, model, pod, sourceNode.NodeName, candidates)
+			if !ok {
+				continue
+			}
+			model.move(pod.Namespace, pod.Name, sourceNode.NodeName, targetNode)
 
 			plan = append(plan, types.MigrationPlan{
-				PodName:      pod.Name,
-				PodNamespace: pod.Namespace,
-				SourceNode:   sourceNode.NodeName,
-				TargetNode:   targetNode,
-				Reason:       fmt.Sprintf("Source node overloaded (%.1f%%), target node underloaded", float64(sourceNode.CPUPercent+sourceNode.MemoryPercent)/2.0),
-				Priority:     int32(100 - migrationsCount),
+				PodName:              pod.Name,
+				PodNamespace:         pod.Namespace,
+				SourceNode:           sourceNode.NodeName,
+				TargetNode:           targetNode,
+				Reason:               fmt.Sprintf("Source node overloaded (%.1f%%), target node underloaded", float64(sourceNode.CPUPercent+sourceNode.MemoryPercent)/2.0),
+				Priority:             int32(100 - migrationsCount),
+				EstimatedImprovement: gain,
 			})
 
 			migrationsCount++
@@ -630,6 +953,8 @@ func (lc *LoadbalancingController) calculateStorageIOBalancedPlan(job *Loadbalan
 	}
 
 	// Migrate pods from high I/O nodes to low I/O nodes
+	model := lc.newClusterModel(state)
+	candidates := nodeNames(lowIONodes)
 	migrationsCount := 0
 	for _, sourceNode := range highIONodes {
 		if migrationsCount >= int(job.Request.MaxMigrationsPerCycle) {
@@ -652,8 +977,12 @@ func (lc *LoadbalancingController) calculateStorageIOBalancedPlan(job *Loadbalan
 				continue
 			}
 
-			// Find target node with lowest I/O
-			targetNode := lowIONodes[0].NodeName
+			// Find the low I/O node with the best predicted balance
+			targetNode, gain, ok := lc.pickTargetNode(ctx, model, pod, sourceNode.NodeName, candidates)
+			if !ok {
+				continue
+			}
+			model.move(pod.Namespace, pod.Name, sourceNode.NodeName, targetNode)
 
 			plan = append(plan, types.MigrationPlan{
 				PodName:      pod.Name,
@@ -662,7 +991,8 @@ func (lc *LoadbalancingController) calculateStorageIOBalancedPlan(job *Loadbalan
 				TargetNode:   targetNode,
 				Reason: fmt.Sprintf("High Storage I/O on source (Read: %dMB/s, Write: %dMB/s, IOPS: %d)",
 					sourceNode.StorageReadMBps, sourceNode.StorageWriteMBps, sourceNode.StorageIOPS),
-				Priority: int32(100 - migrationsCount),
+				Priority:             int32(100 - migrationsCount),
+				EstimatedImprovement: gain,
 			})
 
 			migrationsCount++
@@ -726,6 +1056,11 @@ func (lc *LoadbalancingController) calculateStorageAwareWeightedPlan(job *Loadba
 	}
 
 	// Migrate pods from overloaded to underloaded nodes
+	model := lc.newClusterModel(state)
+	candidates := make([]string, 0, len(underloadedNodes))
+	for _, ns := range underloadedNodes {
+		candidates = append(candidates, ns.node.NodeName)
+	}
 	migrationsCount := 0
 	for _, source := range overloadedNodes {
 		if migrationsCount >= int(job.Request.MaxMigrationsPerCycle) {
@@ -748,19 +1083,23 @@ func (lc *LoadbalancingController) calculateStorageAwareWeightedPlan(job *Loadba
 				continue
 			}
 
-			// Target: lowest scored node
-			target := underloadedNodes[0]
+			// Target: underloaded node with the best predicted balance
+			targetNode, gain, ok := lc.pickTargetNode(ctx, model, pod, source.node.NodeName, candidates)
+			if !ok {
+				continue
+			}
+			model.move(pod.Namespace, pod.Name, source.node.NodeName, targetNode)
 
 			plan = append(plan, types.MigrationPlan{
 				PodName:      pod.Name,
 				PodNamespace: pod.Namespace,
 				SourceNode:   source.node.NodeName,
-				TargetNode:   target.node.NodeName,
+				TargetNode:   targetNode,
 				Reason: fmt.Sprintf("Weighted score %.2f > 0.8 (CPU: %d%%, Mem: %d%%, GPU: %d%%, Storage I/O: %dMB/s)",
 					source.score, source.node.CPUPercent, source.node.MemoryPercent,
 					source.node.GPUPercent, source.node.StorageReadMBps+source.node.StorageWriteMBps),
 				Priority:             int32(100 - migrationsCount),
-				EstimatedImprovement: source.score - target.score,
+				EstimatedImprovement: gain,
 			})
 
 			migrationsCount++
@@ -770,83 +1109,151 @@ func (lc *LoadbalancingController) calculateStorageAwareWeightedPlan(job *Loadba
 	return plan, nil
 }
 
-// executeMigrations executes the migration plan
+// executeMigrations executes the migration plan with at most MaxConcurrentMigrations migrations
+// in flight, waiting for each one to finish so the results reflect the real outcome
 func (lc *LoadbalancingController) executeMigrations(job *LoadbalancingJob, plan []types.MigrationPlan) error {
-	results := make([]types.MigrationResult, 0)
-
 	// Sort plan by priority
 	sort.Slice(plan, func(i, j int) bool {
 		return plan[i].Priority > plan[j].Priority
 	})
 
-	// Execute migrations
-	for _, migration := range plan {
-		startTime := time.Now()
+	results := make([]types.MigrationResult, len(plan))
+	slots := make(chan struct{}, job.Request.MaxConcurrentMigrations)
+	var wg sync.WaitGroup
 
-		// Create migration request
-		migReq := &types.MigrationRequest{
-			PodName:      migration.PodName,
-			PodNamespace: migration.PodNamespace,
-			SourceNode:   migration.SourceNode,
-			TargetNode:   migration.TargetNode,
-			PreservePV:   job.Request.PreservePV,
-			Timeout:      600, // 10 minutes
+	// Execute migrations in priority order; launched migrations always form a prefix of the plan
+	launched := 0
+	for i, migration := range plan {
+		select {
+		case slots <- struct{}{}:
+		case <-job.ctx.Done():
+		}
+		if job.ctx.Err() != nil {
+			log.Printf("Loadbalancing job %s cancelled, skipping %d remaining migrations", job.ID, len(plan)-i)
+			break
 		}
 
-		// Execute migration via migration controller
-		migrationResp, err := lc.migrationController.StartMigration(migReq)
+		launched++
+		wg.Add(1)
+		go func(i int, migration types.MigrationPlan) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			results[i] = lc.runMigration(job, migration)
+		}(i, migration)
+	}
+	wg.Wait()
 
-		endTime := time.Now()
-		duration := endTime.Sub(startTime).Seconds()
+	lc.jobsMux.Lock()
+	job.Details.ExecutedMigrations = results[:launched]
+	lc.jobsMux.Unlock()
 
-		migrationID := ""
-		if migrationResp != nil {
-			migrationID = migrationResp.MigrationID
-		}
+	return nil
+}
 
-		result := types.MigrationResult{
-			MigrationID:  migrationID,
-			PodName:      migration.PodName,
-			PodNamespace: migration.PodNamespace,
-			SourceNode:   migration.SourceNode,
-			TargetNode:   migration.TargetNode,
-			StartTime:    startTime,
-			EndTime:      endTime,
-			Duration:     duration,
-		}
+// runMigration starts one planned migration and tracks it to a terminal state
+func (lc *LoadbalancingController) runMigration(job *LoadbalancingJob, migration types.MigrationPlan) types.MigrationResult {
+	startTime := time.Now()
+
+	// Create migration request
+	migReq := &types.MigrationRequest{
+		PodName:      migration.PodName,
+		PodNamespace: migration.PodNamespace,
+		SourceNode:   migration.SourceNode,
+		TargetNode:   migration.TargetNode,
+		PreservePV:   job.Request.PreservePV,
+		Timeout:      loadbalancingMigrationTimeout,
+	}
+
+	result := types.MigrationResult{
+		PodName:      migration.PodName,
+		PodNamespace: migration.PodNamespace,
+		SourceNode:   migration.SourceNode,
+		TargetNode:   migration.TargetNode,
+		StartTime:    startTime,
+	}
 
+	// Execute migration via migration controller and wait for it, leaving room for its rollback
+	migrationResp, err := lc.migrationController.StartMigration(migReq)
+	if err != nil {
+		result.Status = "failed"
+		result.ErrorMessage = err.Error()
+	} else {
+		result.MigrationID = migrationResp.MigrationID
+
+		waitTimeout := time.Duration(migReq.Timeout)*time.Second + rollbackTimeout + time.Minute
+		waitCtx, cancel := context.WithTimeout(job.ctx, waitTimeout)
+		final, err := lc.migrationController.WaitForMigration(waitCtx, migrationResp.MigrationID)
+		cancel()
 		if err != nil {
+			// The job was cancelled or the migration overran: stop it instead of leaving it running
+			lc.cancelChildMigration(job, migrationResp.MigrationID)
+		}
+
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			result.Status = "timeout"
+			result.ErrorMessage = err.Error()
+		case err != nil && job.ctx.Err() != nil:
+			result.Status = "cancelled"
+			result.ErrorMessage = "loadbalancing job cancelled before the migration finished"
+		case err != nil:
 			result.Status = "failed"
 			result.ErrorMessage = err.Error()
-			log.Printf("Migration failed: %s/%s from %s to %s: %v",
-				migration.PodNamespace, migration.PodName,
-				migration.SourceNode, migration.TargetNode, err)
-
-			lc.jobsMux.Lock()
-			job.Details.FailedMigrations++
-			lc.metrics.FailedMigrations++
-			lc.jobsMux.Unlock()
-		} else {
+		case final.Status == types.MigrationStatusCompleted:
 			result.Status = "success"
-			log.Printf("Migration succeeded: %s/%s from %s to %s",
-				migration.PodNamespace, migration.PodName,
-				migration.SourceNode, migration.TargetNode)
-
-			lc.jobsMux.Lock()
-			job.Details.SuccessfulMigrations++
-			lc.metrics.SuccessfulMigrations++
-			lc.metrics.TotalMigrationsExecuted++
-			lc.jobsMux.Unlock()
+		case final.Status == types.MigrationStatusCancelled:
+			result.Status = "cancelled"
+			result.ErrorMessage = "migration cancelled"
+		default:
+			result.Status = "failed"
+			if final.Details != nil {
+				result.ErrorMessage = final.Details.ErrorMessage
+			}
 		}
-
-		results = append(results, result)
 	}
 
+	result.EndTime = time.Now()
+	result.Duration = result.EndTime.Sub(startTime).Seconds()
+
 	lc.jobsMux.Lock()
-	job.Details.ExecutedMigrations = results
+	if result.Status == "success" {
+		job.Details.SuccessfulMigrations++
+		lc.metrics.SuccessfulMigrations++
+		lc.metrics.TotalMigrationsExecuted++
+	} else {
+		job.Details.FailedMigrations++
+		lc.metrics.FailedMigrations++
+	}
 	lc.jobsMux.Unlock()
 
-	return nil
+	if result.Status == "success" {
+		log.Printf("Migration succeeded: %s/%s from %s to %s (%.1fs)",
+			migration.PodNamespace, migration.PodName,
+			migration.SourceNode, migration.TargetNode, result.Duration)
+	} else {
+		log.Printf("Migration %s: %s/%s from %s to %s: %s",
+			result.Status, migration.PodNamespace, migration.PodName,
+			migration.SourceNode, migration.TargetNode, result.ErrorMessage)
+	}
+
+	return result
+}
+
+// cancelChildMigration cancels a migration the job no longer waits for and waits for its rollback,
+// so the job does not end while the migration still moves its pod
+func (lc *LoadbalancingController) cancelChildMigration(job *LoadbalancingJob, migrationID string) {
+	if err := lc.migrationController.CancelMigration(migrationID); err != nil {
+		// Already finished between the wait and the cancellation
+		log.Printf("Loadbalancing job %s: Migration %s not cancelled: %v", job.ID, migrationID, err)
+		return
+	}
+	log.Printf("Loadbalancing job %s: Cancelled migration %s", job.ID, migrationID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), rollbackTimeout+time.Minute)
+	defer cancel()
+	if _, err := lc.migrationController.WaitForMigration(ctx, migrationID); err != nil {
+		log.Printf("Loadbalancing job %s: Migration %s did not finish its rollback: %v", job.ID, migrationID, err)
+	}
 }
 
 // calculateImprovement calculates the improvement in resource utilization
@@ -909,16 +1316,20 @@ func (lc *LoadbalancingController) ListLoadbalancingJobs() []*types.Loadbalancin
 func (lc *LoadbalancingController) CancelLoadbalancing(jobID string) error {
 	lc.jobsMux.RLock()
 	job, exists := lc.jobs[jobID]
+	var status types.LoadbalancingStatus
+	if exists {
+		status = job.Status
+	}
 	lc.jobsMux.RUnlock()
 
 	if !exists {
 		return fmt.Errorf("loadbalancing job not found: %s", jobID)
 	}
 
-	if job.Status == types.LoadbalancingStatusCompleted ||
-		job.Status == types.LoadbalancingStatusFailed ||
-		job.Status == types.LoadbalancingStatusCancelled {
-		return fmt.Errorf("cannot cancel loadbalancing job in status: %s", job.Status)
+	if status == types.LoadbalancingStatusCompleted ||
+		status == types.LoadbalancingStatusFailed ||
+		status == types.LoadbalancingStatusCancelled {
+		return fmt.Errorf("cannot cancel loadbalancing job in status: %s", status)
 	}
 
 	job.cancel()
@@ -926,6 +1337,18 @@ func (lc *LoadbalancingController) CancelLoadbalancing(jobID string) error {
 	return nil
 }
 
+// GetLoadbalancingNamespace returns the namespace a loadbalancing job is limited to (empty: all namespaces)
+func (lc *LoadbalancingController) GetLoadbalancingNamespace(id string) (string, error) {
+	lc.jobsMux.RLock()
+	defer lc.jobsMux.RUnlock()
+
+	job, exists := lc.jobs[id]
+	if !exists || job.Request == nil {
+		return "", fmt.Errorf("loadbalancing job %s not found", id)
+	}
+	return job.Request.Namespace, nil
+}
+
 // GetMetrics returns loadbalancing metrics
 func (lc *LoadbalancingController) GetMetrics() *types.LoadbalancingMetrics {
 	lc.jobsMux.RLock()
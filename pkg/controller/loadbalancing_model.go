@@ -0,0 +1,272 @@
+package controller
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// clusterModel is an in-memory copy of the cluster's node utilization that candidate
+// migrations can be applied to, so plans are scored without touching any pods
+type clusterModel struct {
+	observed  []types.NodeState
+	loads     []nodeLoad
+	nodeIndex map[string]int
+	pods      map[string]*types.PodResourceInfo // namespace/name -> requests and storage I/O
+	score     func(*types.ClusterState) float64
+}
+
+// nodeLoad is the mutable utilization of a node in the model; percentages are kept as floats
+// so consecutive moves do not accumulate rounding errors
+type nodeLoad struct {
+	cpuPercent    float64
+	memoryPercent float64
+	gpuPercent    float64
+	readMBps      int64
+	writeMBps     int64
+	iops          int64
+	pods          int32
+}
+
+// newClusterModel builds a model from an observed cluster state
+func (lc *LoadbalancingController) newClusterModel(state *types.ClusterState) *clusterModel {
+	m := &clusterModel{
+		observed:  state.Nodes,
+		loads:     make([]nodeLoad, len(state.Nodes)),
+		nodeIndex: make(map[string]int, len(state.Nodes)),
+		pods:      make(map[string]*types.PodResourceInfo),
+		score:     lc.calculateBalanceScore,
+	}
+	for i, node := range state.Nodes {
+		m.nodeIndex[node.NodeName] = i
+		m.loads[i] = nodeLoad{
+			cpuPercent:    float64(node.CPUPercent),
+			memoryPercent: float64(node.MemoryPercent),
+			gpuPercent:    float64(node.GPUPercent),
+			readMBps:      node.StorageReadMBps,
+			writeMBps:     node.StorageWriteMBps,
+			iops:          node.StorageIOPS,
+			pods:          node.PodCount,
+		}
+	}
+	return m
+}
+
+// loadPod fetches the resource requests and storage I/O of a pod into the model.
+// Pods whose info cannot be read fall back to an equal share of their node's load.
+func (lc *LoadbalancingController) loadPod(ctx context.Context, m *clusterModel, namespace, name string) {
+	key := namespace + "/" + name
+	if _, ok := m.pods[key]; ok {
+		return
+	}
+
+	info, err := lc.k8sClient.GetPodResourceInfo(ctx, namespace, name)
+	if err != nil {
+		log.Printf("Warning: Failed to get resource info for pod %s, assuming an equal share of node load: %v", key, err)
+	}
+	m.pods[key] = info
+}
+
+// clone returns an independent copy of the model for evaluating a candidate move
+func (m *clusterModel) clone() *clusterModel {
+	c := *m
+	c.loads = make([]nodeLoad, len(m.loads))
+	copy(c.loads, m.loads)
+	return &c
+}
+
+// move applies the migration of a pod from source to target and reports whether both nodes are modeled
+func (m *clusterModel) move(namespace, name, source, target string) bool {
+	si, srcOK := m.nodeIndex[source]
+	ti, dstOK := m.nodeIndex[target]
+	if !srcOK || !dstOK || si == ti {
+		return false
+	}
+
+	src, dst := &m.loads[si], &m.loads[ti]
+	srcNode, dstNode := m.observed[si], m.observed[ti]
+	info := m.pods[namespace+"/"+name]
+
+	// Equal share of the observed node load, used for anything the pod does not report
+	share := nodeLoad{}
+	if srcNode.PodCount > 0 {
+		pods := float64(srcNode.PodCount)
+		share = nodeLoad{
+			cpuPercent:    float64(srcNode.CPUPercent) / pods,
+			memoryPercent: float64(srcNode.MemoryPercent) / pods,
+			gpuPercent:    float64(srcNode.GPUPercent) / pods,
+			readMBps:      srcNode.StorageReadMBps / int64(srcNode.PodCount),
+			writeMBps:     srcNode.StorageWriteMBps / int64(srcNode.PodCount),
+			iops:          srcNode.StorageIOPS / int64(srcNode.PodCount),
+		}
+	}
+
+	// CPU/Memory: 요청량을 노드 용량 대비 비율로 환산, 없으면 균등 분배 값을 용량 비율로 보정
+	srcCPU, dstCPU := share.cpuPercent, share.cpuPercent*capacityRatio(srcNode.CPUCapacity, dstNode.CPUCapacity)
+	if info != nil && info.CPURequest > 0 {
+		if s, d := requestPercent(info.CPURequest, srcNode.CPUCapacity, true), requestPercent(info.CPURequest, dstNode.CPUCapacity, true); s > 0 && d > 0 {
+			srcCPU, dstCPU = s, d
+		}
+	}
+	srcMem, dstMem := share.memoryPercent, share.memoryPercent*capacityRatio(srcNode.MemoryCapacity, dstNode.MemoryCapacity)
+	if info != nil && info.MemoryRequest > 0 {
+		if s, d := requestPercent(info.MemoryRequest, srcNode.MemoryCapacity, false), requestPercent(info.MemoryRequest, dstNode.MemoryCapacity, false); s > 0 && d > 0 {
+			srcMem, dstMem = s, d
+		}
+	}
+
+	// GPU: 대상 노드에 GPU가 없으면 사용률 변화 없음
+	srcGPU, dstGPU := share.gpuPercent, 0.0
+	if srcNode.GPUCapacity > 0 && dstNode.GPUCapacity > 0 {
+		dstGPU = share.gpuPercent * float64(srcNode.GPUCapacity) / float64(dstNode.GPUCapacity)
+	}
+	if info != nil && info.GPURequest > 0 {
+		srcGPU, dstGPU = 0, 0
+		if srcNode.GPUCapacity > 0 {
+			srcGPU = 100.0 * float64(info.GPURequest) / float64(srcNode.GPUCapacity)
+		}
+		if dstNode.GPUCapacity > 0 {
+			dstGPU = 100.0 * float64(info.GPURequest) / float64(dstNode.GPUCapacity)
+		}
+	}
+
+	// Storage I/O is absolute throughput and moves with the pod as is
+	read, write, iops := share.readMBps, share.writeMBps, share.iops
+	if info != nil && (info.StorageReadMBps > 0 || info.StorageWriteMBps > 0 || info.StorageIOPS > 0) {
+		read, write, iops = info.StorageReadMBps, info.StorageWriteMBps, info.StorageIOPS
+	}
+
+	src.cpuPercent = math.Max(0, src.cpuPercent-srcCPU)
+	dst.cpuPercent += dstCPU
+	src.memoryPercent = math.Max(0, src.memoryPercent-srcMem)
+	dst.memoryPercent += dstMem
+	src.gpuPercent = math.Max(0, src.gpuPercent-srcGPU)
+	dst.gpuPercent += dstGPU
+	src.readMBps = max(0, src.readMBps-read)
+	dst.readMBps += read
+	src.writeMBps = max(0, src.writeMBps-write)
+	dst.writeMBps += write
+	src.iops = max(0, src.iops-iops)
+	dst.iops += iops
+	src.pods = max(0, src.pods-1)
+	dst.pods++
+
+	return true
+}
+
+// apply applies every migration of a plan to the model
+func (m *clusterModel) apply(plan []types.MigrationPlan) {
+	for _, migration := range plan {
+		m.move(migration.PodNamespace, migration.PodName, migration.SourceNode, migration.TargetNode)
+	}
+}
+
+// state materializes the model as a ClusterState with a recomputed balance score
+func (m *clusterModel) state() *types.ClusterState {
+	state := &types.ClusterState{
+		Timestamp: time.Now(),
+		Nodes:     make([]types.NodeState, len(m.observed)),
+	}
+	for i, node := range m.observed {
+		load := m.loads[i]
+		node.CPUPercent = clampPercent(load.cpuPercent)
+		node.MemoryPercent = clampPercent(load.memoryPercent)
+		node.GPUPercent = clampPercent(load.gpuPercent)
+		node.StorageReadMBps = load.readMBps
+		node.StorageWriteMBps = load.writeMBps
+		node.StorageIOPS = load.iops
+		node.PodCount = load.pods
+		state.Nodes[i] = node
+		state.TotalPods += load.pods
+	}
+	state.BalanceScore = m.score(state)
+	return state
+}
+
+// balanceScore returns the predicted balance score of the model in its current form
+func (m *clusterModel) balanceScore() float64 {
+	return m.state().BalanceScore
+}
+
+// pickTargetNode tries moving a pod to each candidate node and returns the one with the best
+// predicted balance score, together with the score gain. ok is false when no candidate improves
+// the balance, in which case the pod should stay where it is.
+func (lc *LoadbalancingController) pickTargetNode(ctx context.Context, m *clusterModel, pod types.PodRef, source string, candidates []string) (target string, gain float64, ok bool) {
+	lc.loadPod(ctx, m, pod.Namespace, pod.Name)
+
+	current := m.balanceScore()
+	best := current
+	for _, candidate := range candidates {
+		trial := m.clone()
+		if !trial.move(pod.Namespace, pod.Name, source, candidate) {
+			continue
+		}
+		if score := trial.balanceScore(); score > best {
+			best, target = score, candidate
+		}
+	}
+
+	if target == "" {
+		return "", 0, false
+	}
+	return target, best - current, true
+}
+
+// predictClusterState estimates the cluster state after a migration plan is applied
+func (lc *LoadbalancingController) predictClusterState(ctx context.Context, state *types.ClusterState, plan []types.MigrationPlan) *types.ClusterState {
+	m := lc.newClusterModel(state)
+	for _, migration := range plan {
+		lc.loadPod(ctx, m, migration.PodNamespace, migration.PodName)
+	}
+	m.apply(plan)
+	return m.state()
+}
+
+// nodeNames returns the names of the given nodes in order
+func nodeNames(nodes []types.NodeState) []string {
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		names = append(names, node.NodeName)
+	}
+	return names
+}
+
+// requestPercent converts a pod request into a percentage of a node capacity quantity.
+// CPU requests are in millicores, memory requests in bytes. Returns 0 if the capacity is unknown.
+func requestPercent(request int64, capacity string, milli bool) float64 {
+	q, err := resource.ParseQuantity(capacity)
+	if err != nil {
+		return 0
+	}
+	total := q.Value()
+	if milli {
+		total = q.MilliValue()
+	}
+	if total <= 0 {
+		return 0
+	}
+	return 100.0 * float64(request) / float64(total)
+}
+
+// capacityRatio returns source/target for two resource quantities, or 1 when either cannot be parsed
+func capacityRatio(source, target string) float64 {
+	src, err := resource.ParseQuantity(source)
+	if err != nil {
+		return 1.0
+	}
+	dst, err := resource.ParseQuantity(target)
+	if err != nil || dst.IsZero() {
+		return 1.0
+	}
+	return src.AsApproximateFloat64() / dst.AsApproximateFloat64()
+}
+
+// clampPercent rounds a utilization value into the 0-100 range
+func clampPercent(v float64) int32 {
+	return int32(math.Max(0, math.Min(100, math.Round(v))))
+}
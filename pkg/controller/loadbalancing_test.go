@@ -0,0 +1,375 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newImbalancedClusterMock returns a two-node cluster where node-a is overloaded and node-b is mostly idle
+func newImbalancedClusterMock() *MockK8sClient {
+	return newImbalancedClusterMockWithProvenance(types.ProvenanceReal)
+}
+
+// newImbalancedClusterMockWithProvenance is newImbalancedClusterMock with the given provenance for node-b's metrics
+func newImbalancedClusterMockWithProvenance(nodeB types.MetricProvenance) *MockK8sClient {
+	mockClient := new(MockK8sClient)
+	mockClient.On("ListNodes", mock.Anything).Return([]string{"node-a", "node-b"}, nil)
+	mockClient.On("GetNodeMetrics", mock.Anything, "node-a").Return(int32(90), int32(90), types.ProvenanceReal, nil)
+	mockClient.On("GetNodeMetrics", mock.Anything, "node-b").Return(int32(20), int32(20), nodeB, nil)
+	mockClient.On("GetNodeCapacity", mock.Anything, mock.Anything).Return("8", "32Gi", int32(0), nil)
+	mockClient.On("GetNodePodCount", mock.Anything, "node-a").Return(int32(4), nil)
+	mockClient.On("GetNodePodCount", mock.Anything, "node-b").Return(int32(2), nil)
+	mockClient.On("GetNodeLabel", mock.Anything, mock.Anything, "layer").Return("", nil)
+	mockClient.On("GetNodeStorageMetrics", mock.Anything, mock.Anything).Return(int64(0), int64(0), int64(0), int32(0), types.ProvenanceReal, nil)
+	mockClient.On("ListPodsOnNode", mock.Anything, "node-a").Return([]types.PodRef{
+		{Name: "trainer-0", Namespace: "ai-workloads"},
+		{Name: "trainer-1", Namespace: "ai-workloads"},
+	}, nil)
+	mockClient.On("GetPodResourceInfo", mock.Anything, "ai-workloads", mock.Anything).Return(&types.PodResourceInfo{
+		CPURequest:    2000,    // 8코어 중 25%
+		MemoryRequest: 8 << 30, // 32Gi 중 25%
+	}, nil)
+	return mockClient
+}
+
+func TestPlanLoadbalancing(t *testing.T) {
+	mockClient := newImbalancedClusterMock()
+	lc := NewLoadbalancingController(mockClient, nil)
+
+	plan, err := lc.PlanLoadbalancing(&types.LoadbalancingRequest{
+		Strategy:              string(types.StrategyLoadSpreading),
+		MaxMigrationsPerCycle: 1,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, plan.PlannedMigrations, 1)
+	assert.Equal(t, "trainer-0", plan.PlannedMigrations[0].PodName)
+	assert.Equal(t, "node-b", plan.PlannedMigrations[0].TargetNode)
+	assert.Len(t, plan.ClusterState.Nodes, 2)
+
+	// Pod 요청량(노드 용량의 25%)이 node-b로 이동한 것으로 예측
+	predicted := map[string]types.NodeState{}
+	for _, node := range plan.PredictedState.Nodes {
+		predicted[node.NodeName] = node
+	}
+	assert.Equal(t, int32(3), predicted["node-a"].PodCount)
+	assert.Equal(t, int32(3), predicted["node-b"].PodCount)
+	assert.Equal(t, int32(65), predicted["node-a"].CPUPercent)
+	assert.Equal(t, int32(45), predicted["node-b"].CPUPercent)
+	assert.Equal(t, int32(65), predicted["node-a"].MemoryPercent)
+	assert.Greater(t, plan.PredictedBalanceScore, plan.ClusterState.BalanceScore)
+	require.NotNil(t, plan.PredictedImprovement)
+	assert.Less(t, plan.PredictedImprovement.CPUVarianceAfter, plan.PredictedImprovement.CPUVarianceBefore)
+
+	// Plan 조회는 작업을 만들지 않음
+	assert.Empty(t, lc.ListLoadbalancingJobs())
+}
+
+func TestPlanLoadbalancingSkipsStaleMetrics(t *testing.T) {
+	mockClient := newImbalancedClusterMockWithProvenance(types.ProvenanceStale)
+	lc := NewLoadbalancingController(mockClient, nil)
+
+	plan, err := lc.PlanLoadbalancing(&types.LoadbalancingRequest{
+		Strategy: string(types.StrategyLoadSpreading),
+	})
+	require.NoError(t, err)
+
+	// stale 메트릭으로는 마이그레이션을 계획하지 않음
+	assert.Empty(t, plan.PlannedMigrations)
+	assert.Equal(t, types.ProvenanceStale, plan.ClusterState.MetricsProvenance)
+	require.Len(t, plan.Conditions, 1)
+	assert.Equal(t, types.ConditionMetricsUnavailable, plan.Conditions[0].Type)
+	assert.Equal(t, "True", plan.Conditions[0].Status)
+	assert.Equal(t, "StaleMetrics", plan.Conditions[0].Reason)
+	assert.Contains(t, plan.Conditions[0].Message, "node-b (stale)")
+	mockClient.AssertNotCalled(t, "ListPodsOnNode", mock.Anything, mock.Anything)
+}
+
+func TestPlanLoadbalancingSkipsMissingStorageMetrics(t *testing.T) {
+	mockClient := newImbalancedClusterMock()
+	// node-a는 스토리지 I/O가 높고 node-b는 스토리지 메트릭 조회 실패 (값이 0으로 남음)
+	mockClient.ExpectedCalls = removeExpectedCalls(mockClient.ExpectedCalls, "GetNodeStorageMetrics")
+	mockClient.On("GetNodeStorageMetrics", mock.Anything, "node-a").Return(int64(900), int64(300), int64(8000), int32(90), types.ProvenanceReal, nil)
+	mockClient.On("GetNodeStorageMetrics", mock.Anything, "node-b").Return(int64(0), int64(0), int64(0), int32(0), types.MetricProvenance(""), assert.AnError)
+	lc := NewLoadbalancingController(mockClient, nil)
+
+	for _, strategy := range []types.LoadbalancingStrategy{types.LBStrategyStorageIOBalanced, types.LBStrategyStorageAwareWeighted} {
+		plan, err := lc.PlanLoadbalancing(&types.LoadbalancingRequest{Strategy: string(strategy)})
+		require.NoError(t, err, strategy)
+
+		// 스토리지 메트릭이 없는 node-b로는 아무것도 옮기지 않음
+		assert.Empty(t, plan.PlannedMigrations, strategy)
+		require.Len(t, plan.Conditions, 1, strategy)
+		assert.Equal(t, "True", plan.Conditions[0].Status, strategy)
+		assert.Equal(t, "UnavailableMetrics", plan.Conditions[0].Reason, strategy)
+		assert.Contains(t, plan.Conditions[0].Message, "node-b (storage unavailable)", strategy)
+	}
+
+	// 스토리지를 쓰지 않는 전략은 그대로 계획
+	plan, err := lc.PlanLoadbalancing(&types.LoadbalancingRequest{
+		Strategy:              string(types.StrategyLoadSpreading),
+		MaxMigrationsPerCycle: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, plan.PlannedMigrations, 1)
+	assert.Equal(t, "node-b", plan.PlannedMigrations[0].TargetNode)
+	mockClient.AssertNotCalled(t, "ListPodsOnNode", mock.Anything, "node-b")
+}
+
+// removeExpectedCalls drops the expectations for method so a test can register its own
+func removeExpectedCalls(calls []*mock.Call, method string) []*mock.Call {
+	kept := make([]*mock.Call, 0, len(calls))
+	for _, call := range calls {
+		if call.Method != method {
+			kept = append(kept, call)
+		}
+	}
+	return kept
+}
+
+func TestDryRunLoadbalancingJob(t *testing.T) {
+	mockClient := newImbalancedClusterMock()
+	// migration controller가 nil이므로 실제 마이그레이션을 시도하면 panic
+	lc := NewLoadbalancingController(mockClient, nil)
+
+	jobID, err := lc.StartLoadbalancing(&types.LoadbalancingRequest{
+		Strategy: string(types.StrategyLoadSpreading),
+		DryRun:   true,
+	})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		resp, err := lc.GetLoadbalancingJob(jobID)
+		return err == nil && resp.Status == types.LoadbalancingStatusCompleted
+	}, 2*time.Second, 10*time.Millisecond)
+
+	resp, err := lc.GetLoadbalancingJob(jobID)
+	require.NoError(t, err)
+	// 두 번째 Pod까지 옮기면 node-b가 과부하가 되므로 한 개만 계획
+	assert.Equal(t, int32(1), resp.Details.PodsToMigrate)
+	assert.Empty(t, resp.Details.ExecutedMigrations)
+	require.NotNil(t, resp.Details.PredictedState)
+	assert.Greater(t, resp.Details.PredictedState.BalanceScore, resp.Details.InitialState.BalanceScore)
+	mockClient.AssertNotCalled(t, "EvictPod", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestClusterModelPicksBestTarget(t *testing.T) {
+	mockClient := new(MockK8sClient)
+	mockClient.On("GetPodResourceInfo", mock.Anything, "default", "worker").
+		Return((*types.PodResourceInfo)(nil), assert.AnError)
+	lc := NewLoadbalancingController(mockClient, nil)
+
+	state := &types.ClusterState{Nodes: []types.NodeState{
+		{NodeName: "busy", CPUPercent: 90, MemoryPercent: 90, PodCount: 4, CPUCapacity: "8", MemoryCapacity: "32Gi"},
+		{NodeName: "half", CPUPercent: 50, MemoryPercent: 50, PodCount: 4, CPUCapacity: "8", MemoryCapacity: "32Gi"},
+		{NodeName: "idle", CPUPercent: 10, MemoryPercent: 10, PodCount: 1, CPUCapacity: "16", MemoryCapacity: "64Gi"},
+	}}
+	state.BalanceScore = lc.calculateBalanceScore(state)
+	model := lc.newClusterModel(state)
+
+	target, gain, ok := lc.pickTargetNode(context.Background(), model, types.PodRef{Name: "worker", Namespace: "default"}, "busy", []string{"half", "idle"})
+	require.True(t, ok)
+	assert.Equal(t, "idle", target)
+	assert.Greater(t, gain, 0.0)
+
+	// Pod 정보가 없으면 노드 부하의 균등 분배로 추정, 용량이 두 배인 노드에서는 절반 비율
+	model.move("default", "worker", "busy", target)
+	predicted := model.state()
+	assert.Equal(t, int32(68), predicted.Nodes[0].CPUPercent)
+	assert.Equal(t, int32(21), predicted.Nodes[2].CPUPercent)
+	assert.Equal(t, int32(2), predicted.Nodes[2].PodCount)
+	assert.InDelta(t, state.BalanceScore+gain, predicted.BalanceScore, 1e-9)
+
+	// 원본 상태는 변경되지 않음
+	assert.Equal(t, int32(90), state.Nodes[0].CPUPercent)
+}
+
+// fakeMigrationRunner finishes each migration after a short delay with a preset status
+type fakeMigrationRunner struct {
+	mu        sync.Mutex
+	outcomes  map[string]types.MigrationStatus // pod name -> final status
+	hanging   map[string]bool                  // pod name -> runs until cancelled
+	cancelled map[string]chan struct{}         // migration ID -> closed by CancelMigration
+	inFlight  int
+	peak      int
+}
+
+func (f *fakeMigrationRunner) cancelledCh(migrationID string) chan struct{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.cancelled == nil {
+		f.cancelled = map[string]chan struct{}{}
+	}
+	if f.cancelled[migrationID] == nil {
+		f.cancelled[migrationID] = make(chan struct{})
+	}
+	return f.cancelled[migrationID]
+}
+
+func (f *fakeMigrationRunner) CancelMigration(migrationID string) error {
+	ch := f.cancelledCh(migrationID)
+	select {
+	case <-ch:
+		return fmt.Errorf("migration %s is already cancelled", migrationID)
+	default:
+		close(ch)
+		return nil
+	}
+}
+
+func (f *fakeMigrationRunner) StartMigration(req *types.MigrationRequest) (*types.MigrationResponse, error) {
+	return &types.MigrationResponse{MigrationID: "migration-" + req.PodName, Status: types.MigrationStatusPending}, nil
+}
+
+func (f *fakeMigrationRunner) WaitForMigration(ctx context.Context, migrationID string) (*types.MigrationResponse, error) {
+	f.mu.Lock()
+	f.inFlight++
+	if f.inFlight > f.peak {
+		f.peak = f.inFlight
+	}
+	hanging := f.hanging[strings.TrimPrefix(migrationID, "migration-")]
+	f.mu.Unlock()
+
+	if hanging {
+		defer func() {
+			f.mu.Lock()
+			f.inFlight--
+			f.mu.Unlock()
+		}()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-f.cancelledCh(migrationID):
+			return &types.MigrationResponse{MigrationID: migrationID, Status: types.MigrationStatusCancelled}, nil
+		}
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.inFlight--
+	status := f.outcomes[strings.TrimPrefix(migrationID, "migration-")]
+	return &types.MigrationResponse{
+		MigrationID: migrationID,
+		Status:      status,
+		Details:     &types.MigrationDetails{ErrorMessage: "target node rejected pod"},
+	}, nil
+}
+
+func TestExecuteMigrationsWaitsForOutcome(t *testing.T) {
+	runner := &fakeMigrationRunner{outcomes: map[string]types.MigrationStatus{
+		"pod-a": types.MigrationStatusCompleted,
+		"pod-b": types.MigrationStatusFailed,
+		"pod-c": types.MigrationStatusCompleted,
+	}}
+	lc := NewLoadbalancingController(new(MockK8sClient), runner)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	job := &LoadbalancingJob{
+		ID:      "lb-test",
+		Request: &types.LoadbalancingRequest{MaxConcurrentMigrations: 2},
+		Details: &types.LoadbalancingDetails{},
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	plan := []types.MigrationPlan{
+		{PodName: "pod-c", PodNamespace: "default", SourceNode: "node-a", TargetNode: "node-b", Priority: 98},
+		{PodName: "pod-a", PodNamespace: "default", SourceNode: "node-a", TargetNode: "node-b", Priority: 100},
+		{PodName: "pod-b", PodNamespace: "default", SourceNode: "node-a", TargetNode: "node-b", Priority: 99},
+	}
+
+	require.NoError(t, lc.executeMigrations(job, plan))
+
+	require.Len(t, job.Details.ExecutedMigrations, 3)
+	assert.Equal(t, "pod-a", job.Details.ExecutedMigrations[0].PodName)
+	assert.Equal(t, "success", job.Details.ExecutedMigrations[0].Status)
+	assert.Equal(t, "failed", job.Details.ExecutedMigrations[1].Status)
+	assert.Equal(t, "target node rejected pod", job.Details.ExecutedMigrations[1].ErrorMessage)
+	assert.Equal(t, "migration-pod-c", job.Details.ExecutedMigrations[2].MigrationID)
+	assert.Equal(t, int32(2), job.Details.SuccessfulMigrations)
+	assert.Equal(t, int32(1), job.Details.FailedMigrations)
+	assert.LessOrEqual(t, runner.peak, 2)
+}
+
+// TestCancelLoadbalancingCancelsChildMigrations tests that cancelling the job cancels the migrations it started
+func TestCancelLoadbalancingCancelsChildMigrations(t *testing.T) {
+	runner := &fakeMigrationRunner{
+		outcomes: map[string]types.MigrationStatus{"pod-b": types.MigrationStatusCompleted},
+		hanging:  map[string]bool{"pod-a": true},
+	}
+	lc := NewLoadbalancingController(new(MockK8sClient), runner)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	job := &LoadbalancingJob{
+		ID:      "lb-test",
+		Request: &types.LoadbalancingRequest{MaxConcurrentMigrations: 1},
+		Status:  types.LoadbalancingStatusExecuting,
+		Details: &types.LoadbalancingDetails{},
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	lc.jobs[job.ID] = job
+	plan := []types.MigrationPlan{
+		{PodName: "pod-a", PodNamespace: "default", SourceNode: "node-a", TargetNode: "node-b", Priority: 100},
+		{PodName: "pod-b", PodNamespace: "default", SourceNode: "node-a", TargetNode: "node-b", Priority: 99},
+	}
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		assert.NoError(t, lc.CancelLoadbalancing(job.ID))
+	}()
+	require.NoError(t, lc.executeMigrations(job, plan))
+
+	// The in-flight migration is cancelled and waited for; the queued one is never started
+	require.Len(t, job.Details.ExecutedMigrations, 1)
+	assert.Equal(t, "cancelled", job.Details.ExecutedMigrations[0].Status)
+	select {
+	case <-runner.cancelledCh("migration-pod-a"):
+	default:
+		t.Fatal("child migration was not cancelled")
+	}
+	assert.Equal(t, 0, runner.inFlight)
+}
+
+// TestCancelOneTimeLoadbalancingJob tests that a cancelled one-time job ends as cancelled, not completed
+func TestCancelOneTimeLoadbalancingJob(t *testing.T) {
+	runner := &fakeMigrationRunner{hanging: map[string]bool{"trainer-0": true}}
+	lc := NewLoadbalancingController(newImbalancedClusterMock(), runner)
+
+	jobID, err := lc.StartLoadbalancing(&types.LoadbalancingRequest{
+		Strategy:              string(types.StrategyLoadSpreading),
+		MaxMigrationsPerCycle: 1,
+	})
+	require.NoError(t, err)
+
+	// 마이그레이션이 진행 중일 때 취소
+	assert.Eventually(t, func() bool {
+		runner.mu.Lock()
+		defer runner.mu.Unlock()
+		return runner.inFlight == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	require.NoError(t, lc.CancelLoadbalancing(jobID))
+
+	assert.Eventually(t, func() bool {
+		resp, err := lc.GetLoadbalancingJob(jobID)
+		return err == nil && resp.Status == types.LoadbalancingStatusCancelled
+	}, 2*time.Second, 10*time.Millisecond)
+
+	resp, err := lc.GetLoadbalancingJob(jobID)
+	require.NoError(t, err)
+	assert.NotNil(t, resp.Details.CompletedAt)
+	assert.Error(t, lc.CancelLoadbalancing(jobID))
+}
@@ -0,0 +1,184 @@
+// Package controller implements the LoadbalancingPolicy CRD controller
+// kubectl apply로 선언한 LoadbalancingPolicy를 LoadbalancingController 작업으로 실행
+// spec 변경 시 기존 작업을 취소하고 재시작, 리소스 삭제 시 finalizer로 작업 취소
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	apollov1 "ai-storage-orchestrator/api/v1"
+	"ai-storage-orchestrator/pkg/types"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// LoadbalancingPolicyReconciler reconciles a LoadbalancingPolicy object
+type LoadbalancingPolicyReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	Loadbalancing *LoadbalancingController
+
+	started    *jobTracker
+	namespaces namespaceScope
+}
+
+// NewLoadbalancingPolicyReconciler creates a new reconciler
+func NewLoadbalancingPolicyReconciler(client client.Client, scheme *runtime.Scheme, loadbalancing *LoadbalancingController) *LoadbalancingPolicyReconciler {
+	return &LoadbalancingPolicyReconciler{
+		Client:        client,
+		Scheme:        scheme,
+		Loadbalancing: loadbalancing,
+		started:       newJobTracker(),
+	}
+}
+
+// AllowCrossNamespace lets LoadbalancingPolicies in the given namespaces target other or all namespaces
+func (r *LoadbalancingPolicyReconciler) AllowCrossNamespace(namespaces []string) {
+	r.namespaces.allow(namespaces)
+}
+
+// +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=loadbalancingpolicies,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=loadbalancingpolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=loadbalancingpolicies/finalizers,verbs=update
+
+// Reconcile runs the loadbalancing job described by a LoadbalancingPolicy and mirrors its results
+func (r *LoadbalancingPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var lp apollov1.LoadbalancingPolicy
+	if err := r.Get(ctx, req.NamespacedName, &lp); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// 삭제 처리: 실행 중인 작업 취소 후 finalizer 제거
+	if !lp.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(&lp, apollov1.JobFinalizer) {
+			r.cancelJob(&lp)
+			controllerutil.RemoveFinalizer(&lp, apollov1.JobFinalizer)
+			if err := r.Update(ctx, &lp); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		r.started.forget(lp.UID)
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(&lp, apollov1.JobFinalizer) {
+		controllerutil.AddFinalizer(&lp, apollov1.JobFinalizer)
+		if err := r.Update(ctx, &lp); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// spec 변경: 기존 작업 취소 후 새 정책으로 재시작
+	if lp.Status.ObservedGeneration != 0 && lp.Generation != lp.Status.ObservedGeneration {
+		log.Printf("[LoadbalancingPolicy] %s/%s: spec 변경 감지, 작업 재시작", lp.Namespace, lp.Name)
+		r.cancelJob(&lp)
+		lp.Status.JobID = ""
+		lp.Status.CompletionTime = nil
+	}
+
+	// 종료된 작업은 spec이 바뀔 때까지 다시 실행하지 않음
+	if lp.Status.CompletionTime != nil {
+		r.started.forget(lp.UID)
+		return ctrl.Result{}, nil
+	}
+
+	// 1. 작업 시작
+	if lp.Status.JobID == "" {
+		jobID := r.started.get(lp.UID)
+		if jobID == "" {
+			namespace, err := r.namespaces.resolve(lp.Namespace, lp.Spec.Namespace)
+			if err == nil {
+				jobID, err = r.Loadbalancing.StartLoadbalancing(&types.LoadbalancingRequest{
+					Namespace:             namespace,
+					TargetNodes:           lp.Spec.TargetNodes,
+					Strategy:              lp.Spec.Strategy,
+					CPUThreshold:          lp.Spec.CPUThreshold,
+					MemoryThreshold:       lp.Spec.MemoryThreshold,
+					GPUThreshold:          lp.Spec.GPUThreshold,
+					StorageReadThreshold:  lp.Spec.StorageReadThreshold,
+					StorageWriteThreshold: lp.Spec.StorageWriteThreshold,
+					StorageIOPSThreshold:  lp.Spec.StorageIOPSThreshold,
+					MaxMigrationsPerCycle: lp.Spec.MaxMigrationsPerCycle,
+					Interval:              lp.Spec.IntervalSeconds,
+					PreservePV:            lp.Spec.PreservePV,
+				})
+			}
+			if err != nil {
+				lp.Status.ObservedGeneration = lp.Generation
+				setJobStatus(&lp.Status.JobStatus, lp.Generation, string(types.LoadbalancingStatusFailed), err.Error(), false, true)
+				return ctrl.Result{}, r.Status().Update(ctx, &lp)
+			}
+			r.started.set(lp.UID, jobID)
+			log.Printf("[LoadbalancingPolicy] %s/%s: 부하 분산 시작 (%s, strategy=%s, interval=%ds)",
+				lp.Namespace, lp.Name, jobID, lp.Spec.Strategy, lp.Spec.IntervalSeconds)
+		}
+		startJobStatus(&lp.Status.JobStatus, jobID, lp.Generation)
+	}
+
+	// 2. 작업 상태 반영
+	finished := r.mirrorStatus(&lp)
+	if err := r.Status().Update(ctx, &lp); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.started.forget(lp.UID)
+
+	if finished {
+		log.Printf("[LoadbalancingPolicy] %s/%s: %s", lp.Namespace, lp.Name, lp.Status.Phase)
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: jobRequeueInterval}, nil
+}
+
+// cancelJob cancels the policy's loadbalancing job if it is still running
+func (r *LoadbalancingPolicyReconciler) cancelJob(lp *apollov1.LoadbalancingPolicy) {
+	if lp.Status.JobID == "" {
+		return
+	}
+	if err := r.Loadbalancing.CancelLoadbalancing(lp.Status.JobID); err != nil {
+		log.Printf("[LoadbalancingPolicy] %s/%s: 작업 취소 생략: %v", lp.Namespace, lp.Name, err)
+	}
+}
+
+// mirrorStatus copies the loadbalancing job state into the LoadbalancingPolicy status
+// and reports whether the job reached a terminal state
+func (r *LoadbalancingPolicyReconciler) mirrorStatus(lp *apollov1.LoadbalancingPolicy) bool {
+	resp, err := r.Loadbalancing.GetLoadbalancingJob(lp.Status.JobID)
+	if err != nil {
+		setJobStatus(&lp.Status.JobStatus, lp.Generation, string(types.LoadbalancingStatusFailed),
+			fmt.Sprintf("%s: %s", jobLostMessage, lp.Status.JobID), false, true)
+		return true
+	}
+
+	message := resp.Message
+	if resp.Details != nil {
+		lp.Status.TotalPodsAnalyzed = resp.Details.TotalPodsAnalyzed
+		lp.Status.PodsToMigrate = resp.Details.PodsToMigrate
+		lp.Status.SuccessfulMigrations = resp.Details.SuccessfulMigrations
+		lp.Status.FailedMigrations = resp.Details.FailedMigrations
+		if resp.Details.ErrorMessage != "" {
+			message = resp.Details.ErrorMessage
+		}
+	}
+
+	finished := resp.Status == types.LoadbalancingStatusCompleted ||
+		resp.Status == types.LoadbalancingStatusFailed ||
+		resp.Status == types.LoadbalancingStatusCancelled
+	// 주기 실행 정책은 작업이 살아있는 동안 Ready
+	succeeded := resp.Status == types.LoadbalancingStatusCompleted ||
+		(lp.Spec.IntervalSeconds > 0 && !finished)
+	setJobStatus(&lp.Status.JobStatus, lp.Generation, string(resp.Status), message, succeeded, finished)
+	return finished
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *LoadbalancingPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&apollov1.LoadbalancingPolicy{}, builder.WithPredicates(jobObjectPredicate())).
+		Complete(r)
+}
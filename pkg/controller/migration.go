@@ -2,17 +2,31 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"sync"
 	"time"
 
+	"ai-storage-orchestrator/pkg/audit"
 	"ai-storage-orchestrator/pkg/k8s"
+	"ai-storage-orchestrator/pkg/eventbus"
+	"ai-storage-orchestrator/pkg/store"
 	"ai-storage-orchestrator/pkg/types"
 	
 	"github.com/google/uuid"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
+// rollbackTimeout bounds the compensating steps of a failed or cancelled migration
+const rollbackTimeout = 3 * time.Minute
+
+// migrationPollInterval is how often WaitForMigration checks the migration status
+const migrationPollInterval = time.Second
+
 // MigrationController manages pod migrations with persistent volume optimization
 type MigrationController struct {
 	k8sClient      *k8s.Client
@@ -20,6 +34,14 @@ type MigrationController struct {
 	migrationsMux  sync.RWMutex
 	metrics        *types.MigrationMetrics
 	checkpointSize string // Default PV size for checkpoints
+	jobStore       store.JobStore
+	auditLog       audit.Recorder
+	events         *eventbus.Bus
+
+	// Checkpoint image registry for CRIU restore (empty: no checkpoint, restart-based restore)
+	checkpointRegistry       string
+	checkpointNamespace      string
+	checkpointRegistrySecret string
 }
 
 // MigrationJob represents an active migration job
@@ -31,6 +53,16 @@ type MigrationJob struct {
 	StartTime   time.Time
 	ctx         context.Context
 	cancel      context.CancelFunc
+
+	// Rollback state
+	originalPod     *corev1.Pod // snapshot taken before migration, used to restore the original pod
+	originalDeleted bool
+	cancelRequested bool
+
+	// Workload state changed while migrating through a Deployment/StatefulSet
+	workloadPinned         bool
+	previousNodeAffinity   *corev1.NodeAffinity
+	previousUpdateStrategy *appsv1.StatefulSetUpdateStrategy // nil when unchanged or already restored
 }
 
 // NewMigrationController creates a new migration controller
@@ -40,7 +72,88 @@ func NewMigrationController(k8sClient *k8s.Client) *MigrationController {
 		migrations:     make(map[string]*MigrationJob),
 		metrics:        &types.MigrationMetrics{},
 		checkpointSize: "1Gi", // Default 1GB for checkpoint storage
+		jobStore:       store.NewNopStore(),
+	}
+}
+
+// SetJobStore configures the store that migration jobs are written through
+func (mc *MigrationController) SetJobStore(s store.JobStore) {
+	mc.jobStore = s
+}
+
+// SetAuditLog records the outcome of every migration in the audit trail
+func (mc *MigrationController) SetAuditLog(recorder audit.Recorder) {
+	mc.auditLog = recorder
+}
+
+// SetEventBus publishes status transitions and per-step progress of every migration
+func (mc *MigrationController) SetEventBus(bus *eventbus.Bus) {
+	mc.events = bus
+}
+
+// RestoreJobs reloads persisted migrations. Migrations that were still in flight
+// cannot be resumed safely (the pod may be half-migrated), so they are marked failed.
+func (mc *MigrationController) RestoreJobs() error {
+	restored := 0
+	err := mc.jobStore.ForEach(store.KindMigration, func(id string, data []byte) error {
+		var rec migrationRecord
+		if err := json.Unmarshal(data, &rec); err != nil {
+			log.Printf("Warning: Skipping corrupt migration record %s: %v", id, err)
+			return nil
+		}
+		if rec.Request == nil {
+			log.Printf("Warning: Skipping migration record %s without a request", id)
+			return nil
+		}
+
+		job := &MigrationJob{
+			ID:        rec.ID,
+			Request:   rec.Request,
+			Status:    rec.Status,
+			Details:   rec.Details,
+			StartTime: rec.StartTime,
+		}
+		if job.Details == nil {
+			job.Details = &types.MigrationDetails{StartTime: rec.StartTime}
+		}
+
+		mc.migrationsMux.Lock()
+		mc.migrations[job.ID] = job
+		switch job.Status {
+		case types.MigrationStatusCompleted:
+			mc.metrics.TotalMigrations++
+			mc.metrics.SuccessfulMigrations++
+		case types.MigrationStatusFailed:
+			mc.metrics.FailedMigrations++
+		}
+		mc.migrationsMux.Unlock()
+
+		if job.Status == types.MigrationStatusPending || job.Status == types.MigrationStatusRunning {
+			mc.failMigration(job, interruptedMessage)
+		}
+		restored++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore migrations: %w", err)
 	}
+
+	log.Printf("Restored %d migration jobs", restored)
+	return nil
+}
+
+// persistJob writes the current state of a migration job to the job store
+func (mc *MigrationController) persistJob(job *MigrationJob) {
+	mc.migrationsMux.RLock()
+	defer mc.migrationsMux.RUnlock()
+
+	saveRecord(mc.jobStore, store.KindMigration, job.ID, &migrationRecord{
+		ID:        job.ID,
+		Request:   job.Request,
+		Status:    job.Status,
+		Details:   job.Details,
+		StartTime: job.StartTime,
+	})
 }
 
 // StartMigration initiates a new pod migration
@@ -70,6 +183,7 @@ func (mc *MigrationController) StartMigration(req *types.MigrationRequest) (*typ
 	mc.migrationsMux.Lock()
 	mc.migrations[migrationID] = job
 	mc.migrationsMux.Unlock()
+	mc.persistJob(job)
 
 	// Start migration in background
 	go mc.executeMigration(job)
@@ -85,9 +199,9 @@ func (mc *MigrationController) StartMigration(req *types.MigrationRequest) (*typ
 // GetMigrationStatus returns the current status of a migration
 func (mc *MigrationController) GetMigrationStatus(migrationID string) (*types.MigrationResponse, error) {
 	mc.migrationsMux.RLock()
+	defer mc.migrationsMux.RUnlock()
+
 	job, exists := mc.migrations[migrationID]
-	mc.migrationsMux.RUnlock()
-	
 	if !exists {
 		return nil, fmt.Errorf("migration %s not found", migrationID)
 	}
@@ -96,10 +210,51 @@ func (mc *MigrationController) GetMigrationStatus(migrationID string) (*types.Mi
 		MigrationID: job.ID,
 		Status:      job.Status,
 		Message:     mc.getStatusMessage(job.Status),
-		Details:     job.Details,
+		Details:     copyMigrationDetails(job.Details),
 	}, nil
 }
 
+// ListMigrations returns all migrations
+func (mc *MigrationController) ListMigrations() []*types.MigrationResponse {
+	mc.migrationsMux.RLock()
+	defer mc.migrationsMux.RUnlock()
+
+	result := make([]*types.MigrationResponse, 0, len(mc.migrations))
+	for _, job := range mc.migrations {
+		result = append(result, &types.MigrationResponse{
+			MigrationID: job.ID,
+			Status:      job.Status,
+			Message:     mc.getStatusMessage(job.Status),
+			Details:     copyMigrationDetails(job.Details),
+		})
+	}
+	return result
+}
+
+// WaitForMigration blocks until the migration is completed, failed or cancelled and returns
+// its final status. If ctx ends first, the last observed status is returned with an error.
+func (mc *MigrationController) WaitForMigration(ctx context.Context, migrationID string) (*types.MigrationResponse, error) {
+	ticker := time.NewTicker(migrationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := mc.GetMigrationStatus(migrationID)
+		if err != nil {
+			return nil, err
+		}
+		switch resp.Status {
+		case types.MigrationStatusCompleted, types.MigrationStatusFailed, types.MigrationStatusCancelled:
+			return resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return resp, fmt.Errorf("stopped waiting for migration %s in status %s: %w", migrationID, resp.Status, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
 // executeMigration performs the actual migration following the 3-step process from the paper
 func (mc *MigrationController) executeMigration(job *MigrationJob) {
 	defer func() {
@@ -114,48 +269,186 @@ func (mc *MigrationController) executeMigration(job *MigrationJob) {
 
 	// Update status to running
 	mc.updateJobStatus(job, types.MigrationStatusRunning)
+	mc.k8sClient.RecordEvent("Pod", job.Request.PodNamespace, job.Request.PodName, corev1.EventTypeNormal, k8s.EventReasonMigrating,
+		fmt.Sprintf("Migrating from node %s to %s (migration %s)", job.Request.SourceNode, job.Request.TargetNode, job.ID))
 
 	// Step 1: Capture container states and collect metrics
 	if err := mc.captureContainerStates(job); err != nil {
-		mc.failMigration(job, fmt.Sprintf("Failed to capture container states: %v", err))
+		mc.abortMigration(job, fmt.Sprintf("Failed to capture container states: %v", err))
+		return
+	}
+	mc.publishStep(job, "capture_state", fmt.Sprintf("Captured state of %d containers", len(job.Details.ContainerStates)))
+
+	// Resolve the owning workload; owned pods are migrated through their controller
+	if err := mc.resolveWorkload(job); err != nil {
+		mc.abortMigration(job, err.Error())
+		return
+	}
+	if job.Details.WorkloadKind != "" {
+		mc.publishStep(job, "resolve_workload", fmt.Sprintf("Pod is owned by %s %s", job.Details.WorkloadKind, job.Details.WorkloadName))
+	}
+
+	// Steps 2-4: move the pod to the target node
+	var err error
+	switch job.Details.WorkloadKind {
+	case "":
+		err = mc.migrateBarePod(job)
+	case "Deployment":
+		err = mc.migrateDeployment(job)
+	case "StatefulSet":
+		err = mc.migrateStatefulSet(job)
+	default:
+		err = fmt.Errorf("pods owned by a %s cannot be migrated", job.Details.WorkloadKind)
+	}
+	if err != nil {
+		mc.abortMigration(job, err.Error())
+		return
+	}
+
+	// Step 5: Collect post-migration metrics
+	mc.publishStep(job, "collect_metrics", "Collecting post-migration metrics")
+	if err := mc.collectPostMigrationMetrics(job); err != nil {
+		log.Printf("Warning: Failed to collect post-migration metrics: %v", err)
+		// Don't fail migration for this
+	}
+
+	// A cancellation that arrived while metrics were collected still rolls back
+	if mc.isCancelRequested(job) {
+		mc.abortMigration(job, "Migration cancelled")
 		return
 	}
 
+	// Complete migration
+	mc.completeMigration(job)
+	
+	log.Printf("Migration %s completed successfully", job.ID)
+}
+
+// migrateBarePod recreates an unowned pod on the target node with only the containers worth migrating
+func (mc *MigrationController) migrateBarePod(job *MigrationJob) error {
 	// Step 2: Create checkpoint in Persistent Volume (if enabled)
 	var checkpointPVC string
 	if job.Request.PreservePV {
 		var err error
 		checkpointPVC, err = mc.createCheckpoint(job)
 		if err != nil {
-			mc.failMigration(job, fmt.Sprintf("Failed to create checkpoint: %v", err))
-			return
+			return fmt.Errorf("Failed to create checkpoint: %v", err)
 		}
-		job.Details.CheckpointPath = checkpointPVC
-		job.Details.PVClaimName = checkpointPVC
 	}
 
 	// Step 3: Create optimized pod (only with running containers)
 	if err := mc.createOptimizedPod(job, checkpointPVC); err != nil {
-		mc.failMigration(job, fmt.Sprintf("Failed to create optimized pod: %v", err))
-		return
+		return fmt.Errorf("Failed to create optimized pod: %v", err)
 	}
 
 	// Step 4: Delete original pod
 	if err := mc.deleteOriginalPod(job); err != nil {
+		if mc.isCancelRequested(job) {
+			return err
+		}
 		log.Printf("Warning: Failed to delete original pod: %v", err)
 		// Don't fail migration for this, just log warning
 	}
 
-	// Step 5: Collect post-migration metrics
-	if err := mc.collectPostMigrationMetrics(job); err != nil {
-		log.Printf("Warning: Failed to collect post-migration metrics: %v", err)
-		// Don't fail migration for this
+	return nil
+}
+
+// CancelMigration stops a pending or running migration; executeMigration then rolls back
+// whatever it already changed and marks the migration cancelled
+func (mc *MigrationController) CancelMigration(migrationID string) error {
+	mc.migrationsMux.Lock()
+	job, exists := mc.migrations[migrationID]
+	if !exists {
+		mc.migrationsMux.Unlock()
+		return fmt.Errorf("migration %s not found", migrationID)
 	}
 
-	// Complete migration
-	mc.completeMigration(job)
-	
-	log.Printf("Migration %s completed successfully", job.ID)
+	if job.Status != types.MigrationStatusPending && job.Status != types.MigrationStatusRunning {
+		mc.migrationsMux.Unlock()
+		return fmt.Errorf("cannot cancel migration in status: %s", job.Status)
+	}
+	if job.cancel == nil {
+		mc.migrationsMux.Unlock()
+		return fmt.Errorf("migration %s is not running in this orchestrator", migrationID)
+	}
+
+	job.cancelRequested = true
+	mc.migrationsMux.Unlock()
+
+	job.cancel()
+	log.Printf("Migration %s: Cancellation requested", migrationID)
+	return nil
+}
+
+func (mc *MigrationController) isCancelRequested(job *MigrationJob) bool {
+	mc.migrationsMux.RLock()
+	defer mc.migrationsMux.RUnlock()
+	return job.cancelRequested
+}
+
+// abortMigration rolls back a migration that failed or was cancelled part way through
+func (mc *MigrationController) abortMigration(job *MigrationJob, message string) {
+	mc.publishStep(job, "rollback", message)
+	rollbackErr := mc.rollbackMigration(job)
+
+	if mc.isCancelRequested(job) {
+		mc.cancelMigration(job, rollbackErr)
+		return
+	}
+
+	if rollbackErr != nil {
+		message = fmt.Sprintf("%s (rollback failed: %v)", message, rollbackErr)
+	}
+	mc.failMigration(job, message)
+}
+
+// rollbackMigration undoes the steps a migration already performed:
+// deletes the half-created pod and checkpoint PVC, and recreates the original pod if it was deleted
+// (controller-owned pods are handed back to their Deployment/StatefulSet instead)
+func (mc *MigrationController) rollbackMigration(job *MigrationJob) error {
+	// job.ctx may already be cancelled, compensating steps get their own deadline
+	ctx, cancel := context.WithTimeout(context.Background(), rollbackTimeout)
+	defer cancel()
+
+	var errs []error
+	namespace := job.Request.PodNamespace
+
+	// 1. 워크로드 소유 Pod는 컨트롤러 설정을 원래대로 복구
+	if job.Details.WorkloadKind != "" {
+		errs = append(errs, mc.rollbackWorkload(ctx, job)...)
+	} else if job.Details.NewPodName != "" {
+		// 새로 생성된 Pod 삭제
+		if err := mc.k8sClient.DeletePod(ctx, namespace, job.Details.NewPodName); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete new pod %s: %w", job.Details.NewPodName, err))
+		} else {
+			log.Printf("Migration %s: Rollback deleted new pod %s", job.ID, job.Details.NewPodName)
+		}
+	}
+
+	// 2. 체크포인트 PVC 삭제
+	if job.Details.PVClaimName != "" {
+		if err := mc.k8sClient.DeletePVC(ctx, namespace, job.Details.PVClaimName); err != nil {
+			errs = append(errs, err)
+		} else {
+			log.Printf("Migration %s: Rollback deleted checkpoint PVC %s", job.ID, job.Details.PVClaimName)
+		}
+	}
+
+	// 3. 원본 Pod가 이미 삭제되었으면 복구
+	if job.Details.WorkloadKind == "" && job.originalDeleted && job.originalPod != nil {
+		if err := mc.k8sClient.RestorePod(ctx, job.originalPod); err != nil {
+			errs = append(errs, fmt.Errorf("failed to restore original pod %s: %w", job.originalPod.Name, err))
+		} else {
+			log.Printf("Migration %s: Rollback restored original pod %s on node %s",
+				job.ID, job.originalPod.Name, job.originalPod.Spec.NodeName)
+		}
+	}
+
+	mc.migrationsMux.Lock()
+	job.Details.RolledBack = len(errs) == 0
+	mc.migrationsMux.Unlock()
+
+	return errors.Join(errs...)
 }
 
 // captureContainerStates analyzes current container states and collects resource metrics
@@ -174,21 +467,17 @@ func (mc *MigrationController) captureContainerStates(job *MigrationJob) error {
 		return fmt.Errorf("failed to analyze container states: %w", err)
 	}
 
-	job.Details.ContainerStates = containerStates
+	mc.updateJobDetails(job, func(d *types.MigrationDetails) { d.ContainerStates = containerStates })
+	job.originalPod = pod.DeepCopy()
 
 	// Collect original resource metrics
+	// Left unset when unavailable so no savings are reported against made-up values
 	metrics, err := mc.k8sClient.GetPodMetrics(ctx, job.Request.PodNamespace, job.Request.PodName)
 	if err != nil {
 		log.Printf("Warning: Failed to collect original metrics: %v", err)
-		// Create default metrics if collection fails
-		metrics = &types.ResourceUsage{
-			CPUUsage:    0,
-			MemoryUsage: 0,
-			Timestamp:   time.Now(),
-		}
+		metrics = nil
 	}
-	
-	job.Details.OriginalResources = metrics
+	mc.updateJobDetails(job, func(d *types.MigrationDetails) { d.OriginalResources = metrics })
 
 	// Count containers that should be migrated
 	shouldMigrate := 0
@@ -204,7 +493,7 @@ func (mc *MigrationController) captureContainerStates(job *MigrationJob) error {
 	return nil
 }
 
-// createCheckpoint creates a PVC for storing container state
+// createCheckpoint creates a PVC for storing container state and checkpoints the running containers onto it
 func (mc *MigrationController) createCheckpoint(job *MigrationJob) (string, error) {
 	ctx := job.ctx
 	
@@ -215,7 +504,17 @@ func (mc *MigrationController) createCheckpoint(job *MigrationJob) (string, erro
 		return "", fmt.Errorf("failed to create checkpoint PVC: %w", err)
 	}
 
+	mc.updateJobDetails(job, func(d *types.MigrationDetails) {
+		d.CheckpointPath = checkpointName
+		d.PVClaimName = checkpointName
+	})
+
 	log.Printf("Migration %s: Created checkpoint PVC %s", job.ID, checkpointName)
+	mc.publishStep(job, "checkpoint", fmt.Sprintf("Created checkpoint PVC %s", checkpointName))
+	mc.k8sClient.RecordEvent("PersistentVolumeClaim", job.Request.PodNamespace, checkpointName, corev1.EventTypeNormal, k8s.EventReasonCheckpointCreated,
+		fmt.Sprintf("Checkpoint of pod %s for migration %s", job.Request.PodName, job.ID))
+
+	mc.checkpointContainers(job)
 	return checkpointName, nil
 }
 
@@ -230,11 +529,14 @@ func (mc *MigrationController) createOptimizedPod(job *MigrationJob, checkpointP
 	}
 
 	// Create optimized pod
-	newPod, err := mc.k8sClient.CreateOptimizedPod(ctx, originalPod, job.Request.TargetNode, job.Details.ContainerStates, checkpointPVC)
+	newPod, err := mc.k8sClient.CreateOptimizedPod(ctx, originalPod, job.Request.TargetNode, job.Details.ContainerStates, checkpointPVC, job.Details.CheckpointImages, mc.restorePullSecret(job))
 	if err != nil {
 		return fmt.Errorf("failed to create optimized pod: %w", err)
 	}
 
+	// Record the new pod right away so that a rollback can remove it
+	mc.updateJobDetails(job, func(d *types.MigrationDetails) { d.NewPodName = newPod.Name })
+
 	log.Printf("Migration %s: Created optimized pod %s on node %s", 
 		job.ID, newPod.Name, job.Request.TargetNode)
 
@@ -245,10 +547,8 @@ func (mc *MigrationController) createOptimizedPod(job *MigrationJob, checkpointP
 	}
 
 	log.Printf("Migration %s: New pod %s is ready", job.ID, newPod.Name)
-	
-	// Store new pod name for later metric collection
-	job.Details.NewPodName = newPod.Name
-	
+	mc.publishStep(job, "create_pod", fmt.Sprintf("Pod %s is ready on node %s", newPod.Name, job.Request.TargetNode))
+
 	return nil
 }
 
@@ -260,55 +560,93 @@ func (mc *MigrationController) deleteOriginalPod(job *MigrationJob) error {
 	if err != nil {
 		return fmt.Errorf("failed to delete original pod: %w", err)
 	}
+	job.originalDeleted = true
 
 	log.Printf("Migration %s: Deleted original pod %s", job.ID, job.Request.PodName)
+	mc.publishStep(job, "delete_original", fmt.Sprintf("Deleted original pod %s", job.Request.PodName))
 	return nil
 }
 
 // collectPostMigrationMetrics collects resource usage after migration
+// OptimizedResources stays unset when the new pod cannot be measured
 func (mc *MigrationController) collectPostMigrationMetrics(job *MigrationJob) error {
 	// Wait a bit for metrics to stabilize
-	time.Sleep(30 * time.Second)
+	select {
+	case <-time.After(30 * time.Second):
+	case <-job.ctx.Done():
+		return job.ctx.Err()
+	}
+
+	if job.Details.NewPodName == "" {
+		log.Printf("Warning: Migration %s: New pod name not available, optimized metrics not collected", job.ID)
+		return nil
+	}
 
 	// Collect actual metrics from the new pod
-	if job.Details.NewPodName != "" {
-		metrics, err := mc.k8sClient.GetPodMetrics(job.ctx, job.Request.PodNamespace, job.Details.NewPodName)
-		if err != nil {
-			log.Printf("Warning: Failed to collect optimized pod metrics: %v", err)
-			// Fallback to simulation if metrics collection fails
-			if job.Details.OriginalResources != nil {
-				job.Details.OptimizedResources = &types.ResourceUsage{
-					CPUUsage:    job.Details.OriginalResources.CPUUsage * 0.5,
-					MemoryUsage: int64(float64(job.Details.OriginalResources.MemoryUsage) * 0.6),
-					Timestamp:   time.Now(),
-				}
-			}
-			return nil
-		}
-		job.Details.OptimizedResources = metrics
-		log.Printf("Migration %s: Collected optimized metrics - CPU: %.2f cores, Memory: %d bytes", 
-			job.ID, metrics.CPUUsage, metrics.MemoryUsage)
-	} else {
-		// Fallback: if new pod name is not available, use simulation
-		log.Printf("Warning: New pod name not available, using simulated metrics")
-		if job.Details.OriginalResources != nil {
-			job.Details.OptimizedResources = &types.ResourceUsage{
-				CPUUsage:    job.Details.OriginalResources.CPUUsage * 0.5,
-				MemoryUsage: int64(float64(job.Details.OriginalResources.MemoryUsage) * 0.6),
-				Timestamp:   time.Now(),
-			}
-		}
+	metrics, err := mc.k8sClient.GetPodMetrics(job.ctx, job.Request.PodNamespace, job.Details.NewPodName)
+	if err != nil {
+		log.Printf("Warning: Migration %s: Failed to collect optimized pod metrics: %v", job.ID, err)
+		return nil
 	}
+	mc.updateJobDetails(job, func(d *types.MigrationDetails) { d.OptimizedResources = metrics })
+	log.Printf("Migration %s: Collected optimized metrics - CPU: %.2f cores, Memory: %d bytes",
+		job.ID, metrics.CPUUsage, metrics.MemoryUsage)
 
 	return nil
 }
 
 // Helper methods
 
+// updateJobDetails changes the details of a running job under the lock, as they are read by the API
+func (mc *MigrationController) updateJobDetails(job *MigrationJob, update func(*types.MigrationDetails)) {
+	mc.migrationsMux.Lock()
+	update(job.Details)
+	mc.migrationsMux.Unlock()
+}
+
+// copyMigrationDetails returns a copy of the details that callers can read without holding the lock
+func copyMigrationDetails(details *types.MigrationDetails) *types.MigrationDetails {
+	if details == nil {
+		return nil
+	}
+	c := *details
+	if details.EndTime != nil {
+		endTime := *details.EndTime
+		c.EndTime = &endTime
+	}
+	if details.Duration != nil {
+		duration := *details.Duration
+		c.Duration = &duration
+	}
+	if details.OriginalResources != nil {
+		original := *details.OriginalResources
+		c.OriginalResources = &original
+	}
+	if details.OptimizedResources != nil {
+		optimized := *details.OptimizedResources
+		c.OptimizedResources = &optimized
+	}
+	if details.ContainerStates != nil {
+		c.ContainerStates = append([]types.ContainerState(nil), details.ContainerStates...)
+	}
+	if details.CheckpointImages != nil {
+		c.CheckpointImages = make(map[string]string, len(details.CheckpointImages))
+		for container, image := range details.CheckpointImages {
+			c.CheckpointImages[container] = image
+		}
+	}
+	if details.Conditions != nil {
+		c.Conditions = append([]types.Condition(nil), details.Conditions...)
+	}
+	return &c
+}
+
 func (mc *MigrationController) updateJobStatus(job *MigrationJob, status types.MigrationStatus) {
 	mc.migrationsMux.Lock()
 	job.Status = status
 	mc.migrationsMux.Unlock()
+	mc.persistJob(job)
+	mc.publishStatus(job, status, mc.getStatusMessage(status))
 }
 
 func (mc *MigrationController) failMigration(job *MigrationJob, message string) {
@@ -320,8 +658,39 @@ func (mc *MigrationController) failMigration(job *MigrationJob, message string)
 	job.Details.EndTime = &endTime
 	duration := endTime.Sub(job.StartTime)
 	job.Details.Duration = &duration
+	job.Details.ErrorMessage = message
 	mc.metrics.FailedMigrations++
 	mc.migrationsMux.Unlock()
+	mc.persistJob(job)
+	mc.auditMigration(job, "migration.fail", audit.OutcomeFailure, message)
+	mc.publishStatus(job, types.MigrationStatusFailed, message)
+	mc.recordMigrationEvent(job, "", corev1.EventTypeWarning, k8s.EventReasonMigrationFailed,
+		fmt.Sprintf("Migration %s failed: %s", job.ID, message))
+}
+
+func (mc *MigrationController) cancelMigration(job *MigrationJob, rollbackErr error) {
+	log.Printf("Migration %s cancelled", job.ID)
+
+	mc.migrationsMux.Lock()
+	job.Status = types.MigrationStatusCancelled
+	endTime := time.Now()
+	job.Details.EndTime = &endTime
+	duration := endTime.Sub(job.StartTime)
+	job.Details.Duration = &duration
+	if rollbackErr != nil {
+		job.Details.ErrorMessage = fmt.Sprintf("rollback failed: %v", rollbackErr)
+	}
+	mc.migrationsMux.Unlock()
+	mc.persistJob(job)
+
+	outcome, message := audit.OutcomeSuccess, "migration cancelled and rolled back"
+	if rollbackErr != nil {
+		outcome, message = audit.OutcomeFailure, job.Details.ErrorMessage
+	}
+	mc.auditMigration(job, "migration.cancel", outcome, message)
+	mc.publishStatus(job, types.MigrationStatusCancelled, message)
+	mc.recordMigrationEvent(job, "", corev1.EventTypeWarning, k8s.EventReasonMigrationCancelled,
+		fmt.Sprintf("Migration %s cancelled: %s", job.ID, message))
 }
 
 func (mc *MigrationController) completeMigration(job *MigrationJob) {
@@ -342,16 +711,81 @@ func (mc *MigrationController) completeMigration(job *MigrationJob) {
 		mc.metrics.AverageDuration = (mc.metrics.AverageDuration*time.Duration(mc.metrics.TotalMigrations-1) + duration) / time.Duration(mc.metrics.TotalMigrations)
 	}
 	
-	// Calculate resource savings if we have both metrics
-	if job.Details.OriginalResources != nil && job.Details.OptimizedResources != nil {
-		cpuSavings := ((job.Details.OriginalResources.CPUUsage - job.Details.OptimizedResources.CPUUsage) / job.Details.OriginalResources.CPUUsage) * 100
-		memorySavings := ((float64(job.Details.OriginalResources.MemoryUsage - job.Details.OptimizedResources.MemoryUsage)) / float64(job.Details.OriginalResources.MemoryUsage)) * 100
-		
-		mc.metrics.CPUSavings = cpuSavings
-		mc.metrics.MemorySavings = memorySavings
+	// Calculate resource savings only from measured usage before and after
+	original, optimized := job.Details.OriginalResources, job.Details.OptimizedResources
+	if original != nil && optimized != nil && original.CPUUsage > 0 && original.MemoryUsage > 0 {
+		mc.metrics.CPUSavings = ((original.CPUUsage - optimized.CPUUsage) / original.CPUUsage) * 100
+		mc.metrics.MemorySavings = (float64(original.MemoryUsage-optimized.MemoryUsage) / float64(original.MemoryUsage)) * 100
 	}
 	
 	mc.migrationsMux.Unlock()
+	mc.persistJob(job)
+	mc.auditMigration(job, "migration.complete", audit.OutcomeSuccess,
+		fmt.Sprintf("pod moved from %s to %s", job.Request.SourceNode, job.Request.TargetNode))
+	mc.publishStatus(job, types.MigrationStatusCompleted, mc.getStatusMessage(types.MigrationStatusCompleted))
+	mc.recordMigrationEvent(job, job.Details.NewPodName, corev1.EventTypeNormal, k8s.EventReasonMigrated,
+		fmt.Sprintf("Pod %s migrated from node %s to %s (migration %s)", job.Request.PodName, job.Request.SourceNode, job.Request.TargetNode, job.ID))
+}
+
+// publishStatus publishes a status transition of a migration
+func (mc *MigrationController) publishStatus(job *MigrationJob, status types.MigrationStatus, message string) {
+	event := eventbus.Event{Kind: eventbus.KindMigration, Type: eventbus.TypeStatus, JobID: job.ID, Status: string(status), Message: message}
+	if job.Request != nil {
+		event.Namespace = job.Request.PodNamespace
+	}
+	mc.events.Publish(event)
+}
+
+// publishStep publishes the progress of a running migration
+func (mc *MigrationController) publishStep(job *MigrationJob, step, message string) {
+	mc.events.Publish(eventbus.Event{
+		Kind:      eventbus.KindMigration,
+		Type:      eventbus.TypeStep,
+		JobID:     job.ID,
+		Namespace: job.Request.PodNamespace,
+		Status:    string(types.MigrationStatusRunning),
+		Step:      step,
+		Message:   message,
+	})
+}
+
+// recordMigrationEvent records an event on a pod of the migration (the original pod when podName is empty)
+// and on the workload that owns it
+func (mc *MigrationController) recordMigrationEvent(job *MigrationJob, podName, eventType, reason, message string) {
+	if job.Request == nil {
+		return
+	}
+	namespace := job.Request.PodNamespace
+	if podName == "" {
+		podName = job.Request.PodName
+	}
+	mc.k8sClient.RecordEvent("Pod", namespace, podName, eventType, reason, message)
+	if job.Details.WorkloadKind != "" {
+		mc.k8sClient.RecordEvent(job.Details.WorkloadKind, namespace, job.Details.WorkloadName, eventType, reason, message)
+	}
+}
+
+// auditMigration records the outcome of a migration with the pod, workload and checkpoint PVC it touched
+func (mc *MigrationController) auditMigration(job *MigrationJob, action string, outcome audit.Outcome, message string) {
+	if job.Request == nil {
+		return
+	}
+	namespace := job.Request.PodNamespace
+	entry := &audit.Entry{
+		Action:    action,
+		Namespace: namespace,
+		JobID:     job.ID,
+		Outcome:   outcome,
+		Message:   message,
+		Targets:   []audit.Target{{Kind: "Pod", Namespace: namespace, Name: job.Request.PodName}},
+	}
+	if job.Details.WorkloadKind != "" {
+		entry.Targets = append(entry.Targets, audit.Target{Kind: job.Details.WorkloadKind, Namespace: namespace, Name: job.Details.WorkloadName})
+	}
+	if job.Details.PVClaimName != "" {
+		entry.Targets = append(entry.Targets, audit.Target{Kind: "PersistentVolumeClaim", Namespace: namespace, Name: job.Details.PVClaimName})
+	}
+	recordAudit(mc.auditLog, entry)
 }
 
 func (mc *MigrationController) getStatusMessage(status types.MigrationStatus) string {
@@ -371,6 +805,18 @@ func (mc *MigrationController) getStatusMessage(status types.MigrationStatus) st
 	}
 }
 
+// GetMigrationNamespace returns the namespace of the migrated pod
+func (mc *MigrationController) GetMigrationNamespace(id string) (string, error) {
+	mc.migrationsMux.RLock()
+	defer mc.migrationsMux.RUnlock()
+
+	job, exists := mc.migrations[id]
+	if !exists || job.Request == nil {
+		return "", fmt.Errorf("migration %s not found", id)
+	}
+	return job.Request.PodNamespace, nil
+}
+
 // GetMetrics returns current migration metrics
 func (mc *MigrationController) GetMetrics() *types.MigrationMetrics {
 	mc.migrationsMux.RLock()
@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"ai-storage-orchestrator/pkg/k8s"
+	"ai-storage-orchestrator/pkg/types"
+)
+
+const (
+	// checkpointCleanupImage deletes checkpoint archives from the source node
+	checkpointCleanupImage = "busybox:1.36"
+	// checkpointBuilderImage builds and pushes CRI-O checkpoint images from the archives
+	checkpointBuilderImage = "quay.io/buildah/stable:v1.33"
+	// checkpointTransferTimeout bounds the helper pod that moves archives off the source node
+	checkpointTransferTimeout = 10 * time.Minute
+)
+
+// SetCheckpointRegistry configures the registry that checkpoint images are pushed to.
+// Without a registry, containers are not checkpointed and restart on the target node,
+// which is reported with a False Checkpointed condition on the migration.
+// The helper pods that build the images run in namespace, the orchestrator's own, and
+// secret optionally names a docker config secret in that namespace used to push; it is
+// copied into the migrated pod's namespace and used as the restored pod's imagePullSecret.
+//
+// Checkpoints travel through the registry rather than the checkpoint PVC: CRI-O only restores
+// from an OCI image carrying the checkpoint annotation, and a ReadWriteOnce PVC written on the
+// source node is not guaranteed to attach on the target node.
+func (mc *MigrationController) SetCheckpointRegistry(registry, namespace, secret string) {
+	mc.checkpointRegistry = registry
+	mc.checkpointNamespace = namespace
+	mc.checkpointRegistrySecret = secret
+}
+
+// checkpointContainers saves the process state of the containers being migrated with the kubelet
+// checkpoint API and builds checkpoint images the target node restores them from.
+// Any failure falls back to the restart-based migration instead of failing the migration.
+func (mc *MigrationController) checkpointContainers(job *MigrationJob) {
+	ctx := job.ctx
+	namespace, podName := job.Request.PodNamespace, job.Request.PodName
+	mc.updateJobDetails(job, func(d *types.MigrationDetails) { d.CheckpointMode = types.CheckpointModeRestart })
+	if mc.checkpointRegistry == "" {
+		// 복원할 이미지 레지스트리가 없으면 체크포인트는 쓰이지 않으므로 메모리를 덤프하지 않음
+		mc.setCheckpointCondition(job, "False", "RegistryNotConfigured",
+			"CHECKPOINT_REGISTRY is not set; containers restart on the target node")
+		return
+	}
+
+	sourceNode := job.Request.SourceNode
+	if job.originalPod != nil && job.originalPod.Spec.NodeName != "" {
+		sourceNode = job.originalPod.Spec.NodeName
+	}
+
+	// 1. kubelet checkpoint API로 실행 중인 컨테이너 체크포인트
+	archives := make(map[string]string)
+	for _, state := range job.Details.ContainerStates {
+		if !state.ShouldMigrate || state.State != "running" {
+			continue
+		}
+
+		archive, err := mc.k8sClient.CheckpointContainer(ctx, sourceNode, namespace, podName, state.Name)
+		if err != nil {
+			if errors.Is(err, k8s.ErrCheckpointUnsupported) {
+				log.Printf("Migration %s: Checkpoint not supported on node %s, falling back to restart-based migration", job.ID, sourceNode)
+				mc.setCheckpointCondition(job, "False", "CheckpointUnsupported",
+					fmt.Sprintf("node %s cannot checkpoint containers; containers restart on the target node", sourceNode))
+			} else {
+				log.Printf("Migration %s: Warning: Checkpoint of container %s failed, falling back to restart-based migration: %v", job.ID, state.Name, err)
+				mc.setCheckpointCondition(job, "False", "CheckpointFailed",
+					fmt.Sprintf("checkpoint of container %s failed: %v", state.Name, err))
+			}
+			return
+		}
+		archives[state.Name] = archive
+		log.Printf("Migration %s: Checkpointed container %s (%s)", job.ID, state.Name, archive)
+	}
+	if len(archives) == 0 {
+		mc.setCheckpointCondition(job, "False", "NoRunningContainers", "no running container to checkpoint")
+		return
+	}
+
+	// 2. 오케스트레이터 네임스페이스의 헬퍼 Pod로 체크포인트 이미지 빌드/푸시 후 노드의 아카이브 삭제
+	images := make(map[string]string, len(archives))
+	for name := range archives {
+		images[name] = fmt.Sprintf("%s/%s-%s:%s", mc.checkpointRegistry, podName, name, job.ID)
+	}
+
+	transferCtx, cancel := context.WithTimeout(ctx, checkpointTransferTimeout)
+	defer cancel()
+	err := mc.k8sClient.RunCheckpointTransfer(transferCtx, &types.CheckpointTransfer{
+		Namespace:      mc.checkpointNamespace,
+		PodName:        fmt.Sprintf("%s-checkpoint", job.ID),
+		NodeName:       sourceNode,
+		Image:          checkpointBuilderImage,
+		CleanupImage:   checkpointCleanupImage,
+		Archives:       archives,
+		Images:         images,
+		RegistrySecret: mc.checkpointRegistrySecret,
+	})
+	if err != nil {
+		log.Printf("Migration %s: Warning: Checkpoint transfer failed, falling back to restart-based migration: %v", job.ID, err)
+		mc.setCheckpointCondition(job, "False", "TransferFailed", fmt.Sprintf("checkpoint images could not be pushed: %v", err))
+		return
+	}
+
+	// 복원 Pod가 비공개 레지스트리에서 이미지를 받을 수 있도록 pull secret을 워크로드 네임스페이스로 복사
+	if mc.checkpointRegistrySecret != "" {
+		if err := mc.k8sClient.CopyImagePullSecret(ctx, mc.checkpointNamespace, namespace, mc.checkpointRegistrySecret); err != nil {
+			log.Printf("Migration %s: Warning: Checkpoint pull secret unavailable, falling back to restart-based migration: %v", job.ID, err)
+			mc.setCheckpointCondition(job, "False", "PullSecretUnavailable", err.Error())
+			return
+		}
+	}
+
+	// 3. 대상 노드에서 체크포인트 이미지로 복원
+	mc.updateJobDetails(job, func(d *types.MigrationDetails) {
+		d.CheckpointImages = images
+		d.CheckpointMode = types.CheckpointModeCRIU
+	})
+	mc.setCheckpointCondition(job, "True", "CheckpointImagesPushed",
+		fmt.Sprintf("%d containers are restored from checkpoint images", len(images)))
+	log.Printf("Migration %s: %d containers will be restored from checkpoint images", job.ID, len(images))
+}
+
+// setCheckpointCondition records on the job whether its containers were checkpointed
+func (mc *MigrationController) setCheckpointCondition(job *MigrationJob, status, reason, message string) {
+	mc.updateJobDetails(job, func(d *types.MigrationDetails) {
+		d.Conditions = types.SetCondition(d.Conditions, types.Condition{
+			Type:    types.ConditionCheckpointed,
+			Status:  status,
+			Reason:  reason,
+			Message: message,
+		})
+	})
+}
+
+// restorePullSecret returns the imagePullSecret restored pods need for their checkpoint images
+func (mc *MigrationController) restorePullSecret(job *MigrationJob) string {
+	if len(job.Details.CheckpointImages) == 0 {
+		return ""
+	}
+	return mc.checkpointRegistrySecret
+}
@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ai-storage-orchestrator/pkg/k8s"
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newRollbackTestMigration returns a fake cluster with a running bare pod on node-a and a
+// migration controller working on it
+func newRollbackTestMigration(t *testing.T) (*fake.Clientset, *MigrationController) {
+	source := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "trainer", Namespace: "default", Labels: map[string]string{"app": "trainer"}},
+		Spec: corev1.PodSpec{
+			NodeName:   "node-a",
+			Containers: []corev1.Container{{Name: "main", Image: "trainer:v1"}},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "main",
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}},
+		},
+	}
+	clientset := fake.NewSimpleClientset(source)
+	client := k8s.NewClientForClientset(clientset)
+	t.Cleanup(client.EventRecorder().Shutdown)
+	return clientset, NewMigrationController(client)
+}
+
+// runMigration starts a checkpointed migration of the test pod to node-b and waits for its outcome
+func runMigration(t *testing.T, mc *MigrationController) *types.MigrationResponse {
+	started, err := mc.StartMigration(&types.MigrationRequest{
+		PodName:      "trainer",
+		PodNamespace: "default",
+		SourceNode:   "node-a",
+		TargetNode:   "node-b",
+		PreservePV:   true,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	resp, err := mc.WaitForMigration(ctx, started.MigrationID)
+	require.NoError(t, err)
+	return resp
+}
+
+// readyTargetPods makes the migrated pod report ready as soon as it is watched
+func readyTargetPods(clientset *fake.Clientset) {
+	clientset.PrependWatchReactor("pods", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		w := watch.NewFakeWithChanSize(1, false)
+		w.Modify(&corev1.Pod{Status: corev1.PodStatus{Conditions: []corev1.PodCondition{{
+			Type: corev1.PodReady, Status: corev1.ConditionTrue,
+		}}}})
+		return true, w, nil
+	})
+}
+
+// assertRolledBack checks that only the source pod is left: the target pod and checkpoint PVC are gone
+func assertRolledBack(t *testing.T, clientset *fake.Clientset, resp *types.MigrationResponse) {
+	assert.True(t, resp.Details.RolledBack)
+
+	pods, err := clientset.CoreV1().Pods("default").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	assert.Equal(t, "trainer", pods.Items[0].Name)
+	assert.Equal(t, "node-a", pods.Items[0].Spec.NodeName)
+	assert.Equal(t, "trainer:v1", pods.Items[0].Spec.Containers[0].Image)
+
+	require.NotEmpty(t, resp.Details.PVClaimName, "the migration should have created a checkpoint PVC")
+	_, err = clientset.CoreV1().PersistentVolumeClaims("default").Get(context.Background(), resp.Details.PVClaimName, metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err), "checkpoint PVC should be deleted, got %v", err)
+}
+
+// TestMigrationRollbackAfterPodCreationFailure tests that a migration whose target pod cannot be
+// created keeps the source pod and removes its checkpoint PVC
+func TestMigrationRollbackAfterPodCreationFailure(t *testing.T) {
+	clientset, mc := newRollbackTestMigration(t)
+	clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("admission webhook denied the pod")
+	})
+
+	resp := runMigration(t, mc)
+	assert.Equal(t, types.MigrationStatusFailed, resp.Status)
+	assert.Contains(t, resp.Details.ErrorMessage, "admission webhook denied the pod")
+	assert.Empty(t, resp.Details.NewPodName)
+	assertRolledBack(t, clientset, resp)
+}
+
+// TestMigrationRollbackAfterFailedCutover tests the rollback once the target pod is ready and the
+// source pod is being replaced: the target pod and checkpoint are removed and the source pod is
+// kept, or recreated from its snapshot when it was already deleted
+func TestMigrationRollbackAfterFailedCutover(t *testing.T) {
+	tests := []struct {
+		name         string
+		deleteSource bool // whether the source pod is deleted before the cutover fails
+	}{
+		{name: "source pod deletion fails", deleteSource: false},
+		{name: "cancelled after the source pod was deleted", deleteSource: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientset, mc := newRollbackTestMigration(t)
+			readyTargetPods(clientset)
+			clientset.PrependReactor("delete", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if action.(k8stesting.DeleteAction).GetName() != "trainer" {
+					return false, nil, nil
+				}
+				// The migration is cancelled while the source pod is being replaced
+				for _, m := range mc.ListMigrations() {
+					require.NoError(t, mc.CancelMigration(m.MigrationID))
+				}
+				if tt.deleteSource {
+					return false, nil, nil
+				}
+				return true, nil, errors.New("etcd leader changed")
+			})
+
+			resp := runMigration(t, mc)
+			assert.Equal(t, types.MigrationStatusCancelled, resp.Status)
+			assert.NotEmpty(t, resp.Details.NewPodName, "the target pod should have been created")
+			assertRolledBack(t, clientset, resp)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCancelMigration tests that only pending/running migrations can be cancelled
+func TestCancelMigration(t *testing.T) {
+	mc := NewMigrationController(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mc.migrations["migration-running"] = &MigrationJob{
+		ID:      "migration-running",
+		Request: &types.MigrationRequest{PodName: "trainer", PodNamespace: "default"},
+		Status:  types.MigrationStatusRunning,
+		Details: &types.MigrationDetails{},
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	mc.migrations["migration-done"] = &MigrationJob{
+		ID:      "migration-done",
+		Status:  types.MigrationStatusCompleted,
+		Details: &types.MigrationDetails{},
+	}
+
+	assert.Error(t, mc.CancelMigration("migration-missing"))
+	assert.Error(t, mc.CancelMigration("migration-done"))
+
+	require.NoError(t, mc.CancelMigration("migration-running"))
+	assert.True(t, mc.isCancelRequested(mc.migrations["migration-running"]))
+	assert.Error(t, ctx.Err(), "job context should be cancelled")
+}
+
+// TestWaitForMigration tests that waiting returns the terminal status or gives up with the context
+func TestWaitForMigration(t *testing.T) {
+	mc := NewMigrationController(nil)
+	job := &MigrationJob{
+		ID:        "migration-running",
+		Request:   &types.MigrationRequest{PodName: "trainer", PodNamespace: "default"},
+		Status:    types.MigrationStatusRunning,
+		Details:   &types.MigrationDetails{},
+		StartTime: time.Now(),
+	}
+	mc.migrations[job.ID] = job
+
+	_, err := mc.WaitForMigration(context.Background(), "migration-missing")
+	assert.Error(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	resp, err := mc.WaitForMigration(ctx, job.ID)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, types.MigrationStatusRunning, resp.Status)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		mc.completeMigration(job)
+	}()
+	resp, err = mc.WaitForMigration(context.Background(), job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.MigrationStatusCompleted, resp.Status)
+}
+
+// TestListMigrationsReturnsCopies tests that the listed details do not share memory with the running job
+func TestListMigrationsReturnsCopies(t *testing.T) {
+	mc := NewMigrationController(nil)
+	job := &MigrationJob{
+		ID:      "migration-running",
+		Request: &types.MigrationRequest{PodName: "trainer", PodNamespace: "default"},
+		Status:  types.MigrationStatusRunning,
+		Details: &types.MigrationDetails{
+			OriginalResources: &types.ResourceUsage{CPUUsage: 2},
+			CheckpointImages:  map[string]string{"trainer": "registry/trainer:ckpt"},
+		},
+	}
+	mc.migrations[job.ID] = job
+
+	listed := mc.ListMigrations()
+	require.Len(t, listed, 1)
+	mc.updateJobDetails(job, func(d *types.MigrationDetails) {
+		d.NewPodName = "trainer-migrated"
+		d.OriginalResources.CPUUsage = 1
+		d.CheckpointImages["trainer"] = "registry/trainer:other"
+	})
+
+	details := listed[0].Details
+	assert.Empty(t, details.NewPodName)
+	assert.Equal(t, 2.0, details.OriginalResources.CPUUsage)
+	assert.Equal(t, "registry/trainer:ckpt", details.CheckpointImages["trainer"])
+}
+
+// TestCheckpointWithoutRegistryReportsCondition tests that skipping the checkpoint is reported on the migration
+func TestCheckpointWithoutRegistryReportsCondition(t *testing.T) {
+	mc := NewMigrationController(nil)
+	job := &MigrationJob{
+		ID:      "migration-1234",
+		Request: &types.MigrationRequest{PodName: "trainer", PodNamespace: "ai-workloads", PreservePV: true},
+		Details: &types.MigrationDetails{
+			ContainerStates: []types.ContainerState{{Name: "trainer", State: "running", ShouldMigrate: true}},
+		},
+		ctx: context.Background(),
+	}
+
+	mc.checkpointContainers(job)
+
+	assert.Equal(t, types.CheckpointModeRestart, job.Details.CheckpointMode)
+	require.Len(t, job.Details.Conditions, 1)
+	assert.Equal(t, types.ConditionCheckpointed, job.Details.Conditions[0].Type)
+	assert.Equal(t, "False", job.Details.Conditions[0].Status)
+	assert.Equal(t, "RegistryNotConfigured", job.Details.Conditions[0].Reason)
+	assert.Empty(t, mc.restorePullSecret(job))
+}
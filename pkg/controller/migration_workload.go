@@ -0,0 +1,193 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"ai-storage-orchestrator/pkg/k8s"
+	"ai-storage-orchestrator/pkg/types"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// workloadMigrationTimeout bounds a Deployment rollout or StatefulSet pod replacement
+const workloadMigrationTimeout = 5 * time.Minute
+
+// resolveWorkload records which controller owns the pod and checks that its volumes can follow it
+// Bare-pod recreation would orphan a controller-owned pod while its controller spawns a replacement
+func (mc *MigrationController) resolveWorkload(job *MigrationJob) error {
+	ctx := job.ctx
+
+	kind, name, err := mc.k8sClient.GetPodOwner(ctx, job.originalPod)
+	if err != nil {
+		return fmt.Errorf("Failed to resolve owning workload: %v", err)
+	}
+	mc.updateJobDetails(job, func(d *types.MigrationDetails) {
+		d.WorkloadKind = kind
+		d.WorkloadName = name
+	})
+
+	if err := mc.k8sClient.CheckPodVolumesReachable(ctx, job.originalPod, job.Request.TargetNode); err != nil {
+		return fmt.Errorf("Volumes cannot follow pod to %s: %v", job.Request.TargetNode, err)
+	}
+
+	if kind != "" {
+		log.Printf("Migration %s: Pod is owned by %s %s, migrating through the controller", job.ID, kind, name)
+		if job.Request.PreservePV {
+			log.Printf("Migration %s: Checkpoint PVC skipped, %s pods keep their own volumes", job.ID, kind)
+		}
+	}
+	return nil
+}
+
+// migrateDeployment pins the Deployment's pod template to the target node and lets the rollout
+// replace its pods there
+func (mc *MigrationController) migrateDeployment(job *MigrationJob) error {
+	ctx := job.ctx
+	namespace, name := job.Request.PodNamespace, job.Details.WorkloadName
+
+	// Step 2: Node affinity patch
+	previous, err := mc.k8sClient.PinWorkloadToNode(ctx, namespace, "Deployment", name, job.Request.TargetNode)
+	if err != nil {
+		return fmt.Errorf("Failed to pin deployment to target node: %v", err)
+	}
+	job.previousNodeAffinity = previous
+	job.workloadPinned = true
+	log.Printf("Migration %s: Pinned deployment %s to node %s", job.ID, name, job.Request.TargetNode)
+	mc.publishStep(job, "pin_workload", fmt.Sprintf("Pinned deployment %s to node %s", name, job.Request.TargetNode))
+	mc.k8sClient.RecordEvent("Deployment", namespace, name, corev1.EventTypeNormal, k8s.EventReasonMigrating,
+		fmt.Sprintf("Pinned to node %s to move pod %s (migration %s)", job.Request.TargetNode, job.Request.PodName, job.ID))
+
+	// Step 3: Rolling replace
+	if err := mc.k8sClient.WaitForDeploymentRollout(ctx, namespace, name, workloadMigrationTimeout); err != nil {
+		return fmt.Errorf("Deployment rollout did not complete: %v", err)
+	}
+
+	// Step 4: Find the replacement pod on the target node
+	newPodName, err := mc.k8sClient.FindWorkloadPodOnNode(ctx, namespace, "Deployment", name, job.Request.TargetNode)
+	if err != nil {
+		return fmt.Errorf("Failed to find migrated pod: %v", err)
+	}
+	mc.updateJobDetails(job, func(d *types.MigrationDetails) { d.NewPodName = newPodName })
+	job.originalDeleted = true
+
+	log.Printf("Migration %s: Deployment %s rolled out to node %s (pod %s)", job.ID, name, job.Request.TargetNode, newPodName)
+	mc.publishStep(job, "rollout", fmt.Sprintf("Deployment %s rolled out to node %s (pod %s)", name, job.Request.TargetNode, newPodName))
+	return nil
+}
+
+// migrateStatefulSet moves a single StatefulSet pod: the template is pinned under the OnDelete strategy,
+// the pod is deleted and its PVCs are handed off to the replacement the controller creates on the target node
+func (mc *MigrationController) migrateStatefulSet(job *MigrationJob) error {
+	ctx := job.ctx
+	namespace, name := job.Request.PodNamespace, job.Details.WorkloadName
+	podName := job.Request.PodName
+
+	// Step 2: Stop the StatefulSet from rolling other pods, then pin the template
+	previousStrategy, err := mc.k8sClient.SetStatefulSetUpdateStrategy(ctx, namespace, name,
+		appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType})
+	if err != nil {
+		return fmt.Errorf("Failed to switch statefulset to OnDelete: %v", err)
+	}
+	job.previousUpdateStrategy = &previousStrategy
+
+	// 성공/실패와 관계없이 템플릿과 업데이트 전략을 원래대로 복구 (실패한 복구는 롤백에서 재시도)
+	defer func() {
+		for _, err := range mc.restoreStatefulSet(ctx, job) {
+			log.Printf("Warning: Migration %s: %v", job.ID, err)
+		}
+	}()
+
+	previous, err := mc.k8sClient.PinWorkloadToNode(ctx, namespace, "StatefulSet", name, job.Request.TargetNode)
+	if err != nil {
+		return fmt.Errorf("Failed to pin statefulset to target node: %v", err)
+	}
+	job.previousNodeAffinity = previous
+	job.workloadPinned = true
+	mc.k8sClient.RecordEvent("StatefulSet", namespace, name, corev1.EventTypeNormal, k8s.EventReasonMigrating,
+		fmt.Sprintf("Pinned to node %s with OnDelete updates to move pod %s (migration %s)", job.Request.TargetNode, podName, job.ID))
+	mc.publishStep(job, "pin_workload", fmt.Sprintf("Pinned statefulset %s to node %s", name, job.Request.TargetNode))
+
+	// Step 3: Ordered delete - the old pod must release its PVCs before the replacement attaches them
+	if err := mc.deleteOriginalPod(job); err != nil {
+		return err
+	}
+
+	// Step 4: Wait for the StatefulSet to recreate the pod on the target node
+	node, err := mc.k8sClient.WaitForPodReplaced(ctx, namespace, podName, job.originalPod.UID, workloadMigrationTimeout)
+	if err != nil {
+		return fmt.Errorf("StatefulSet pod was not replaced: %v", err)
+	}
+	if node != job.Request.TargetNode {
+		return fmt.Errorf("StatefulSet pod was recreated on %s instead of %s", node, job.Request.TargetNode)
+	}
+	mc.updateJobDetails(job, func(d *types.MigrationDetails) { d.NewPodName = podName })
+	mc.publishStep(job, "replace_pod", fmt.Sprintf("StatefulSet recreated pod %s on node %s", podName, node))
+
+	log.Printf("Migration %s: StatefulSet pod %s moved to node %s", job.ID, podName, job.Request.TargetNode)
+	return nil
+}
+
+// restoreStatefulSet unpins the StatefulSet template and restores the update strategy saved before
+// the migration; settings already restored are skipped so it can run again from the rollback
+func (mc *MigrationController) restoreStatefulSet(ctx context.Context, job *MigrationJob) []error {
+	var errs []error
+	namespace, name := job.Request.PodNamespace, job.Details.WorkloadName
+
+	if job.workloadPinned {
+		if _, err := mc.k8sClient.SetWorkloadNodeAffinity(ctx, namespace, "StatefulSet", name, job.previousNodeAffinity); err != nil {
+			errs = append(errs, fmt.Errorf("failed to unpin statefulset %s: %w", name, err))
+		} else {
+			job.workloadPinned = false
+			log.Printf("Migration %s: Restored node affinity of statefulset %s", job.ID, name)
+		}
+	}
+
+	if job.previousUpdateStrategy != nil {
+		if _, err := mc.k8sClient.SetStatefulSetUpdateStrategy(ctx, namespace, name, *job.previousUpdateStrategy); err != nil {
+			errs = append(errs, fmt.Errorf("failed to restore update strategy of statefulset %s: %w", name, err))
+		} else {
+			job.previousUpdateStrategy = nil
+			log.Printf("Migration %s: Restored update strategy of statefulset %s", job.ID, name)
+		}
+	}
+
+	return errs
+}
+
+// rollbackWorkload restores the Deployment/StatefulSet settings changed by a migration
+func (mc *MigrationController) rollbackWorkload(ctx context.Context, job *MigrationJob) []error {
+	namespace, name := job.Request.PodNamespace, job.Details.WorkloadName
+	kind := job.Details.WorkloadKind
+
+	if kind != "StatefulSet" {
+		// 노드 고정 해제 (Deployment는 이 변경으로 원래 배치로 롤아웃됨)
+		if !job.workloadPinned {
+			return nil
+		}
+		if _, err := mc.k8sClient.SetWorkloadNodeAffinity(ctx, namespace, kind, name, job.previousNodeAffinity); err != nil {
+			return []error{err}
+		}
+		job.workloadPinned = false
+		log.Printf("Migration %s: Rollback restored node affinity of %s %s", job.ID, kind, name)
+		return nil
+	}
+
+	// 1. 노드 고정과 업데이트 전략 복구 (migrateStatefulSet에서 실패한 경우 재시도)
+	errs := mc.restoreStatefulSet(ctx, job)
+
+	// 2. 이미 재생성된 Pod는 원래 템플릿으로 다시 생성되도록 삭제
+	if job.originalDeleted {
+		if err := mc.k8sClient.DeletePod(ctx, namespace, job.Request.PodName); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to recycle statefulset pod %s: %w", job.Request.PodName, err))
+		} else {
+			log.Printf("Migration %s: Rollback recycled statefulset pod %s", job.ID, job.Request.PodName)
+		}
+	}
+
+	return errs
+}
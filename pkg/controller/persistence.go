@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"log"
+	"time"
+
+	"ai-storage-orchestrator/pkg/store"
+	"ai-storage-orchestrator/pkg/types"
+)
+
+// interruptedMessage is recorded on jobs that were in flight when the orchestrator stopped
+const interruptedMessage = "interrupted by orchestrator restart"
+
+// Persisted forms of the controller jobs.
+// Runtime-only state (contexts, stabilization history) is intentionally omitted.
+
+type migrationRecord struct {
+	ID        string                  `json:"id"`
+	Request   *types.MigrationRequest `json:"request"`
+	Status    types.MigrationStatus   `json:"status"`
+	Details   *types.MigrationDetails `json:"details"`
+	StartTime time.Time               `json:"start_time"`
+}
+
+type autoscalingRecord struct {
+	ID        string                    `json:"id"`
+	Request   *types.AutoscalingRequest `json:"request"`
+	Status    types.AutoscalingStatus   `json:"status"`
+	Details   *types.AutoscalingDetails `json:"details"`
+	CreatedAt time.Time                 `json:"created_at"`
+}
+
+type loadbalancingRecord struct {
+	ID        string                      `json:"id"`
+	Request   *types.LoadbalancingRequest `json:"request"`
+	Status    types.LoadbalancingStatus   `json:"status"`
+	Details   *types.LoadbalancingDetails `json:"details"`
+	CreatedAt time.Time                   `json:"created_at"`
+}
+
+type provisioningRecord struct {
+	ID        string                     `json:"id"`
+	Request   *types.ProvisioningRequest `json:"request"`
+	Status    types.ProvisioningStatus   `json:"status"`
+	Details   *types.ProvisioningDetails `json:"details"`
+	CreatedAt time.Time                  `json:"created_at"`
+}
+
+type preemptionRecord struct {
+	ID        string                   `json:"id"`
+	Request   *types.PreemptionRequest `json:"request"`
+	Status    types.PreemptionStatus   `json:"status"`
+	Details   *types.PreemptionDetails `json:"details"`
+	CreatedAt time.Time                `json:"created_at"`
+}
+
+type cacheRecord struct {
+	ID        string                `json:"id"`
+	Request   *types.CachingRequest `json:"request"`
+	Status    types.CachingStatus   `json:"status"`
+	Details   *types.CacheDetails   `json:"details"`
+	CreatedAt time.Time             `json:"created_at"`
+}
+
+type webhookRecord struct {
+	ID        string                `json:"id"`
+	Request   *types.WebhookRequest `json:"request"`
+	Stats     types.WebhookStats    `json:"stats"`
+	CreatedAt time.Time             `json:"created_at"`
+	UpdatedAt *time.Time            `json:"updated_at,omitempty"`
+}
+
+// saveRecord writes a record to the job store, logging (not failing) on error
+// so that a broken store never blocks the control loops
+func saveRecord(s store.JobStore, kind, id string, record interface{}) {
+	if err := s.Save(kind, id, record); err != nil {
+		log.Printf("Warning: Failed to persist %s/%s: %v", kind, id, err)
+	}
+}
+
+// deleteRecord removes a record from the job store, logging on error
+func deleteRecord(s store.JobStore, kind, id string) {
+	if err := s.Delete(kind, id); err != nil {
+		log.Printf("Warning: Failed to delete persisted %s/%s: %v", kind, id, err)
+	}
+}
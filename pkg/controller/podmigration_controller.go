@@ -0,0 +1,125 @@
+// Package controller implements the PodMigration CRD controller
+// kubectl apply로 선언한 PodMigration을 MigrationController 작업으로 실행
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	apollov1 "ai-storage-orchestrator/api/v1"
+	"ai-storage-orchestrator/pkg/types"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PodMigrationReconciler reconciles a PodMigration object
+type PodMigrationReconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	Migrations *MigrationController
+
+	started *jobTracker
+}
+
+// NewPodMigrationReconciler creates a new reconciler
+func NewPodMigrationReconciler(client client.Client, scheme *runtime.Scheme, migrations *MigrationController) *PodMigrationReconciler {
+	return &PodMigrationReconciler{
+		Client:     client,
+		Scheme:     scheme,
+		Migrations: migrations,
+		started:    newJobTracker(),
+	}
+}
+
+// +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=podmigrations,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=podmigrations/status,verbs=get;update;patch
+
+// Reconcile starts the migration described by a PodMigration and mirrors its progress
+func (r *PodMigrationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var pm apollov1.PodMigration
+	if err := r.Get(ctx, req.NamespacedName, &pm); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// 이미 종료된 마이그레이션은 다시 실행하지 않음 (1회성 작업)
+	if pm.Status.CompletionTime != nil {
+		r.started.forget(pm.UID)
+		return ctrl.Result{}, nil
+	}
+
+	// 1. 마이그레이션 시작
+	if pm.Status.JobID == "" {
+		jobID := r.started.get(pm.UID)
+		if jobID == "" {
+			resp, err := r.Migrations.StartMigration(&types.MigrationRequest{
+				PodName:      pm.Spec.PodName,
+				PodNamespace: pm.Namespace,
+				SourceNode:   pm.Spec.SourceNode,
+				TargetNode:   pm.Spec.TargetNode,
+				PreservePV:   pm.Spec.PreservePV,
+				ForceRestart: pm.Spec.ForceRestart,
+				Timeout:      int(pm.Spec.TimeoutSeconds),
+			})
+			if err != nil {
+				setJobStatus(&pm.Status.JobStatus, pm.Generation, string(types.MigrationStatusFailed), err.Error(), false, true)
+				return ctrl.Result{}, r.Status().Update(ctx, &pm)
+			}
+			jobID = resp.MigrationID
+			r.started.set(pm.UID, jobID)
+			log.Printf("[PodMigration] %s/%s: 마이그레이션 시작 (%s, %s → %s)",
+				pm.Namespace, pm.Name, jobID, pm.Spec.SourceNode, pm.Spec.TargetNode)
+		}
+		startJobStatus(&pm.Status.JobStatus, jobID, pm.Generation)
+	}
+
+	// 2. 작업 상태 반영
+	finished := r.mirrorStatus(&pm)
+	if err := r.Status().Update(ctx, &pm); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.started.forget(pm.UID)
+
+	if finished {
+		log.Printf("[PodMigration] %s/%s: %s", pm.Namespace, pm.Name, pm.Status.Phase)
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: jobRequeueInterval}, nil
+}
+
+// mirrorStatus copies the migration job state into the PodMigration status
+// and reports whether the migration reached a terminal state
+func (r *PodMigrationReconciler) mirrorStatus(pm *apollov1.PodMigration) bool {
+	resp, err := r.Migrations.GetMigrationStatus(pm.Status.JobID)
+	if err != nil {
+		setJobStatus(&pm.Status.JobStatus, pm.Generation, string(types.MigrationStatusFailed),
+			fmt.Sprintf("%s: %s", jobLostMessage, pm.Status.JobID), false, true)
+		return true
+	}
+
+	message := resp.Message
+	if resp.Details != nil {
+		pm.Status.NewPodName = resp.Details.NewPodName
+		pm.Status.CheckpointPVC = resp.Details.PVClaimName
+		if resp.Details.ErrorMessage != "" {
+			message = resp.Details.ErrorMessage
+		}
+	}
+
+	succeeded := resp.Status == types.MigrationStatusCompleted
+	finished := succeeded ||
+		resp.Status == types.MigrationStatusFailed ||
+		resp.Status == types.MigrationStatusCancelled
+	setJobStatus(&pm.Status.JobStatus, pm.Generation, string(resp.Status), message, succeeded, finished)
+	return finished
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *PodMigrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&apollov1.PodMigration{}, builder.WithPredicates(jobObjectPredicate())).
+		Complete(r)
+}
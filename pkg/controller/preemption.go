@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,9 +12,14 @@ import (
 	"sync"
 	"time"
 
+	"ai-storage-orchestrator/pkg/audit"
+	"ai-storage-orchestrator/pkg/k8s"
+	"ai-storage-orchestrator/pkg/eventbus"
+	"ai-storage-orchestrator/pkg/store"
 	"ai-storage-orchestrator/pkg/types"
 
 	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
 )
 
 // PreemptionController manages pod preemption operations
@@ -22,6 +28,9 @@ type PreemptionController struct {
 	jobs      map[string]*PreemptionJob
 	jobsMux   sync.RWMutex
 	metrics   *types.PreemptionMetrics
+	jobStore  store.JobStore
+	auditLog  audit.Recorder
+	events    *eventbus.Bus
 }
 
 // PreemptionJob represents an active preemption job
@@ -50,9 +59,90 @@ func NewPreemptionController(k8sClient K8sClientInterface) *PreemptionController
 			TotalMemoryFreed:      "0Mi",
 			TotalGPUFreed:         0,
 		},
+		jobStore: store.NewNopStore(),
 	}
 }
 
+// SetJobStore configures the store that preemption jobs are written through
+func (pc *PreemptionController) SetJobStore(s store.JobStore) {
+	pc.jobStore = s
+}
+
+// SetAuditLog records every pod eviction in the audit trail
+func (pc *PreemptionController) SetAuditLog(recorder audit.Recorder) {
+	pc.auditLog = recorder
+}
+
+// SetEventBus publishes status transitions and eviction outcomes of every preemption job
+func (pc *PreemptionController) SetEventBus(bus *eventbus.Bus) {
+	pc.events = bus
+}
+
+// RestoreJobs reloads persisted preemption jobs. Jobs that were still running are
+// marked failed rather than re-run, because some pods may already have been evicted.
+func (pc *PreemptionController) RestoreJobs() error {
+	restored := 0
+	err := pc.jobStore.ForEach(store.KindPreemption, func(id string, data []byte) error {
+		var rec preemptionRecord
+		if err := json.Unmarshal(data, &rec); err != nil {
+			log.Printf("Warning: Skipping corrupt preemption record %s: %v", id, err)
+			return nil
+		}
+		if rec.Request == nil {
+			log.Printf("Warning: Skipping preemption record %s without a request", id)
+			return nil
+		}
+
+		job := &PreemptionJob{
+			ID:        rec.ID,
+			Request:   rec.Request,
+			Status:    rec.Status,
+			Details:   rec.Details,
+			CreatedAt: rec.CreatedAt,
+		}
+		job.ctx, job.cancel = context.WithCancel(context.Background())
+		job.cancel()
+		if job.Details == nil {
+			job.Details = &types.PreemptionDetails{CreatedAt: rec.CreatedAt}
+		}
+
+		pc.jobsMux.Lock()
+		pc.jobs[job.ID] = job
+		pc.metrics.TotalPreemptionJobs++
+		pc.metrics.TotalPodsPreempted += job.Details.SuccessfulPreemptions
+		pc.metrics.SuccessfulPreemptions += job.Details.SuccessfulPreemptions
+		pc.metrics.FailedPreemptions += job.Details.FailedPreemptions
+		pc.jobsMux.Unlock()
+
+		if job.Status != types.PreemptionStatusCompleted && job.Status != types.PreemptionStatusFailed {
+			pc.failJob(job, interruptedMessage)
+			pc.persistJob(job)
+		}
+		restored++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore preemption jobs: %w", err)
+	}
+
+	log.Printf("Restored %d preemption jobs", restored)
+	return nil
+}
+
+// persistJob writes the current state of a preemption job to the job store
+func (pc *PreemptionController) persistJob(job *PreemptionJob) {
+	pc.jobsMux.RLock()
+	defer pc.jobsMux.RUnlock()
+
+	saveRecord(pc.jobStore, store.KindPreemption, job.ID, &preemptionRecord{
+		ID:        job.ID,
+		Request:   job.Request,
+		Status:    job.Status,
+		Details:   job.Details,
+		CreatedAt: job.CreatedAt,
+	})
+}
+
 // StartPreemption initiates a new preemption operation
 func (pc *PreemptionController) StartPreemption(req *types.PreemptionRequest) (*types.PreemptionResponse, error) {
 	// Validate request
@@ -90,6 +180,7 @@ func (pc *PreemptionController) StartPreemption(req *types.PreemptionRequest) (*
 	pc.metrics.TotalPreemptionJobs++
 	pc.metrics.ActivePreemptionJobs++
 	pc.jobsMux.Unlock()
+	pc.persistJob(job)
 
 	// Start preemption goroutine
 	go pc.runPreemption(job)
@@ -179,7 +270,13 @@ func (pc *PreemptionController) runPreemption(job *PreemptionJob) {
 		pc.metrics.ActivePreemptionJobs--
 		now := time.Now()
 		pc.metrics.LastPreemptionTime = &now
+		status, message := job.Status, job.Details.ErrorMessage
+		if message == "" {
+			message = pc.getStatusMessage(status)
+		}
 		pc.jobsMux.Unlock()
+		pc.persistJob(job)
+		pc.publishStatus(job, status, message)
 	}()
 
 	// Phase 1: Analyze node state
@@ -208,7 +305,11 @@ func (pc *PreemptionController) runPreemption(job *PreemptionJob) {
 	pc.jobsMux.Unlock()
 
 	// Phase 3: Select pods to preempt based on strategy
-	selectedPods := pc.selectPodsToPreempt(job, candidates)
+	selectedPods, err := pc.selectPodsToPreempt(job, candidates)
+	if err != nil {
+		pc.failJob(job, fmt.Sprintf("preemption skipped: %v", err))
+		return
+	}
 
 	if len(selectedPods) == 0 {
 		log.Printf("Preemption job %s: No suitable candidates found for preemption", job.ID)
@@ -253,7 +354,7 @@ func (pc *PreemptionController) analyzeNodeState(job *PreemptionJob) (*types.Nod
 	nodeName := job.Request.NodeName
 
 	// Get node metrics
-	cpuPercent, memoryPercent, err := pc.k8sClient.GetNodeMetrics(ctx, nodeName)
+	cpuPercent, memoryPercent, provenance, err := pc.k8sClient.GetNodeMetrics(ctx, nodeName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get node metrics: %w", err)
 	}
@@ -288,6 +389,7 @@ func (pc *PreemptionController) analyzeNodeState(job *PreemptionJob) (*types.Nod
 		MemoryPercent:     memoryPercent,
 		GPUPercent:        gpuPercent,
 		PodCount:          podCount,
+		MetricsProvenance: provenance,
 	}, nil
 }
 
@@ -350,6 +452,7 @@ func (pc *PreemptionController) findPreemptionCandidates(job *PreemptionJob, nod
 				StorageWriteMBps: podInfo.StorageWriteMBps,
 				StorageIOPS:      podInfo.StorageIOPS,
 			},
+			StorageMetricsProvenance: podInfo.StorageMetricsProvenance,
 			CreationTime:     podInfo.CreationTime,
 			Age:              ageStr,
 			PreemptionScore:  score,
@@ -472,7 +575,47 @@ func (pc *PreemptionController) calculatePreemptionScore(strategy string, podInf
 }
 
 // selectPodsToPreempt selects which pods to preempt to meet the target
-func (pc *PreemptionController) selectPodsToPreempt(job *PreemptionJob, candidates []types.PreemptionCandidate) []types.PreemptionCandidate {
+// When candidate storage metrics are not real, storage I/O strategies rank the candidates without storage I/O;
+// storage targets cannot be measured then and are refused with errMetricsNotReal
+func (pc *PreemptionController) selectPodsToPreempt(job *PreemptionJob, candidates []types.PreemptionCandidate) ([]types.PreemptionCandidate, error) {
+	if pc.usesStorageMetrics(job.Request) {
+		provenance := types.ProvenanceReal
+		var notReal []string
+		for _, candidate := range candidates {
+			p := candidate.StorageMetricsProvenance
+			if p == "" {
+				p = types.ProvenanceUnavailable // no storage I/O data at all
+			}
+			if !p.IsReal() {
+				provenance = types.WorstProvenance(provenance, p)
+				notReal = append(notReal, fmt.Sprintf("%s/%s (%s)", candidate.PodNamespace, candidate.PodName, p))
+			}
+		}
+
+		message := "candidate storage metrics are real"
+		if len(notReal) > 0 {
+			message = fmt.Sprintf("candidate storage metrics are not real: %s", strings.Join(notReal, ", "))
+		}
+		storageTarget := isStorageResourceType(job.Request.ResourceType)
+		if !provenance.IsReal() && !storageTarget {
+			// 스토리지 기준만 빼고 순위를 다시 매김
+			fallback := storageFallbackStrategy(job.Request.Strategy)
+			message += fmt.Sprintf("; ranking candidates by %s without storage I/O", fallback)
+			candidates = pc.rankCandidates(candidates, fallback, job.Request.MinPriority)
+		}
+		pc.jobsMux.Lock()
+		job.Details.Conditions = types.SetCondition(job.Details.Conditions, types.MetricsCondition(provenance, message))
+		job.Details.PreemptionCandidates = candidates
+		pc.jobsMux.Unlock()
+
+		if !provenance.IsReal() {
+			log.Printf("Preemption job %s: %s", job.ID, message)
+			if storageTarget {
+				return nil, fmt.Errorf("%w: %s", errMetricsNotReal, message)
+			}
+		}
+	}
+
 	selectedPods := make([]types.PreemptionCandidate, 0)
 	targetAmount := pc.parseResourceAmount(job.Request.TargetAmount, job.Request.ResourceType)
 	accumulatedAmount := int64(0)
@@ -511,7 +654,55 @@ func (pc *PreemptionController) selectPodsToPreempt(job *PreemptionJob, candidat
 		selectedPods = append(selectedPods, *candidate)
 	}
 
-	return selectedPods
+	return selectedPods, nil
+}
+
+// usesStorageMetrics reports whether the request selects or ranks pods by their storage I/O
+func (pc *PreemptionController) usesStorageMetrics(req *types.PreemptionRequest) bool {
+	if isStorageResourceType(req.ResourceType) {
+		return true
+	}
+	return storageFallbackStrategy(req.Strategy) != req.Strategy
+}
+
+// isStorageResourceType reports whether the preemption target is measured in storage I/O
+func isStorageResourceType(resourceType string) bool {
+	return resourceType == "storage" || resourceType == "storage_iops"
+}
+
+// storageFallbackStrategy returns the strategy that ranks like the given one without storage I/O
+func storageFallbackStrategy(strategy string) string {
+	switch types.PreemptionStrategy(strategy) {
+	case types.StrategyStorageIOHeaviest:
+		return string(types.StrategyLowestPriority)
+	case types.StrategyStorageAwareWeighted:
+		return string(types.StrategyWeightedScore)
+	}
+	return strategy
+}
+
+// rankCandidates returns a copy of the candidates scored and sorted by the given strategy
+func (pc *PreemptionController) rankCandidates(candidates []types.PreemptionCandidate, strategy string, minPriority int32) []types.PreemptionCandidate {
+	ranked := make([]types.PreemptionCandidate, len(candidates))
+	copy(ranked, candidates)
+	for i := range ranked {
+		podInfo := &types.PodResourceInfo{
+			PodName:       ranked[i].PodName,
+			PodNamespace:  ranked[i].PodNamespace,
+			PriorityClass: ranked[i].PriorityClass,
+			PriorityValue: ranked[i].PriorityValue,
+			CPURequest:    pc.parseCPU(ranked[i].ResourceRequests.CPU),
+			MemoryRequest: pc.parseMemory(ranked[i].ResourceRequests.Memory),
+			GPURequest:    ranked[i].ResourceRequests.GPU,
+			CreationTime:  ranked[i].CreationTime,
+		}
+		ranked[i].PreemptionScore = pc.calculatePreemptionScore(strategy, podInfo)
+		ranked[i].PreemptionReason = pc.generatePreemptionReason(strategy, podInfo, minPriority)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].PreemptionScore < ranked[j].PreemptionScore
+	})
+	return ranked
 }
 
 // executePreemption executes the actual pod eviction
@@ -544,6 +735,8 @@ func (pc *PreemptionController) executePreemption(job *PreemptionJob, selectedPo
 			result.Status = "failed"
 			result.ErrorMessage = err.Error()
 			log.Printf("Failed to evict pod %s/%s: %v", pod.PodNamespace, pod.PodName, err)
+			pc.k8sClient.RecordEvent("Pod", pod.PodNamespace, pod.PodName, corev1.EventTypeWarning, k8s.EventReasonPreemptionFailed,
+				fmt.Sprintf("Preemption job %s failed to evict pod from node %s: %v", job.ID, job.Request.NodeName, err))
 
 			pc.jobsMux.Lock()
 			job.Details.FailedPreemptions++
@@ -556,6 +749,8 @@ func (pc *PreemptionController) executePreemption(job *PreemptionJob, selectedPo
 				pod.ResourceRequests.StorageReadMBps,
 				pod.ResourceRequests.StorageWriteMBps,
 				pod.ResourceRequests.StorageIOPS)
+			pc.k8sClient.RecordEvent("Pod", pod.PodNamespace, pod.PodName, corev1.EventTypeNormal, k8s.EventReasonPreempted,
+				fmt.Sprintf("Evicted from node %s by preemption job %s: %s", job.Request.NodeName, job.ID, pod.PreemptionReason))
 
 			// Accumulate freed resources
 			totalCPUFreed += pc.parseCPU(pod.ResourceRequests.CPU)
@@ -575,8 +770,25 @@ func (pc *PreemptionController) executePreemption(job *PreemptionJob, selectedPo
 		}
 
 		results = append(results, result)
+
+		reason, message := k8s.EventReasonPreempted, fmt.Sprintf("Evicted pod %s/%s from node %s", pod.PodNamespace, pod.PodName, job.Request.NodeName)
+		if err != nil {
+			reason, message = k8s.EventReasonPreemptionFailed, fmt.Sprintf("Failed to evict pod %s/%s: %v", pod.PodNamespace, pod.PodName, err)
+		}
+		pc.events.Publish(eventbus.Event{
+			Kind:      eventbus.KindPreemption,
+			Type:      eventbus.TypeOutcome,
+			JobID:     job.ID,
+			Namespace: pod.PodNamespace,
+			Status:    string(types.PreemptionStatusExecuting),
+			Reason:    reason,
+			Message:   message,
+			Data:      result,
+		})
 	}
 
+	pc.auditEvictions(job, results)
+
 	// Update freed resources including Storage I/O
 	pc.jobsMux.Lock()
 	job.Details.PreemptedPods = results
@@ -602,6 +814,29 @@ func (pc *PreemptionController) executePreemption(job *PreemptionJob, selectedPo
 	return nil
 }
 
+// auditEvictions records the pods evicted by a preemption job
+func (pc *PreemptionController) auditEvictions(job *PreemptionJob, results []types.PreemptedPodInfo) {
+	entry := &audit.Entry{
+		Action:    "preemption.evict",
+		Namespace: job.Request.Namespace,
+		JobID:     job.ID,
+		Reason:    job.Request.Reason,
+		Outcome:   audit.OutcomeSuccess,
+	}
+	failed := 0
+	for _, result := range results {
+		entry.Targets = append(entry.Targets, audit.Target{Kind: "Pod", Namespace: result.PodNamespace, Name: result.PodName})
+		if result.Status != "success" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		entry.Outcome = audit.OutcomeFailure
+	}
+	entry.Message = fmt.Sprintf("evicted %d of %d pods on node %s", len(results)-failed, len(results), job.Request.NodeName)
+	recordAudit(pc.auditLog, entry)
+}
+
 // checkTargetAchieved checks if the preemption target was met
 func (pc *PreemptionController) checkTargetAchieved(job *PreemptionJob) bool {
 	targetAmount := pc.parseResourceAmount(job.Request.TargetAmount, job.Request.ResourceType)
@@ -630,11 +865,25 @@ func (pc *PreemptionController) checkTargetAchieved(job *PreemptionJob) bool {
 
 func (pc *PreemptionController) updateJobStatus(job *PreemptionJob, status types.PreemptionStatus) {
 	pc.jobsMux.Lock()
-	defer pc.jobsMux.Unlock()
-
 	job.Status = status
 	now := time.Now()
 	job.Details.UpdatedAt = &now
+	pc.jobsMux.Unlock()
+
+	pc.persistJob(job)
+	pc.publishStatus(job, status, pc.getStatusMessage(status))
+}
+
+// publishStatus publishes a status transition of a preemption job
+func (pc *PreemptionController) publishStatus(job *PreemptionJob, status types.PreemptionStatus, message string) {
+	pc.events.Publish(eventbus.Event{
+		Kind:      eventbus.KindPreemption,
+		Type:      eventbus.TypeStatus,
+		JobID:     job.ID,
+		Namespace: job.Request.Namespace,
+		Status:    string(status),
+		Message:   message,
+	})
 }
 
 func (pc *PreemptionController) failJob(job *PreemptionJob, errorMsg string) {
@@ -783,6 +1032,18 @@ func (pc *PreemptionController) ListPreemptions() []*types.PreemptionResponse {
 	return result
 }
 
+// GetPreemptionNamespace returns the namespace a preemption job is limited to (empty: all namespaces)
+func (pc *PreemptionController) GetPreemptionNamespace(id string) (string, error) {
+	pc.jobsMux.RLock()
+	defer pc.jobsMux.RUnlock()
+
+	job, exists := pc.jobs[id]
+	if !exists || job.Request == nil {
+		return "", fmt.Errorf("preemption job %s not found", id)
+	}
+	return job.Request.Namespace, nil
+}
+
 // GetMetrics returns preemption metrics
 func (pc *PreemptionController) GetMetrics() *types.PreemptionMetrics {
 	pc.jobsMux.RLock()
@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func storageCandidates(provenance types.MetricProvenance) []types.PreemptionCandidate {
+	now := time.Now()
+	return []types.PreemptionCandidate{
+		{
+			PodName: "io-heavy", PodNamespace: "default", PriorityValue: 500, CreationTime: now,
+			ResourceRequests:         types.ResourceAmount{CPU: "1", Memory: "1Gi", StorageReadMBps: 400},
+			StorageMetricsProvenance: provenance,
+		},
+		{
+			PodName: "low-priority", PodNamespace: "default", PriorityValue: 100, CreationTime: now,
+			ResourceRequests:         types.ResourceAmount{CPU: "1", Memory: "1Gi", StorageReadMBps: 10},
+			StorageMetricsProvenance: types.ProvenanceReal,
+		},
+	}
+}
+
+func newStoragePreemptionJob(resourceType, strategy, target string) *PreemptionJob {
+	return &PreemptionJob{
+		ID: "preempt-test",
+		Request: &types.PreemptionRequest{
+			NodeName:         "node-a",
+			ResourceType:     resourceType,
+			TargetAmount:     target,
+			Strategy:         strategy,
+			MinPriority:      1000,
+			MaxPodsToPreempt: 1,
+		},
+		Details: &types.PreemptionDetails{},
+	}
+}
+
+// TestUsesStorageMetrics tests which requests depend on storage I/O metrics
+func TestUsesStorageMetrics(t *testing.T) {
+	pc := &PreemptionController{}
+	assert.True(t, pc.usesStorageMetrics(&types.PreemptionRequest{ResourceType: "storage"}))
+	assert.True(t, pc.usesStorageMetrics(&types.PreemptionRequest{ResourceType: "storage_iops"}))
+	assert.True(t, pc.usesStorageMetrics(&types.PreemptionRequest{ResourceType: "cpu", Strategy: string(types.StrategyStorageIOHeaviest)}))
+	assert.True(t, pc.usesStorageMetrics(&types.PreemptionRequest{ResourceType: "gpu", Strategy: string(types.StrategyStorageAwareWeighted)}))
+	assert.False(t, pc.usesStorageMetrics(&types.PreemptionRequest{ResourceType: "cpu", Strategy: string(types.StrategyLowestPriority)}))
+}
+
+// TestSelectPodsToPreemptStorageGating tests that storage strategies drop the storage criterion when
+// storage metrics are not real, while storage targets are refused
+func TestSelectPodsToPreemptStorageGating(t *testing.T) {
+	pc := &PreemptionController{}
+
+	// 실제 메트릭: I/O가 가장 많은 Pod 선택
+	job := newStoragePreemptionJob("cpu", string(types.StrategyStorageIOHeaviest), "1")
+	candidates := pc.rankCandidates(storageCandidates(types.ProvenanceReal), string(types.StrategyStorageIOHeaviest), 1000)
+	require.Equal(t, "io-heavy", candidates[0].PodName)
+	selected, err := pc.selectPodsToPreempt(job, candidates)
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+	assert.Equal(t, "io-heavy", selected[0].PodName)
+
+	// 스토리지 메트릭 없음: 작업을 실패시키지 않고 우선순위로만 선택
+	job = newStoragePreemptionJob("cpu", string(types.StrategyStorageIOHeaviest), "1")
+	candidates = pc.rankCandidates(storageCandidates(types.ProvenanceUnavailable), string(types.StrategyStorageIOHeaviest), 1000)
+	selected, err = pc.selectPodsToPreempt(job, candidates)
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+	assert.Equal(t, "low-priority", selected[0].PodName)
+	require.Len(t, job.Details.Conditions, 1)
+	assert.Equal(t, "True", job.Details.Conditions[0].Status)
+	assert.Equal(t, "UnavailableMetrics", job.Details.Conditions[0].Reason)
+	assert.Contains(t, job.Details.Conditions[0].Message, "default/io-heavy (unavailable)")
+	assert.Contains(t, job.Details.Conditions[0].Message, "without storage I/O")
+	assert.Equal(t, "low-priority", job.Details.PreemptionCandidates[0].PodName)
+
+	// 스토리지 I/O 자체가 목표면 측정할 수 없으므로 거부
+	job = newStoragePreemptionJob("storage", string(types.StrategyLowestPriority), "100")
+	_, err = pc.selectPodsToPreempt(job, storageCandidates(types.ProvenanceStale))
+	require.ErrorIs(t, err, errMetricsNotReal)
+	assert.Equal(t, "StaleMetrics", job.Details.Conditions[0].Reason)
+}
@@ -0,0 +1,145 @@
+// Package controller implements the PreemptionRequest CRD controller
+// kubectl apply로 선언한 PreemptionRequest를 PreemptionController 작업으로 실행
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	apollov1 "ai-storage-orchestrator/api/v1"
+	"ai-storage-orchestrator/pkg/types"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PreemptionRequestReconciler reconciles a PreemptionRequest object
+type PreemptionRequestReconciler struct {
+	client.Client
+	Scheme      *runtime.Scheme
+	Preemptions *PreemptionController
+
+	started    *jobTracker
+	namespaces namespaceScope
+}
+
+// NewPreemptionRequestReconciler creates a new reconciler
+func NewPreemptionRequestReconciler(client client.Client, scheme *runtime.Scheme, preemptions *PreemptionController) *PreemptionRequestReconciler {
+	return &PreemptionRequestReconciler{
+		Client:      client,
+		Scheme:      scheme,
+		Preemptions: preemptions,
+		started:     newJobTracker(),
+	}
+}
+
+// AllowCrossNamespace lets PreemptionRequests in the given namespaces target other or all namespaces
+func (r *PreemptionRequestReconciler) AllowCrossNamespace(namespaces []string) {
+	r.namespaces.allow(namespaces)
+}
+
+// +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=preemptionrequests,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=preemptionrequests/status,verbs=get;update;patch
+
+// Reconcile starts the preemption described by a PreemptionRequest and mirrors its outcome
+func (r *PreemptionRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var pr apollov1.PreemptionRequest
+	if err := r.Get(ctx, req.NamespacedName, &pr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// 이미 종료된 선점은 다시 실행하지 않음 (1회성 작업)
+	if pr.Status.CompletionTime != nil {
+		r.started.forget(pr.UID)
+		return ctrl.Result{}, nil
+	}
+
+	// 1. 선점 시작
+	if pr.Status.JobID == "" {
+		jobID := r.started.get(pr.UID)
+		if jobID == "" {
+			namespace, err := r.namespaces.resolve(pr.Namespace, pr.Spec.Namespace)
+			if err != nil {
+				setJobStatus(&pr.Status.JobStatus, pr.Generation, string(types.PreemptionStatusFailed), err.Error(), false, true)
+				return ctrl.Result{}, r.Status().Update(ctx, &pr)
+			}
+			resp, err := r.Preemptions.StartPreemption(&types.PreemptionRequest{
+				NodeName:            pr.Spec.NodeName,
+				Namespace:           namespace,
+				ResourceType:        pr.Spec.ResourceType,
+				TargetAmount:        pr.Spec.TargetAmount,
+				Strategy:            pr.Spec.Strategy,
+				MinPriority:         pr.Spec.MinPriority,
+				MaxPodsToPreempt:    pr.Spec.MaxPodsToPreempt,
+				GracePeriodSeconds:  pr.Spec.GracePeriodSeconds,
+				ProtectedNamespaces: pr.Spec.ProtectedNamespaces,
+				Reason:              pr.Spec.Reason,
+			})
+			if err != nil {
+				setJobStatus(&pr.Status.JobStatus, pr.Generation, string(types.PreemptionStatusFailed), err.Error(), false, true)
+				return ctrl.Result{}, r.Status().Update(ctx, &pr)
+			}
+			jobID = resp.PreemptionID
+			r.started.set(pr.UID, jobID)
+			log.Printf("[PreemptionRequest] %s/%s: 선점 시작 (%s, node=%s, %s %s)",
+				pr.Namespace, pr.Name, jobID, pr.Spec.NodeName, pr.Spec.ResourceType, pr.Spec.TargetAmount)
+		}
+		startJobStatus(&pr.Status.JobStatus, jobID, pr.Generation)
+	}
+
+	// 2. 작업 상태 반영
+	finished := r.mirrorStatus(&pr)
+	if err := r.Status().Update(ctx, &pr); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.started.forget(pr.UID)
+
+	if finished {
+		log.Printf("[PreemptionRequest] %s/%s: %s", pr.Namespace, pr.Name, pr.Status.Phase)
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: jobRequeueInterval}, nil
+}
+
+// mirrorStatus copies the preemption job state into the PreemptionRequest status
+// and reports whether the preemption reached a terminal state
+func (r *PreemptionRequestReconciler) mirrorStatus(pr *apollov1.PreemptionRequest) bool {
+	resp, err := r.Preemptions.GetPreemption(pr.Status.JobID)
+	if err != nil {
+		setJobStatus(&pr.Status.JobStatus, pr.Generation, string(types.PreemptionStatusFailed),
+			fmt.Sprintf("%s: %s", jobLostMessage, pr.Status.JobID), false, true)
+		return true
+	}
+
+	message := resp.Message
+	if resp.Details != nil {
+		preempted := make([]string, 0, len(resp.Details.PreemptedPods))
+		for _, pod := range resp.Details.PreemptedPods {
+			if pod.Status == "success" {
+				preempted = append(preempted, fmt.Sprintf("%s/%s", pod.PodNamespace, pod.PodName))
+			}
+		}
+		pr.Status.PreemptedPods = preempted
+		pr.Status.SuccessfulPreemptions = resp.Details.SuccessfulPreemptions
+		pr.Status.FailedPreemptions = resp.Details.FailedPreemptions
+		pr.Status.TargetAchieved = resp.Details.TargetAchieved
+		if resp.Details.ErrorMessage != "" {
+			message = resp.Details.ErrorMessage
+		}
+	}
+
+	succeeded := resp.Status == types.PreemptionStatusCompleted
+	finished := succeeded || resp.Status == types.PreemptionStatusFailed
+	setJobStatus(&pr.Status.JobStatus, pr.Generation, string(resp.Status), message, succeeded, finished)
+	return finished
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *PreemptionRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&apollov1.PreemptionRequest{}, builder.WithPredicates(jobObjectPredicate())).
+		Complete(r)
+}
@@ -2,14 +2,30 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"sync"
 	"time"
 
+	"ai-storage-orchestrator/pkg/eventbus"
+	"ai-storage-orchestrator/pkg/store"
 	"ai-storage-orchestrator/pkg/types"
 
 	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// pvcBindTimeout bounds how long a provisioning waits for its PVC to bind
+	pvcBindTimeout = 5 * time.Minute
+	// pvcBindPollInterval is how often the PVC binding state is checked
+	pvcBindPollInterval = 2 * time.Second
+	// pvcRequestTimeout bounds single PVC API calls made outside a provisioning job
+	pvcRequestTimeout = 30 * time.Second
+	// bindingRefreshInterval is how often the PV and mounted pods of a ready provisioning are refreshed
+	bindingRefreshInterval = 30 * time.Second
 )
 
 // ProvisioningController manages storage provisioning for AI/ML workloads
@@ -21,6 +37,12 @@ type ProvisioningController struct {
 
 	// Storage profiles for different performance requirements
 	storageProfiles map[string]types.StorageProfile
+
+	// Logical storage class (high-throughput, high-iops, ...) -> Kubernetes StorageClass
+	storageClassMap map[string]string
+
+	jobStore store.JobStore
+	events   *eventbus.Bus
 }
 
 // ProvisioningJob represents an active provisioning job
@@ -46,6 +68,8 @@ func NewProvisioningController(k8sClient K8sClientInterface) *ProvisioningContro
 			AverageProvisionTime:    0,
 		},
 		storageProfiles: make(map[string]types.StorageProfile),
+		storageClassMap: make(map[string]string),
+		jobStore:        store.NewNopStore(),
 	}
 
 	// Initialize storage profiles
@@ -54,6 +78,139 @@ func NewProvisioningController(k8sClient K8sClientInterface) *ProvisioningContro
 	return pc
 }
 
+// SetJobStore configures the store that provisioning jobs are written through
+func (pc *ProvisioningController) SetJobStore(s store.JobStore) {
+	pc.jobStore = s
+}
+
+// SetEventBus publishes status transitions of every provisioning
+func (pc *ProvisioningController) SetEventBus(bus *eventbus.Bus) {
+	pc.events = bus
+}
+
+// SetStorageClassMap configures which Kubernetes StorageClass backs each logical storage class
+// Logical classes without an entry are used as StorageClass names as-is
+func (pc *ProvisioningController) SetStorageClassMap(classes map[string]string) {
+	pc.provisioningsMux.Lock()
+	defer pc.provisioningsMux.Unlock()
+
+	pc.storageClassMap = make(map[string]string, len(classes))
+	for logical, storageClass := range classes {
+		pc.storageClassMap[logical] = storageClass
+	}
+}
+
+// ParseStorageClassMap parses a "logical=StorageClass" list such as
+// "high-throughput=fast-ssd,high-iops=nvme-local"
+func ParseStorageClassMap(value string) (map[string]string, error) {
+	classes := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+			return nil, fmt.Errorf("invalid storage class mapping %q (expected logical=StorageClass)", entry)
+		}
+		classes[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return classes, nil
+}
+
+// resolveStorageClass returns the Kubernetes StorageClass for a logical storage class
+func (pc *ProvisioningController) resolveStorageClass(logical string) string {
+	pc.provisioningsMux.RLock()
+	defer pc.provisioningsMux.RUnlock()
+
+	if storageClass, ok := pc.storageClassMap[logical]; ok {
+		return storageClass
+	}
+	return logical
+}
+
+// RestoreJobs reloads persisted provisionings and re-runs the ones that had not finished
+func (pc *ProvisioningController) RestoreJobs() error {
+	restored := 0
+	err := pc.jobStore.ForEach(store.KindProvisioning, func(id string, data []byte) error {
+		var rec provisioningRecord
+		if err := json.Unmarshal(data, &rec); err != nil {
+			log.Printf("Warning: Skipping corrupt provisioning record %s: %v", id, err)
+			return nil
+		}
+
+		// A deleting record means the orchestrator stopped in the middle of DeleteProvisioning
+		if rec.Status == types.ProvisioningStatusDeleting {
+			if rec.Request != nil && rec.Details != nil {
+				ctx, cancel := context.WithTimeout(context.Background(), pvcRequestTimeout)
+				err := pc.k8sClient.DeletePVC(ctx, rec.Request.WorkloadNamespace, rec.Details.PVCName)
+				cancel()
+				if err != nil {
+					log.Printf("Warning: Provisioning %s: failed to finish PVC deletion: %v", id, err)
+					return nil
+				}
+			}
+			deleteRecord(pc.jobStore, store.KindProvisioning, id)
+			return nil
+		}
+		if rec.Request == nil {
+			log.Printf("Warning: Skipping provisioning record %s without a request", id)
+			return nil
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		job := &ProvisioningJob{
+			ID:        rec.ID,
+			Request:   rec.Request,
+			Status:    rec.Status,
+			Details:   rec.Details,
+			CreatedAt: rec.CreatedAt,
+			ctx:       ctx,
+			cancel:    cancel,
+		}
+		if job.Details == nil {
+			job.Details = &types.ProvisioningDetails{CreatedAt: rec.CreatedAt}
+		}
+
+		pc.provisioningsMux.Lock()
+		pc.provisionings[job.ID] = job
+		pc.metrics.TotalProvisionings++
+		if job.Status != types.ProvisioningStatusFailed {
+			pc.metrics.ActiveProvisionings++
+		}
+		pc.provisioningsMux.Unlock()
+
+		switch job.Status {
+		case types.ProvisioningStatusPending, types.ProvisioningStatusCreating:
+			go pc.executeProvisioning(job)
+		case types.ProvisioningStatusReady:
+			go pc.watchBinding(job)
+		}
+		restored++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore provisionings: %w", err)
+	}
+
+	log.Printf("Restored %d provisioning jobs", restored)
+	return nil
+}
+
+// persistJob writes the current state of a provisioning job to the job store
+func (pc *ProvisioningController) persistJob(job *ProvisioningJob) {
+	pc.provisioningsMux.RLock()
+	defer pc.provisioningsMux.RUnlock()
+
+	saveRecord(pc.jobStore, store.KindProvisioning, job.ID, &provisioningRecord{
+		ID:        job.ID,
+		Request:   job.Request,
+		Status:    job.Status,
+		Details:   job.Details,
+		CreatedAt: job.CreatedAt,
+	})
+}
+
 // initializeStorageProfiles sets up predefined storage performance profiles
 func (pc *ProvisioningController) initializeStorageProfiles() {
 	pc.storageProfiles["high-throughput"] = types.StorageProfile{
@@ -122,7 +279,7 @@ func (pc *ProvisioningController) CreateProvisioning(req *types.ProvisioningRequ
 		Status:  types.ProvisioningStatusPending,
 		Details: &types.ProvisioningDetails{
 			CreatedAt:   time.Now(),
-			PVCName:     fmt.Sprintf("pvc-%s-%s", req.WorkloadName, provisioningID[:8]),
+			PVCName:     fmt.Sprintf("pvc-%s-%s", req.WorkloadName, strings.TrimPrefix(provisioningID, "provisioning-")),
 			ActualSize:  req.StorageSize,
 			ActualClass: req.StorageClass,
 		},
@@ -144,6 +301,14 @@ func (pc *ProvisioningController) CreateProvisioning(req *types.ProvisioningRequ
 	pc.metrics.TotalProvisionings++
 	pc.metrics.ActiveProvisionings++
 	pc.provisioningsMux.Unlock()
+	pc.persistJob(job)
+
+	resp := &types.ProvisioningResponse{
+		ProvisioningID: provisioningID,
+		Status:         job.Status,
+		Message:        "Provisioning request accepted and processing",
+		Details:        job.Details,
+	}
 
 	// Start provisioning asynchronously
 	go pc.executeProvisioning(job)
@@ -151,34 +316,74 @@ func (pc *ProvisioningController) CreateProvisioning(req *types.ProvisioningRequ
 	log.Printf("Provisioning %s: Created for workload %s/%s with size %s, class %s",
 		provisioningID, req.WorkloadNamespace, req.WorkloadName, req.StorageSize, req.StorageClass)
 
-	return &types.ProvisioningResponse{
-		ProvisioningID: provisioningID,
-		Status:         job.Status,
-		Message:        "Provisioning request accepted and processing",
-		Details:        job.Details,
-	}, nil
+	return resp, nil
 }
 
-// executeProvisioning performs the actual storage provisioning
+// executeProvisioning creates the PVC for a provisioning job and waits for it to bind
 func (pc *ProvisioningController) executeProvisioning(job *ProvisioningJob) {
 	startTime := time.Now()
 
 	// Update status to creating
 	pc.updateJobStatus(job, types.ProvisioningStatusCreating)
 
-	// TODO: Implement actual PVC creation via Kubernetes API
-	// For now, simulate provisioning
-	log.Printf("Provisioning %s: Creating PVC %s with size %s",
-		job.ID, job.Details.PVCName, job.Details.ActualSize)
+	req := job.Request
+	storageClass := pc.resolveStorageClass(job.Details.ActualClass)
+	pc.provisioningsMux.Lock()
+	job.Details.StorageClassName = storageClass
+	pc.provisioningsMux.Unlock()
+
+	log.Printf("Provisioning %s: Creating PVC %s/%s with size %s, StorageClass %s",
+		job.ID, req.WorkloadNamespace, job.Details.PVCName, job.Details.ActualSize, storageClass)
+
+	// 1. PVC 생성
+	err := pc.k8sClient.CreatePVC(job.ctx, &types.PVCSpec{
+		Namespace:    req.WorkloadNamespace,
+		Name:         job.Details.PVCName,
+		Size:         job.Details.ActualSize,
+		StorageClass: storageClass,
+		AccessMode:   req.AccessMode,
+		VolumeMode:   req.VolumeMode,
+		Labels:       req.Labels,
+		Annotations:  req.Annotations,
+	})
+	if err != nil {
+		pc.failJob(job, err)
+		return
+	}
+
+	// 2. 바인딩 대기
+	pvcStatus, err := pc.waitForBinding(job)
+	if err != nil {
+		pc.failJob(job, err)
+		return
+	}
 
-	// Simulate provisioning delay
-	time.Sleep(2 * time.Second)
+	// 3. PVC를 마운트한 Pod 확인
+	mountedPods, err := pc.k8sClient.ListPodsUsingPVC(job.ctx, req.WorkloadNamespace, job.Details.PVCName)
+	if err != nil {
+		log.Printf("Provisioning %s: Warning: failed to list pods using PVC: %v", job.ID, err)
+	}
 
 	// Update status to ready
-	pc.updateJobStatus(job, types.ProvisioningStatusReady)
+	pc.provisioningsMux.Lock()
+	if job.ctx.Err() != nil {
+		// DeleteProvisioning took over while the PVC was binding
+		pc.provisioningsMux.Unlock()
+		return
+	}
+	job.Status = types.ProvisioningStatusReady
 	readyTime := time.Now()
 	job.Details.ReadyAt = &readyTime
 	job.Details.UpdatedAt = &readyTime
+	job.Details.PVName = pvcStatus.VolumeName
+	if pvcStatus.Capacity != "" {
+		job.Details.ActualSize = pvcStatus.Capacity
+	}
+	job.Details.MountedPods = mountedPods
+	job.Details.BoundToWorkload = len(mountedPods) > 0
+	pc.provisioningsMux.Unlock()
+	pc.persistJob(job)
+	pc.publishStatus(job, types.ProvisioningStatusReady, fmt.Sprintf("PVC %s is ready", job.Details.PVCName))
 
 	// Update metrics
 	provisionTime := time.Since(startTime).Seconds()
@@ -190,17 +395,162 @@ func (pc *ProvisioningController) executeProvisioning(job *ProvisioningJob) {
 	}
 	pc.provisioningsMux.Unlock()
 
-	log.Printf("Provisioning %s: Completed successfully in %.2f seconds", job.ID, provisionTime)
+	log.Printf("Provisioning %s: Completed successfully in %.2f seconds (PV %s)", job.ID, provisionTime, pvcStatus.VolumeName)
+
+	pc.watchBinding(job)
 }
 
-// updateJobStatus updates the status of a provisioning job
-func (pc *ProvisioningController) updateJobStatus(job *ProvisioningJob, status types.ProvisioningStatus) {
+// waitForBinding polls the job's PVC until it is bound
+// Claims of a WaitForFirstConsumer StorageClass are accepted unbound, they bind once the workload pod is scheduled
+func (pc *ProvisioningController) waitForBinding(job *ProvisioningJob) (*types.PVCStatus, error) {
+	ctx, cancel := context.WithTimeout(job.ctx, pvcBindTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(pvcBindPollInterval)
+	defer ticker.Stop()
+
+	namespace, name := job.Request.WorkloadNamespace, job.Details.PVCName
+	for {
+		status, err := pc.k8sClient.GetPVCStatus(ctx, namespace, name)
+		if err != nil {
+			log.Printf("Provisioning %s: Warning: failed to get PVC status: %v", job.ID, err)
+		} else {
+			switch {
+			case status.Phase == string(corev1.ClaimBound):
+				return status, nil
+			case status.Phase == string(corev1.ClaimLost):
+				return nil, fmt.Errorf("PVC %s/%s lost its bound volume", namespace, name)
+			case status.WaitForFirstConsumer:
+				log.Printf("Provisioning %s: PVC %s will bind when the first consumer pod is scheduled", job.ID, name)
+				return status, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if job.ctx.Err() != nil {
+				return nil, job.ctx.Err()
+			}
+			return nil, fmt.Errorf("timed out after %s waiting for PVC %s/%s to bind", pvcBindTimeout, namespace, name)
+		case <-ticker.C:
+		}
+	}
+}
+
+// failJob marks a provisioning job as failed
+func (pc *ProvisioningController) failJob(job *ProvisioningJob, err error) {
+	pc.provisioningsMux.Lock()
+	if job.ctx.Err() != nil {
+		// Cancelled by DeleteProvisioning, not a provisioning failure
+		pc.provisioningsMux.Unlock()
+		return
+	}
+	job.Status = types.ProvisioningStatusFailed
+	job.Details.ErrorMessage = err.Error()
+	now := time.Now()
+	job.Details.UpdatedAt = &now
+	pc.metrics.ActiveProvisionings--
+	pc.provisioningsMux.Unlock()
+	pc.persistJob(job)
+	pc.publishStatus(job, types.ProvisioningStatusFailed, err.Error())
+
+	log.Printf("Provisioning %s: Failed: %v", job.ID, err)
+}
+
+// watchBinding keeps the PV and mounted pods of a ready provisioning up to date until it is deleted,
+// so that GetProvisioning answers from memory without calling the API server
+func (pc *ProvisioningController) watchBinding(job *ProvisioningJob) {
+	ticker := time.NewTicker(bindingRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-job.ctx.Done():
+			return
+		case <-ticker.C:
+			pc.refreshBinding(job)
+		}
+	}
+}
+
+// refreshBinding updates the PV and mounted pods of a ready provisioning
+func (pc *ProvisioningController) refreshBinding(job *ProvisioningJob) {
+	ctx, cancel := context.WithTimeout(job.ctx, pvcRequestTimeout)
+	defer cancel()
+
+	pc.provisioningsMux.RLock()
+	namespace, name, pvName := job.Request.WorkloadNamespace, job.Details.PVCName, job.Details.PVName
+	pc.provisioningsMux.RUnlock()
+
+	mountedPods, err := pc.k8sClient.ListPodsUsingPVC(ctx, namespace, name)
+	if err != nil {
+		log.Printf("Provisioning %s: Warning: failed to list pods using PVC: %v", job.ID, err)
+		return
+	}
+
+	var volumeName string
+	if pvName == "" {
+		// WaitForFirstConsumer 클레임은 Pod 스케줄 이후 바인딩됨
+		if status, err := pc.k8sClient.GetPVCStatus(ctx, namespace, name); err == nil {
+			volumeName = status.VolumeName
+		}
+	}
+
 	pc.provisioningsMux.Lock()
 	defer pc.provisioningsMux.Unlock()
+	if job.ctx.Err() != nil {
+		// Deleted while the pods were listed
+		return
+	}
+	job.Details.MountedPods = mountedPods
+	job.Details.BoundToWorkload = len(mountedPods) > 0
+	if volumeName != "" {
+		job.Details.PVName = volumeName
+	}
+}
 
+// copyProvisioningDetails returns a copy of the details that callers can read without holding the lock
+func copyProvisioningDetails(details *types.ProvisioningDetails) *types.ProvisioningDetails {
+	if details == nil {
+		return nil
+	}
+	c := *details
+	if details.UpdatedAt != nil {
+		updatedAt := *details.UpdatedAt
+		c.UpdatedAt = &updatedAt
+	}
+	if details.ReadyAt != nil {
+		readyAt := *details.ReadyAt
+		c.ReadyAt = &readyAt
+	}
+	if details.MountedPods != nil {
+		c.MountedPods = append([]string(nil), details.MountedPods...)
+	}
+	return &c
+}
+
+// updateJobStatus updates the status of a provisioning job
+func (pc *ProvisioningController) updateJobStatus(job *ProvisioningJob, status types.ProvisioningStatus) {
+	pc.provisioningsMux.Lock()
 	job.Status = status
 	now := time.Now()
 	job.Details.UpdatedAt = &now
+	pc.provisioningsMux.Unlock()
+
+	pc.persistJob(job)
+	pc.publishStatus(job, status, pc.getStatusMessage(status))
+}
+
+// publishStatus publishes a status transition of a provisioning job
+func (pc *ProvisioningController) publishStatus(job *ProvisioningJob, status types.ProvisioningStatus, message string) {
+	pc.events.Publish(eventbus.Event{
+		Kind:      eventbus.KindProvisioning,
+		Type:      eventbus.TypeStatus,
+		JobID:     job.ID,
+		Namespace: job.Request.WorkloadNamespace,
+		Status:    string(status),
+		Message:   message,
+	})
 }
 
 // autoSizeStorage automatically determines storage size based on workload type
@@ -266,11 +616,12 @@ func (pc *ProvisioningController) selectStorageClass(req *types.ProvisioningRequ
 }
 
 // GetProvisioning retrieves the status of a provisioning job
+// The binding of ready provisionings is refreshed in the background by watchBinding
 func (pc *ProvisioningController) GetProvisioning(provisioningID string) (*types.ProvisioningResponse, error) {
 	pc.provisioningsMux.RLock()
-	job, exists := pc.provisionings[provisioningID]
-	pc.provisioningsMux.RUnlock()
+	defer pc.provisioningsMux.RUnlock()
 
+	job, exists := pc.provisionings[provisioningID]
 	if !exists {
 		return nil, fmt.Errorf("provisioning %s not found", provisioningID)
 	}
@@ -279,7 +630,7 @@ func (pc *ProvisioningController) GetProvisioning(provisioningID string) (*types
 		ProvisioningID: job.ID,
 		Status:         job.Status,
 		Message:        pc.getStatusMessage(job.Status),
-		Details:        job.Details,
+		Details:        copyProvisioningDetails(job.Details),
 	}, nil
 }
 
@@ -294,7 +645,7 @@ func (pc *ProvisioningController) ListProvisionings() []*types.ProvisioningRespo
 			ProvisioningID: job.ID,
 			Status:         job.Status,
 			Message:        pc.getStatusMessage(job.Status),
-			Details:        job.Details,
+			Details:        copyProvisioningDetails(job.Details),
 		})
 	}
 
@@ -314,17 +665,36 @@ func (pc *ProvisioningController) DeleteProvisioning(provisioningID string) erro
 	job.cancel()
 
 	// Update status
+	wasActive := job.Status != types.ProvisioningStatusFailed
 	job.Status = types.ProvisioningStatusDeleting
 	pc.provisioningsMux.Unlock()
-
-	// TODO: Implement actual PVC deletion via Kubernetes API
-	log.Printf("Provisioning %s: Deleting PVC %s", provisioningID, job.Details.PVCName)
+	pc.persistJob(job)
+
+	log.Printf("Provisioning %s: Deleting PVC %s/%s", provisioningID, job.Request.WorkloadNamespace, job.Details.PVCName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), pvcRequestTimeout)
+	defer cancel()
+	if err := pc.k8sClient.DeletePVC(ctx, job.Request.WorkloadNamespace, job.Details.PVCName); err != nil {
+		// Keep the job as failed so that the deletion can be retried
+		pc.provisioningsMux.Lock()
+		job.Status = types.ProvisioningStatusFailed
+		job.Details.ErrorMessage = err.Error()
+		if wasActive {
+			pc.metrics.ActiveProvisionings--
+		}
+		pc.provisioningsMux.Unlock()
+		pc.persistJob(job)
+		return err
+	}
 
 	// Remove from map
 	pc.provisioningsMux.Lock()
 	delete(pc.provisionings, provisioningID)
-	pc.metrics.ActiveProvisionings--
+	if wasActive {
+		pc.metrics.ActiveProvisionings--
+	}
 	pc.provisioningsMux.Unlock()
+	deleteRecord(pc.jobStore, store.KindProvisioning, provisioningID)
 
 	log.Printf("Provisioning %s: Deleted successfully", provisioningID)
 	return nil
@@ -364,6 +734,18 @@ func (pc *ProvisioningController) GetRecommendation(workloadType string) *types.
 	return &recommendation
 }
 
+// GetProvisioningNamespace returns the namespace of the provisioned workload
+func (pc *ProvisioningController) GetProvisioningNamespace(id string) (string, error) {
+	pc.provisioningsMux.RLock()
+	defer pc.provisioningsMux.RUnlock()
+
+	job, exists := pc.provisionings[id]
+	if !exists || job.Request == nil {
+		return "", fmt.Errorf("provisioning %s not found", id)
+	}
+	return job.Request.WorkloadNamespace, nil
+}
+
 // GetMetrics returns provisioning metrics
 func (pc *ProvisioningController) GetMetrics() *types.ProvisioningMetrics {
 	pc.provisioningsMux.RLock()
@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProvisioningCreatesAndDeletesPVC tests that a provisioning creates a real PVC,
+// waits for it to bind and deletes it again
+func TestProvisioningCreatesAndDeletesPVC(t *testing.T) {
+	mockClient := new(MockK8sClient)
+	pc := NewProvisioningController(mockClient)
+	pc.SetStorageClassMap(map[string]string{"high-throughput": "fast-ssd"})
+
+	mockClient.On("CreatePVC", mock.Anything, mock.MatchedBy(func(spec *types.PVCSpec) bool {
+		return spec.Namespace == "ai-workloads" &&
+			spec.Size == "500Gi" &&
+			spec.StorageClass == "fast-ssd" &&
+			spec.AccessMode == "ReadWriteOnce" &&
+			spec.Labels["team"] == "vision"
+	})).Return(nil)
+	mockClient.On("GetPVCStatus", mock.Anything, "ai-workloads", mock.Anything).
+		Return(&types.PVCStatus{Phase: "Bound", VolumeName: "pv-1234", Capacity: "512Gi"}, nil)
+	mockClient.On("ListPodsUsingPVC", mock.Anything, "ai-workloads", mock.Anything).
+		Return([]string{"pytorch-training-0"}, nil)
+	mockClient.On("DeletePVC", mock.Anything, "ai-workloads", mock.Anything).Return(nil)
+
+	resp, err := pc.CreateProvisioning(&types.ProvisioningRequest{
+		WorkloadName:      "pytorch-training",
+		WorkloadNamespace: "ai-workloads",
+		WorkloadType:      "training",
+		AutoSize:          true,
+		Labels:            map[string]string{"team": "vision"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "high-throughput", resp.Details.ActualClass)
+
+	require.Eventually(t, func() bool {
+		got, err := pc.GetProvisioning(resp.ProvisioningID)
+		return err == nil && got.Status == types.ProvisioningStatusReady
+	}, 5*time.Second, 50*time.Millisecond)
+
+	got, err := pc.GetProvisioning(resp.ProvisioningID)
+	require.NoError(t, err)
+	assert.Equal(t, "pv-1234", got.Details.PVName)
+	assert.Equal(t, "512Gi", got.Details.ActualSize)
+	assert.Equal(t, "fast-ssd", got.Details.StorageClassName)
+	assert.Equal(t, []string{"pytorch-training-0"}, got.Details.MountedPods)
+	assert.True(t, got.Details.BoundToWorkload)
+
+	require.NoError(t, pc.DeleteProvisioning(resp.ProvisioningID))
+	mockClient.AssertCalled(t, "DeletePVC", mock.Anything, "ai-workloads", got.Details.PVCName)
+	assert.Equal(t, int64(0), pc.GetMetrics().ActiveProvisionings)
+}
+
+// TestProvisioningBindingRefreshedInBackground tests that reading a ready provisioning does not call
+// the API server and returns the binding last refreshed by the background loop
+func TestProvisioningBindingRefreshedInBackground(t *testing.T) {
+	mockClient := new(MockK8sClient)
+	pc := NewProvisioningController(mockClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	job := &ProvisioningJob{
+		ID:      "provisioning-ready",
+		Request: &types.ProvisioningRequest{WorkloadName: "bert", WorkloadNamespace: "ai-workloads"},
+		Status:  types.ProvisioningStatusReady,
+		Details: &types.ProvisioningDetails{PVCName: "pvc-bert"},
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	pc.provisionings[job.ID] = job
+
+	got, err := pc.GetProvisioning(job.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.Details.MountedPods)
+	mockClient.AssertNotCalled(t, "ListPodsUsingPVC", mock.Anything, mock.Anything, mock.Anything)
+
+	// A WaitForFirstConsumer claim binds once its first pod is scheduled
+	mockClient.On("ListPodsUsingPVC", mock.Anything, "ai-workloads", "pvc-bert").Return([]string{"bert-0"}, nil)
+	mockClient.On("GetPVCStatus", mock.Anything, "ai-workloads", "pvc-bert").
+		Return(&types.PVCStatus{Phase: "Bound", VolumeName: "pv-bert"}, nil)
+	pc.refreshBinding(job)
+
+	got, err = pc.GetProvisioning(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "pv-bert", got.Details.PVName)
+	assert.Equal(t, []string{"bert-0"}, got.Details.MountedPods)
+	assert.True(t, got.Details.BoundToWorkload)
+	mockClient.AssertNumberOfCalls(t, "ListPodsUsingPVC", 1)
+
+	// The response is a snapshot
+	got.Details.MountedPods[0] = "changed"
+	assert.Equal(t, []string{"bert-0"}, job.Details.MountedPods)
+}
+
+// TestParseStorageClassMap tests parsing of the STORAGE_CLASS_MAP setting
+func TestParseStorageClassMap(t *testing.T) {
+	classes, err := ParseStorageClassMap("high-throughput=fast-ssd, high-iops = nvme-local,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"high-throughput": "fast-ssd", "high-iops": "nvme-local"}, classes)
+
+	_, err = ParseStorageClassMap("high-throughput")
+	assert.Error(t, err)
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"errors"
+	"log"
+
+	apollov1 "ai-storage-orchestrator/api/v1"
+	"ai-storage-orchestrator/pkg/types"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// scalingPolicy converts a StorageHPA scaling policy to the policy shared with REST autoscalers
+func scalingPolicy(spec *apollov1.ScalingPolicySpec) *types.ScalingPolicy {
+	if spec == nil {
+		return nil
+	}
+	policy := &types.ScalingPolicy{SelectPolicy: spec.SelectPolicy}
+	for _, rule := range spec.Policies {
+		policy.Policies = append(policy.Policies, types.ScalingPolicyRule{
+			Type:          rule.Type,
+			Value:         rule.Value,
+			PeriodSeconds: rule.PeriodSeconds,
+		})
+	}
+	if spec.Tolerance != nil {
+		policy.Tolerance = spec.Tolerance.AsApproximateFloat64()
+	}
+	return policy
+}
+
+// scalingRulesFor returns the behavior of one scaling direction of a StorageHPA.
+// 설정 오류가 있으면 해당 방향의 스케일링을 멈춘다 (Disabled와 같이 현재 레플리카 유지).
+func scalingRulesFor(direction string, spec *apollov1.ScalingPolicySpec) (scalingRules, error) {
+	policy := scalingPolicy(spec)
+	if err := validateScalingPolicy(direction, policy); err != nil {
+		return scalingRules{selectPolicy: types.SelectPolicyDisabled}, err
+	}
+	return newScalingRules(policy), nil
+}
+
+// scalingRulesOf returns the behavior of both scaling directions of a StorageHPA
+func scalingRulesOf(hpa *apollov1.StorageHPA) (up, down scalingRules, err error) {
+	up, upErr := scalingRulesFor("scaleUp", hpa.Spec.ScaleUpPolicy)
+	down, downErr := scalingRulesFor("scaleDown", hpa.Spec.ScaleDownPolicy)
+	return up, down, errors.Join(upErr, downErr)
+}
+
+// scalingBehavior returns the behavior of both scaling directions of a StorageHPA and records
+// whether the scaling policies are valid in the ScalingPolicyValid condition
+func scalingBehavior(hpa *apollov1.StorageHPA) (up, down scalingRules) {
+	up, down, err := scalingRulesOf(hpa)
+
+	condition := metav1.Condition{
+		Type:               apollov1.ConditionTypeScalingPolicyValid,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: hpa.Generation,
+		Reason:             "ValidScalingPolicy",
+		Message:            "스케일링 정책 유효",
+	}
+	if err != nil {
+		log.Printf("[StorageHPA] %s: 스케일링 정책 설정 오류, 해당 방향 스케일링 중지: %v", hpa.Name, err)
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "InvalidScalingPolicy"
+		condition.Message = err.Error()
+	}
+	meta.SetStatusCondition(&hpa.Status.Conditions, condition)
+	return up, down
+}
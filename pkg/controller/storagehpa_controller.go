@@ -18,14 +18,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math"
 	"time"
 
 	apollov1 "ai-storage-orchestrator/api/v1"
+	"ai-storage-orchestrator/pkg/types"
 
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
+	ktypes "k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -42,6 +45,9 @@ type StorageHPAReconciler struct {
 	Scheme    *runtime.Scheme
 	K8sClient K8sClientInterface // 기존 K8s 클라이언트 재사용
 
+	// 커스텀 메트릭(임의 PromQL)은 다른 네임스페이스 데이터를 읽을 수 있으므로 허용된 네임스페이스에서만 평가
+	namespaces namespaceScope
+
 	// 안정화 윈도우를 위한 스케일링 히스토리
 	// key: namespace/name
 	scaleHistory map[string]*scaleHistoryEntry
@@ -51,6 +57,13 @@ type StorageHPAReconciler struct {
 type scaleHistoryEntry struct {
 	scaleUpHistory   []scaleRecommendationEntry
 	scaleDownHistory []scaleRecommendationEntry
+
+	// 스케일링 정책의 기간당 변경 제한용 스케일 이벤트
+	scaleEvents []scaleEvent
+
+	// 예측 스케일링용 메트릭 이력 (spec.predictive 설정 시)
+	forecaster   *demandForecaster
+	forecastSpec string // forecaster를 만든 mode/season/horizon
 }
 
 type scaleRecommendationEntry struct {
@@ -68,6 +81,11 @@ func NewStorageHPAReconciler(client client.Client, scheme *runtime.Scheme, k8sCl
 	}
 }
 
+// AllowCrossNamespace lets StorageHPAs in the given namespaces use custom metrics, whose PromQL can read other namespaces
+func (r *StorageHPAReconciler) AllowCrossNamespace(namespaces []string) {
+	r.namespaces.allow(namespaces)
+}
+
 // +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=storagehpas,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=storagehpas/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=storagehpas/finalizers,verbs=update
@@ -113,16 +131,62 @@ func (r *StorageHPAReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return r.updateStatusFailed(ctx, &storageHPA, err.Error())
 	}
 
+	// Scale to zero: 0 레플리카이거나 깨우는 중이면 메트릭 수집 없이 종료
+	if storageHPA.Spec.ScaleToZero != nil {
+		if result, done, err := r.reconcileScaleToZero(ctx, &storageHPA, currentReplicas); done {
+			return result, err
+		}
+	} else {
+		storageHPA.Status.ScaleToZero = nil
+	}
+
 	// 3. 메트릭 수집
 	metrics, err := r.collectMetrics(ctx, &storageHPA)
 	if err != nil {
-		log.Printf("[StorageHPA] %s: 메트릭 수집 실패 (시뮬레이션 사용): %v", req.Name, err)
-		// 메트릭 수집 실패시 시뮬레이션 값 사용
-		metrics = r.getSimulatedMetrics()
+		// 메트릭이 없으면 임의 값으로 스케일링하지 않고 상태에 기록한 뒤 재시도
+		log.Printf("[StorageHPA] %s: 메트릭 수집 실패, 스케일링 건너뜀: %v", req.Name, err)
+		return r.updateStatusMetricsUnavailable(ctx, &storageHPA, currentReplicas, "MetricsUnavailable", err.Error())
+	}
+	if !metrics.provenance.IsReal() {
+		// 목표로 쓰는 신호가 stale/unavailable/simulated면 스케일링하지 않음
+		log.Printf("[StorageHPA] %s: 메트릭 출처가 %s, 스케일링 건너뜀", req.Name, metrics.provenance)
+		return r.updateStatusMetricsUnavailable(ctx, &storageHPA, currentReplicas,
+			types.MetricsCondition(metrics.provenance, "").Reason,
+			fmt.Sprintf("workload metrics are %s (%s)", metrics.provenance, metrics.signals))
+	}
+
+	// 유휴 타임아웃이 지나면 0으로 스케일
+	if storageHPA.Spec.ScaleToZero != nil {
+		scaledToZero, err := r.scaleToZeroIfIdle(ctx, &storageHPA, currentReplicas, metrics)
+		if err != nil {
+			log.Printf("[StorageHPA] %s: scale to zero 실패: %v", req.Name, err)
+			return r.updateStatusFailed(ctx, &storageHPA, err.Error())
+		}
+		if scaledToZero {
+			if err := r.updateStatus(ctx, &storageHPA, currentReplicas, 0, metrics, true); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: defaultRequeueInterval}, nil
+		}
 	}
 
-	// 4. 원하는 레플리카 수 계산
-	desiredReplicas := r.calculateDesiredReplicas(&storageHPA, currentReplicas, metrics)
+	// 4. 원하는 레플리카 수 계산 (예측 스케일링 시 예측 수요가 더 크면 예측값 기준)
+	scaleMetrics := metrics
+	forecaster := r.forecasterFor(&storageHPA, r.scaleHistory[historyKey])
+	if forecaster != nil {
+		predicted := *metrics
+		predicted.gpuPercent, predicted.storageReadThroughput = forecaster.observe(
+			time.Now(), currentReplicas, metrics.gpuPercent, metrics.storageReadThroughput)
+		if predicted != *metrics {
+			log.Printf("[StorageHPA] %s: 예측 수요로 선제 스케일 판단 (GPU %d%%→%d%%, Read %d→%d MB/s)",
+				req.Name, metrics.gpuPercent, predicted.gpuPercent, metrics.storageReadThroughput, predicted.storageReadThroughput)
+		}
+		scaleMetrics = &predicted
+	}
+	storageHPA.Status.Forecast = forecastStatus(forecaster)
+	// 커스텀 메트릭(PromQL)도 기본 메트릭과 함께 평가
+	storageHPA.Status.CustomMetrics = r.evaluateCustomMetrics(&storageHPA, currentReplicas)
+	desiredReplicas := r.calculateDesiredReplicas(&storageHPA, currentReplicas, scaleMetrics)
 
 	// 5. 안정화 윈도우 적용
 	stabilizedReplicas := r.applyStabilizationWindow(&storageHPA, historyKey, currentReplicas, desiredReplicas)
@@ -135,6 +199,8 @@ func (r *StorageHPAReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			return r.updateStatusFailed(ctx, &storageHPA, err.Error())
 		}
 		scaled = true
+		history := r.scaleHistory[historyKey]
+		history.scaleEvents = recordScaleEvent(history.scaleEvents, currentReplicas, stabilizedReplicas, time.Now())
 
 		if stabilizedReplicas > currentReplicas {
 			log.Printf("[StorageHPA] %s: 스케일 UP %d → %d (목표: %d)",
@@ -163,6 +229,58 @@ type metricsData struct {
 	storageReadThroughput  int64
 	storageWriteThroughput int64
 	storageIOPS            int64
+	provenance             types.MetricProvenance // provenance of the signals the StorageHPA has targets for
+	signals                types.WorkloadMetricsProvenance
+}
+
+// forecasterFor returns the forecaster of a StorageHPA, nil when predictive scaling is off or invalid.
+// spec.predictive가 바뀌면 이력을 버리고 새로 만든다.
+func (r *StorageHPAReconciler) forecasterFor(hpa *apollov1.StorageHPA, history *scaleHistoryEntry) *demandForecaster {
+	spec := hpa.Spec.Predictive
+	if spec == nil {
+		history.forecaster = nil
+		return nil
+	}
+	horizon := hpa.Spec.GetForecastHorizonSeconds()
+	key := fmt.Sprintf("%s/%d/%d", spec.Mode, spec.SeasonLengthSeconds, horizon)
+	if history.forecaster != nil && history.forecastSpec == key {
+		return history.forecaster
+	}
+
+	err := validatePredictiveScaling(spec.Mode, spec.SeasonLengthSeconds, horizon, defaultRequeueInterval)
+	var forecaster *demandForecaster
+	if err == nil {
+		forecaster, err = newDemandForecaster(spec.Mode, spec.SeasonLengthSeconds, horizon, defaultRequeueInterval)
+	}
+	if err != nil {
+		log.Printf("[StorageHPA] %s: 예측 스케일링 설정 오류, 반응형 스케일링만 사용: %v", hpa.Name, err)
+		history.forecaster = nil
+		return nil
+	}
+	history.forecaster = forecaster
+	history.forecastSpec = key
+	return forecaster
+}
+
+// forecastStatus converts the state of a forecaster to the StorageHPA status
+func forecastStatus(f *demandForecaster) *apollov1.ForecastStatus {
+	if f == nil {
+		return nil
+	}
+	s := f.status()
+	status := &apollov1.ForecastStatus{
+		Mode:                           s.Mode,
+		HorizonSeconds:                 s.HorizonSeconds,
+		Samples:                        int32(s.Samples),
+		Ready:                          s.Ready,
+		PredictedGPUPercent:            s.PredictedGPU,
+		PredictedStorageReadThroughput: s.PredictedStorageReadThroughput,
+		ExpectedGPUPercent:             s.ExpectedGPU,
+		ExpectedStorageReadThroughput:  s.ExpectedStorageReadThroughput,
+		GPUErrorPercent:                int32(math.Round(s.GPUErrorPercent)),
+		StorageReadErrorPercent:        int32(math.Round(s.StorageReadErrorPercent)),
+	}
+	return status
 }
 
 // getCurrentReplicas returns the current replica count of the target workload
@@ -170,7 +288,7 @@ func (r *StorageHPAReconciler) getCurrentReplicas(ctx context.Context, hpa *apol
 	switch hpa.Spec.WorkloadRef.Kind {
 	case "Deployment":
 		var deployment appsv1.Deployment
-		err := r.Get(ctx, types.NamespacedName{
+		err := r.Get(ctx, ktypes.NamespacedName{
 			Namespace: hpa.Namespace,
 			Name:      hpa.Spec.WorkloadRef.Name,
 		}, &deployment)
@@ -184,7 +302,7 @@ func (r *StorageHPAReconciler) getCurrentReplicas(ctx context.Context, hpa *apol
 
 	case "StatefulSet":
 		var statefulset appsv1.StatefulSet
-		err := r.Get(ctx, types.NamespacedName{
+		err := r.Get(ctx, ktypes.NamespacedName{
 			Namespace: hpa.Namespace,
 			Name:      hpa.Spec.WorkloadRef.Name,
 		}, &statefulset)
@@ -204,7 +322,7 @@ func (r *StorageHPAReconciler) getCurrentReplicas(ctx context.Context, hpa *apol
 // collectMetrics collects metrics for the target workload
 func (r *StorageHPAReconciler) collectMetrics(ctx context.Context, hpa *apollov1.StorageHPA) (*metricsData, error) {
 	// K8sClient를 통해 메트릭 수집
-	cpuPercent, memoryPercent, gpuPercent, readMBps, writeMBps, iops, err := r.K8sClient.GetWorkloadPodMetrics(
+	cpuPercent, memoryPercent, gpuPercent, readMBps, writeMBps, iops, signals, err := r.K8sClient.GetWorkloadPodMetrics(
 		ctx,
 		hpa.Namespace,
 		hpa.Spec.WorkloadRef.Name,
@@ -220,22 +338,14 @@ func (r *StorageHPAReconciler) collectMetrics(ctx context.Context, hpa *apollov1
 		storageReadThroughput:  readMBps,
 		storageWriteThroughput: writeMBps,
 		storageIOPS:            iops,
+		provenance: signals.Of(hpa.Spec.TargetCPUPercent != nil || hpa.Spec.TargetMemoryPercent != nil,
+			hpa.Spec.TargetGPUPercent != nil,
+			hpa.Spec.TargetStorageReadThroughput != nil || hpa.Spec.TargetStorageWriteThroughput != nil ||
+				hpa.Spec.TargetStorageIOPS != nil),
+		signals: signals,
 	}, nil
 }
 
-// getSimulatedMetrics returns simulated metrics when real metrics are unavailable
-func (r *StorageHPAReconciler) getSimulatedMetrics() *metricsData {
-	// 시뮬레이션 값 (테스트용)
-	return &metricsData{
-		cpuPercent:             50 + int32(time.Now().Unix()%40),
-		memoryPercent:          45 + int32(time.Now().Unix()%35),
-		gpuPercent:             40 + int32(time.Now().Unix()%50),
-		storageReadThroughput:  300 + int64(time.Now().Unix()%200),
-		storageWriteThroughput: 80 + int64(time.Now().Unix()%70),
-		storageIOPS:            2000 + int64(time.Now().Unix()%2000),
-	}
-}
-
 // calculateDesiredReplicas calculates the desired replica count based on metrics
 func (r *StorageHPAReconciler) calculateDesiredReplicas(hpa *apollov1.StorageHPA, currentReplicas int32, metrics *metricsData) int32 {
 	if currentReplicas == 0 {
@@ -244,31 +354,31 @@ func (r *StorageHPAReconciler) calculateDesiredReplicas(hpa *apollov1.StorageHPA
 
 	recommendations := []int32{}
 
+	// tolerance는 메트릭과 목표값의 비율에 적용 (설정 오류는 applyStabilizationWindow에서 기록)
+	up, down, _ := scalingRulesOf(hpa)
+
 	// CPU 기반 계산
 	if hpa.Spec.TargetCPUPercent != nil && metrics.cpuPercent > 0 {
-		targetCPU := *hpa.Spec.TargetCPUPercent
-		cpuDesired := int32(float64(currentReplicas) * float64(metrics.cpuPercent) / float64(targetCPU))
+		cpuDesired := metricReplicas(currentReplicas, float64(metrics.cpuPercent), float64(*hpa.Spec.TargetCPUPercent), up, down)
 		recommendations = append(recommendations, cpuDesired)
 	}
 
 	// Memory 기반 계산
 	if hpa.Spec.TargetMemoryPercent != nil && metrics.memoryPercent > 0 {
-		targetMem := *hpa.Spec.TargetMemoryPercent
-		memDesired := int32(float64(currentReplicas) * float64(metrics.memoryPercent) / float64(targetMem))
+		memDesired := metricReplicas(currentReplicas, float64(metrics.memoryPercent), float64(*hpa.Spec.TargetMemoryPercent), up, down)
 		recommendations = append(recommendations, memDesired)
 	}
 
 	// GPU 기반 계산
 	if hpa.Spec.TargetGPUPercent != nil && metrics.gpuPercent > 0 {
-		targetGPU := *hpa.Spec.TargetGPUPercent
-		gpuDesired := int32(float64(currentReplicas) * float64(metrics.gpuPercent) / float64(targetGPU))
+		gpuDesired := metricReplicas(currentReplicas, float64(metrics.gpuPercent), float64(*hpa.Spec.TargetGPUPercent), up, down)
 		recommendations = append(recommendations, gpuDesired)
 	}
 
 	// Storage Read 기반 계산 (AI/ML 데이터 로딩에 중요!)
 	if hpa.Spec.TargetStorageReadThroughput != nil && metrics.storageReadThroughput > 0 {
 		targetRead := *hpa.Spec.TargetStorageReadThroughput
-		readDesired := int32(float64(currentReplicas) * float64(metrics.storageReadThroughput) / float64(targetRead))
+		readDesired := metricReplicas(currentReplicas, float64(metrics.storageReadThroughput), float64(targetRead), up, down)
 		recommendations = append(recommendations, readDesired)
 		log.Printf("[StorageHPA] Storage Read: %d MB/s (목표: %d MB/s) → %d replicas",
 			metrics.storageReadThroughput, targetRead, readDesired)
@@ -276,18 +386,20 @@ func (r *StorageHPAReconciler) calculateDesiredReplicas(hpa *apollov1.StorageHPA
 
 	// Storage Write 기반 계산
 	if hpa.Spec.TargetStorageWriteThroughput != nil && metrics.storageWriteThroughput > 0 {
-		targetWrite := *hpa.Spec.TargetStorageWriteThroughput
-		writeDesired := int32(float64(currentReplicas) * float64(metrics.storageWriteThroughput) / float64(targetWrite))
+		writeDesired := metricReplicas(currentReplicas, float64(metrics.storageWriteThroughput), float64(*hpa.Spec.TargetStorageWriteThroughput), up, down)
 		recommendations = append(recommendations, writeDesired)
 	}
 
 	// Storage IOPS 기반 계산
 	if hpa.Spec.TargetStorageIOPS != nil && metrics.storageIOPS > 0 {
-		targetIOPS := *hpa.Spec.TargetStorageIOPS
-		iopsDesired := int32(float64(currentReplicas) * float64(metrics.storageIOPS) / float64(targetIOPS))
+		iopsDesired := metricReplicas(currentReplicas, float64(metrics.storageIOPS), float64(*hpa.Spec.TargetStorageIOPS), up, down)
 		recommendations = append(recommendations, iopsDesired)
 	}
 
+	// 커스텀 메트릭 (Status에 기록된 이번 평가 결과)
+	customRecommendations, customMissing := customMetricStatusRecommendations(hpa.Status.CustomMetrics)
+	recommendations = append(recommendations, customRecommendations...)
+
 	// 최대값 선택 (가장 보수적인 스케일 다운, 가장 적극적인 스케일 업)
 	desiredReplicas := currentReplicas
 	if len(recommendations) > 0 {
@@ -299,6 +411,11 @@ func (r *StorageHPAReconciler) calculateDesiredReplicas(hpa *apollov1.StorageHPA
 		}
 	}
 
+	// 값을 얻지 못한 커스텀 메트릭이 있으면 스케일 다운하지 않음
+	if customMissing && desiredReplicas < currentReplicas {
+		desiredReplicas = currentReplicas
+	}
+
 	// Min/Max 제약 적용
 	if desiredReplicas < hpa.Spec.MinReplicas {
 		desiredReplicas = hpa.Spec.MinReplicas
@@ -324,10 +441,18 @@ func (r *StorageHPAReconciler) calculateDesiredReplicas(hpa *apollov1.StorageHPA
 }
 
 // applyStabilizationWindow applies stabilization window to prevent flapping
+// 안정화 후 tolerance와 스케일링 정책(Policies/SelectPolicy)의 기간당 변경 제한을 적용
 func (r *StorageHPAReconciler) applyStabilizationWindow(hpa *apollov1.StorageHPA, historyKey string, currentReplicas, desiredReplicas int32) int32 {
 	now := time.Now()
 	history := r.scaleHistory[historyKey]
+	up, down := scalingBehavior(hpa)
 
+	stabilized := r.stabilizeRecommendation(hpa, history, currentReplicas, desiredReplicas, now)
+	return limitScaling(currentReplicas, stabilized, up, down, history.scaleEvents, now)
+}
+
+// stabilizeRecommendation returns the recommendation selected within the stabilization window
+func (r *StorageHPAReconciler) stabilizeRecommendation(hpa *apollov1.StorageHPA, history *scaleHistoryEntry, currentReplicas, desiredReplicas int32, now time.Time) int32 {
 	recommendation := scaleRecommendationEntry{
 		replicas:  desiredReplicas,
 		timestamp: now,
@@ -397,7 +522,7 @@ func (r *StorageHPAReconciler) scaleWorkload(ctx context.Context, hpa *apollov1.
 	switch hpa.Spec.WorkloadRef.Kind {
 	case "Deployment":
 		var deployment appsv1.Deployment
-		err := r.Get(ctx, types.NamespacedName{
+		err := r.Get(ctx, ktypes.NamespacedName{
 			Namespace: hpa.Namespace,
 			Name:      hpa.Spec.WorkloadRef.Name,
 		}, &deployment)
@@ -410,7 +535,7 @@ func (r *StorageHPAReconciler) scaleWorkload(ctx context.Context, hpa *apollov1.
 
 	case "StatefulSet":
 		var statefulset appsv1.StatefulSet
-		err := r.Get(ctx, types.NamespacedName{
+		err := r.Get(ctx, ktypes.NamespacedName{
 			Namespace: hpa.Namespace,
 			Name:      hpa.Spec.WorkloadRef.Name,
 		}, &statefulset)
@@ -442,6 +567,13 @@ func (r *StorageHPAReconciler) updateStatus(ctx context.Context, hpa *apollov1.S
 	hpa.Status.Phase = apollov1.StorageHPAPhaseActive
 	hpa.Status.Message = "오토스케일러 활성"
 	hpa.Status.LastUpdated = &now
+	meta.SetStatusCondition(&hpa.Status.Conditions, metav1.Condition{
+		Type:               apollov1.ConditionTypeMetricsAvailable,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: hpa.Generation,
+		Reason:             "MetricsCollected",
+		Message:            "워크로드 메트릭 수집 성공",
+	})
 
 	if scaled {
 		hpa.Status.LastScaleTime = &now
@@ -456,6 +588,28 @@ func (r *StorageHPAReconciler) updateStatus(ctx context.Context, hpa *apollov1.S
 	return r.Status().Update(ctx, hpa)
 }
 
+// updateStatusMetricsUnavailable records that no usable metrics were collected; the workload is left as is
+func (r *StorageHPAReconciler) updateStatusMetricsUnavailable(ctx context.Context, hpa *apollov1.StorageHPA, currentReplicas int32, reason, message string) (ctrl.Result, error) {
+	now := metav1.Now()
+	hpa.Status.CurrentReplicas = currentReplicas
+	hpa.Status.DesiredReplicas = currentReplicas
+	hpa.Status.Message = fmt.Sprintf("메트릭 사용 불가, 스케일링 보류: %s", message)
+	hpa.Status.LastUpdated = &now
+	meta.SetStatusCondition(&hpa.Status.Conditions, metav1.Condition{
+		Type:               apollov1.ConditionTypeMetricsAvailable,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: hpa.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+
+	if err := r.Status().Update(ctx, hpa); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: defaultRequeueInterval}, nil
+}
+
 // updateStatusFailed updates status to failed
 func (r *StorageHPAReconciler) updateStatusFailed(ctx context.Context, hpa *apollov1.StorageHPA, message string) (ctrl.Result, error) {
 	now := metav1.Now()
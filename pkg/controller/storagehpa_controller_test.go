@@ -0,0 +1,409 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	apollov1 "ai-storage-orchestrator/api/v1"
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+// startStorageHPAEnv starts an envtest API server with the StorageHPA CRD installed
+// and a manager running the StorageHPA reconciler against the given metrics client.
+// envtest 바이너리(etcd, kube-apiserver)가 필요하므로 KUBEBUILDER_ASSETS가 없으면 skip
+// (setup-envtest use -p path 로 설치). 같은 시나리오를 fake 클라이언트로 검증하는 테스트는 항상 실행된다.
+func startStorageHPAEnv(t *testing.T, k8sClient K8sClientInterface) ctrl.Manager {
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS not set, skipping envtest-based test")
+	}
+
+	testEnv := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "deployments", "crd")},
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := testEnv.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = testEnv.Stop() })
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, apollov1.AddToScheme(scheme))
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme:  scheme,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, NewStorageHPAReconciler(mgr.GetClient(), mgr.GetScheme(), k8sClient).SetupWithManager(mgr))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, mgr.Start(ctx))
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	return mgr
+}
+
+func TestStorageHPAReconcilerScalesDeployment(t *testing.T) {
+	mockClient := new(MockK8sClient)
+	// Storage Read 300 MB/s, 목표 100 MB/s → 2 replicas * 3 = 6 replicas
+	mockClient.On("GetWorkloadPodMetrics", mock.Anything, "default", "trainer").
+		Return(int32(40), int32(40), int32(0), int64(300), int64(0), int64(0), realWorkloadMetrics, nil)
+
+	mgr := startStorageHPAEnv(t, mockClient)
+	c := mgr.GetClient()
+	ctx := context.Background()
+
+	replicas := int32(2)
+	labels := map[string]string{"app": "trainer"}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "trainer", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "trainer", Image: "busybox"}},
+				},
+			},
+		},
+	}
+	require.NoError(t, c.Create(ctx, deployment))
+
+	targetRead := int64(100)
+	hpa := &apollov1.StorageHPA{
+		ObjectMeta: metav1.ObjectMeta{Name: "trainer-hpa", Namespace: "default"},
+		Spec: apollov1.StorageHPASpec{
+			WorkloadRef:                 apollov1.WorkloadReference{Name: "trainer", Kind: "Deployment"},
+			MinReplicas:                 1,
+			MaxReplicas:                 10,
+			TargetStorageReadThroughput: &targetRead,
+		},
+	}
+	require.NoError(t, c.Create(ctx, hpa))
+
+	key := ktypes.NamespacedName{Namespace: "default", Name: "trainer"}
+	assert.Eventually(t, func() bool {
+		var current appsv1.Deployment
+		if err := c.Get(ctx, key, &current); err != nil {
+			return false
+		}
+		return current.Spec.Replicas != nil && *current.Spec.Replicas == 6
+	}, 20*time.Second, 250*time.Millisecond, "deployment should be scaled to 6 replicas")
+
+	assert.Eventually(t, func() bool {
+		var current apollov1.StorageHPA
+		if err := c.Get(ctx, ktypes.NamespacedName{Namespace: "default", Name: "trainer-hpa"}, &current); err != nil {
+			return false
+		}
+		return current.Status.Phase == apollov1.StorageHPAPhaseActive &&
+			current.Status.DesiredReplicas == 6 &&
+			current.Status.ScaleUpCount >= 1
+	}, 20*time.Second, 250*time.Millisecond, "status should report the scale up")
+}
+
+func TestStorageHPAReconcilerMissingWorkload(t *testing.T) {
+	mgr := startStorageHPAEnv(t, new(MockK8sClient))
+	c := mgr.GetClient()
+	ctx := context.Background()
+
+	targetCPU := int32(50)
+	hpa := &apollov1.StorageHPA{
+		ObjectMeta: metav1.ObjectMeta{Name: "orphan-hpa", Namespace: "default"},
+		Spec: apollov1.StorageHPASpec{
+			WorkloadRef:      apollov1.WorkloadReference{Name: "does-not-exist", Kind: "Deployment"},
+			MinReplicas:      1,
+			MaxReplicas:      3,
+			TargetCPUPercent: &targetCPU,
+		},
+	}
+	require.NoError(t, c.Create(ctx, hpa))
+
+	assert.Eventually(t, func() bool {
+		var current apollov1.StorageHPA
+		if err := c.Get(ctx, ktypes.NamespacedName{Namespace: "default", Name: "orphan-hpa"}, &current); err != nil {
+			return false
+		}
+		return current.Status.Phase == apollov1.StorageHPAPhaseFailed
+	}, 20*time.Second, 250*time.Millisecond, "status should report the missing workload")
+}
+
+// newFakeStorageHPAReconciler returns a reconciler on a fake API server holding the given objects
+func newFakeStorageHPAReconciler(t *testing.T, k8sClient K8sClientInterface, objs ...client.Object) (*StorageHPAReconciler, client.Client) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, apollov1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&apollov1.StorageHPA{}).
+		Build()
+	return NewStorageHPAReconciler(c, scheme, k8sClient), c
+}
+
+// TestStorageHPAReconcileFakeClient runs the envtest scenarios against a fake API server,
+// so that the reconcile loop is covered without the envtest binaries
+func TestStorageHPAReconcileFakeClient(t *testing.T) {
+	ctx := context.Background()
+	targetRead := int64(100)
+	targetCPU := int32(50)
+
+	t.Run("scales deployment", func(t *testing.T) {
+		mockClient := new(MockK8sClient)
+		// Storage Read 300 MB/s, 목표 100 MB/s → 2 replicas * 3 = 6 replicas
+		mockClient.On("GetWorkloadPodMetrics", mock.Anything, "default", "trainer").
+			Return(int32(40), int32(40), int32(0), int64(300), int64(0), int64(0), realWorkloadMetrics, nil)
+
+		replicas := int32(2)
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "trainer", Namespace: "default"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		}
+		hpa := &apollov1.StorageHPA{
+			ObjectMeta: metav1.ObjectMeta{Name: "trainer-hpa", Namespace: "default"},
+			Spec: apollov1.StorageHPASpec{
+				WorkloadRef:                 apollov1.WorkloadReference{Name: "trainer", Kind: "Deployment"},
+				MinReplicas:                 1,
+				MaxReplicas:                 10,
+				TargetStorageReadThroughput: &targetRead,
+			},
+		}
+		r, c := newFakeStorageHPAReconciler(t, mockClient, deployment, hpa)
+
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: ktypes.NamespacedName{Namespace: "default", Name: "trainer-hpa"}})
+		require.NoError(t, err)
+		assert.Equal(t, defaultRequeueInterval, result.RequeueAfter)
+
+		var current appsv1.Deployment
+		require.NoError(t, c.Get(ctx, ktypes.NamespacedName{Namespace: "default", Name: "trainer"}, &current))
+		require.NotNil(t, current.Spec.Replicas)
+		assert.Equal(t, int32(6), *current.Spec.Replicas)
+
+		var status apollov1.StorageHPA
+		require.NoError(t, c.Get(ctx, ktypes.NamespacedName{Namespace: "default", Name: "trainer-hpa"}, &status))
+		assert.Equal(t, apollov1.StorageHPAPhaseActive, status.Status.Phase)
+		assert.Equal(t, int32(6), status.Status.DesiredReplicas)
+		assert.Equal(t, int64(1), status.Status.ScaleUpCount)
+	})
+
+	t.Run("missing workload", func(t *testing.T) {
+		hpa := &apollov1.StorageHPA{
+			ObjectMeta: metav1.ObjectMeta{Name: "orphan-hpa", Namespace: "default"},
+			Spec: apollov1.StorageHPASpec{
+				WorkloadRef:      apollov1.WorkloadReference{Name: "does-not-exist", Kind: "Deployment"},
+				MinReplicas:      1,
+				MaxReplicas:      3,
+				TargetCPUPercent: &targetCPU,
+			},
+		}
+		r, c := newFakeStorageHPAReconciler(t, new(MockK8sClient), hpa)
+
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: ktypes.NamespacedName{Namespace: "default", Name: "orphan-hpa"}})
+		require.NoError(t, err)
+
+		var status apollov1.StorageHPA
+		require.NoError(t, c.Get(ctx, ktypes.NamespacedName{Namespace: "default", Name: "orphan-hpa"}, &status))
+		assert.Equal(t, apollov1.StorageHPAPhaseFailed, status.Status.Phase)
+	})
+
+	t.Run("deleted storagehpa", func(t *testing.T) {
+		r, _ := newFakeStorageHPAReconciler(t, new(MockK8sClient))
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: ktypes.NamespacedName{Namespace: "default", Name: "gone"}})
+		require.NoError(t, err)
+		assert.Zero(t, result)
+	})
+}
+
+// TestStorageHPAForecasterFor tests that the forecaster follows spec.predictive
+func TestStorageHPAForecasterFor(t *testing.T) {
+	r := NewStorageHPAReconciler(nil, nil, new(MockK8sClient))
+	history := &scaleHistoryEntry{}
+	hpa := &apollov1.StorageHPA{
+		ObjectMeta: metav1.ObjectMeta{Name: "trainer-hpa", Namespace: "default"},
+		Spec: apollov1.StorageHPASpec{
+			Predictive: &apollov1.PredictiveScalingSpec{Mode: "holt_winters", SeasonLengthSeconds: 600},
+		},
+	}
+
+	f := r.forecasterFor(hpa, history)
+	require.NotNil(t, f)
+	assert.Equal(t, int32(defaultForecastHorizonSeconds), f.horizonSeconds)
+	assert.Same(t, f, r.forecasterFor(hpa, history), "history is kept while the spec is unchanged")
+
+	horizon := int32(120)
+	hpa.Spec.Predictive.HorizonSeconds = &horizon
+	changed := r.forecasterFor(hpa, history)
+	require.NotNil(t, changed)
+	assert.NotSame(t, f, changed)
+	assert.Equal(t, int32(120), forecastStatus(changed).HorizonSeconds)
+
+	// Horizon longer than the season: fall back to reactive scaling
+	horizon = 900
+	assert.Nil(t, r.forecasterFor(hpa, history))
+
+	hpa.Spec.Predictive = nil
+	assert.Nil(t, r.forecasterFor(hpa, history))
+	assert.Nil(t, forecastStatus(nil))
+}
+
+// TestStorageHPAWakeRequest tests the wake and queue-depth annotations
+func TestStorageHPAWakeRequest(t *testing.T) {
+	depth := int64(4)
+	hpa := &apollov1.StorageHPA{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm-hpa", Namespace: "inference"},
+		Spec: apollov1.StorageHPASpec{
+			ScaleToZero: &apollov1.ScaleToZeroSpec{IdleTimeoutSeconds: 300, ActivationQueueDepth: &depth},
+		},
+	}
+	state := &apollov1.ScaleToZeroStatus{}
+	assert.Empty(t, wakeRequest(hpa, state))
+
+	// A new wake annotation value wakes once
+	hpa.Annotations = map[string]string{apollov1.WakeAnnotation: "2026-10-16T09:00:00Z"}
+	assert.Equal(t, "wake annotation", wakeRequest(hpa, state))
+	assert.Equal(t, "2026-10-16T09:00:00Z", state.ObservedWakeRequest)
+	assert.Empty(t, wakeRequest(hpa, state))
+
+	// The queue depth wakes while it is at or above the activation threshold
+	hpa.Annotations[apollov1.QueueDepthAnnotation] = "3"
+	assert.Empty(t, wakeRequest(hpa, state))
+	hpa.Annotations[apollov1.QueueDepthAnnotation] = "4"
+	assert.Equal(t, "queue depth 4", wakeRequest(hpa, state))
+	hpa.Annotations[apollov1.QueueDepthAnnotation] = "many"
+	assert.Empty(t, wakeRequest(hpa, state))
+}
+
+// TestStorageHPACustomMetrics tests custom metric evaluation and its effect on the desired replicas
+func TestStorageHPACustomMetrics(t *testing.T) {
+	mockClient := new(MockK8sClient)
+	r := NewStorageHPAReconciler(nil, nil, mockClient)
+	targetCPU := int32(70)
+	hpa := &apollov1.StorageHPA{
+		ObjectMeta: metav1.ObjectMeta{Name: "vllm-hpa", Namespace: "inference"},
+		Spec: apollov1.StorageHPASpec{
+			WorkloadRef:      apollov1.WorkloadReference{Name: "vllm-server", Kind: "Deployment"},
+			MinReplicas:      1,
+			MaxReplicas:      8,
+			TargetCPUPercent: &targetCPU,
+			CustomMetrics: []apollov1.CustomMetricSpec{{
+				Name:        "queue_length",
+				Query:       `sum(vllm_num_requests_waiting{namespace="${namespace}"})`,
+				TargetType:  types.MetricTargetAverageValue,
+				TargetValue: resource.MustParse("10"),
+			}},
+		},
+	}
+	metrics := &metricsData{cpuPercent: 10, provenance: types.ProvenanceReal}
+
+	// Custom metrics are not evaluated outside the namespaces allowed to read other namespaces
+	hpa.Status.CustomMetrics = r.evaluateCustomMetrics(hpa, 2)
+	require.Len(t, hpa.Status.CustomMetrics, 1)
+	assert.Contains(t, hpa.Status.CustomMetrics[0].Error, "not allowed in namespace inference")
+	assert.Equal(t, int32(2), r.calculateDesiredReplicas(hpa, 2, metrics))
+	r.AllowCrossNamespace([]string{"inference"})
+
+	mockClient.On("QueryMetric", mock.Anything, `sum(vllm_num_requests_waiting{namespace="inference"})`).
+		Return(float64(45.5), types.ProvenanceReal, nil).Once()
+	hpa.Status.CustomMetrics = r.evaluateCustomMetrics(hpa, 2)
+	require.Len(t, hpa.Status.CustomMetrics, 1)
+	assert.Equal(t, "45500m", hpa.Status.CustomMetrics[0].CurrentValue.String())
+	assert.Equal(t, int32(5), r.calculateDesiredReplicas(hpa, 2, metrics))
+
+	// Query failure: CPU alone would scale down to 1, the custom metric holds the workload at 3
+	mockClient.On("QueryMetric", mock.Anything, `sum(vllm_num_requests_waiting{namespace="inference"})`).
+		Return(float64(0), types.MetricProvenance(""), fmt.Errorf("prometheus unavailable")).Once()
+	hpa.Status.CustomMetrics = r.evaluateCustomMetrics(hpa, 3)
+	assert.Equal(t, "prometheus unavailable", hpa.Status.CustomMetrics[0].Error)
+	assert.Nil(t, hpa.Status.CustomMetrics[0].CurrentValue)
+	assert.Equal(t, int32(3), r.calculateDesiredReplicas(hpa, 3, metrics))
+
+	// A zero target is rejected without querying
+	hpa.Spec.CustomMetrics[0].TargetValue = resource.MustParse("0")
+	hpa.Status.CustomMetrics = r.evaluateCustomMetrics(hpa, 3)
+	assert.Contains(t, hpa.Status.CustomMetrics[0].Error, "target value must be greater than 0")
+	mockClient.AssertExpectations(t)
+}
+
+func TestStorageHPAScalingPolicies(t *testing.T) {
+	r := NewStorageHPAReconciler(nil, nil, new(MockK8sClient))
+	tolerance := resource.MustParse("0.2")
+	hpa := &apollov1.StorageHPA{
+		ObjectMeta: metav1.ObjectMeta{Name: "embedding-hpa", Namespace: "inference"},
+		Spec: apollov1.StorageHPASpec{
+			WorkloadRef: apollov1.WorkloadReference{Name: "embedding-server", Kind: "Deployment"},
+			MinReplicas: 1,
+			MaxReplicas: 20,
+			ScaleDownPolicy: &apollov1.ScalingPolicySpec{
+				Tolerance: &tolerance,
+				Policies:  []apollov1.ScalingPolicyRuleSpec{{Type: types.ScalingPolicyPods, Value: 1, PeriodSeconds: 60}},
+			},
+		},
+	}
+	key := "inference/embedding-hpa"
+	r.scaleHistory[key] = &scaleHistoryEntry{}
+
+	targetCPU := int32(50)
+	hpa.Spec.TargetCPUPercent = &targetCPU
+	assert.Equal(t, int32(10), r.calculateDesiredReplicas(hpa, 10, &metricsData{cpuPercent: 41}), "within tolerance")
+	assert.Equal(t, int32(7), r.calculateDesiredReplicas(hpa, 10, &metricsData{cpuPercent: 35}))
+	assert.Equal(t, int32(9), r.applyStabilizationWindow(hpa, key, 10, 5))
+
+	// One replica already removed in this period
+	r.scaleHistory[key].scaleEvents = recordScaleEvent(nil, 10, 9, time.Now())
+	assert.Equal(t, int32(9), r.applyStabilizationWindow(hpa, key, 9, 5))
+
+	assert.True(t, meta.IsStatusConditionTrue(hpa.Status.Conditions, apollov1.ConditionTypeScalingPolicyValid))
+
+	// An invalid policy stops scaling in its direction and is reported in the status
+	hpa.Spec.ScaleDownPolicy.Policies[0].Type = "Replicas"
+	assert.Equal(t, int32(9), r.applyStabilizationWindow(hpa, key, 9, 5))
+	condition := meta.FindStatusCondition(hpa.Status.Conditions, apollov1.ConditionTypeScalingPolicyValid)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, "InvalidScalingPolicy", condition.Reason)
+	assert.Contains(t, condition.Message, "scaleDown policy: type must be")
+	assert.Equal(t, int32(12), r.applyStabilizationWindow(hpa, key, 9, 12), "scale-up is unaffected")
+
+	// Disabled with an invalid tolerance still keeps the current replicas
+	invalidTolerance := resource.MustParse("1.5")
+	hpa.Spec.ScaleDownPolicy = &apollov1.ScalingPolicySpec{SelectPolicy: types.SelectPolicyDisabled, Tolerance: &invalidTolerance}
+	assert.Equal(t, int32(9), r.applyStabilizationWindow(hpa, key, 9, 5))
+	assert.False(t, meta.IsStatusConditionTrue(hpa.Status.Conditions, apollov1.ConditionTypeScalingPolicyValid))
+
+	// Scaling to zero is a scale-down and is held as well
+	idleSince := metav1.NewTime(time.Now().Add(-time.Hour))
+	hpa.Spec.ScaleToZero = &apollov1.ScaleToZeroSpec{IdleTimeoutSeconds: 300}
+	hpa.Status.ScaleToZero = &apollov1.ScaleToZeroStatus{IdleSince: &idleSince}
+	scaled, err := r.scaleToZeroIfIdle(context.Background(), hpa, 9, &metricsData{signals: realWorkloadMetrics})
+	require.NoError(t, err)
+	assert.False(t, scaled)
+}
@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	apollov1 "ai-storage-orchestrator/api/v1"
+	"ai-storage-orchestrator/pkg/types"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// customMetricTargets converts the custom metrics of a StorageHPA spec to the targets shared with REST autoscalers
+func customMetricTargets(hpa *apollov1.StorageHPA) []types.CustomMetricTarget {
+	targets := make([]types.CustomMetricTarget, 0, len(hpa.Spec.CustomMetrics))
+	for _, m := range hpa.Spec.CustomMetrics {
+		targets = append(targets, types.CustomMetricTarget{
+			Name:        m.Name,
+			Query:       m.Query,
+			TargetType:  m.TargetType,
+			TargetValue: m.TargetValue.AsApproximateFloat64(),
+		})
+	}
+	return targets
+}
+
+// evaluateCustomMetrics runs the custom metric queries of a StorageHPA and returns them as status.
+// 설정 오류가 있거나 커스텀 메트릭이 허용되지 않은 네임스페이스이면 모든 커스텀 메트릭을 오류로 기록해 스케일 다운을 보류한다.
+func (r *StorageHPAReconciler) evaluateCustomMetrics(hpa *apollov1.StorageHPA, currentReplicas int32) []apollov1.CustomMetricStatus {
+	if len(hpa.Spec.CustomMetrics) == 0 {
+		return nil
+	}
+
+	targets := customMetricTargets(hpa)
+	var results []types.CustomMetricStatus
+	err := validateCustomMetrics(targets)
+	if err == nil && !r.namespaces.crossNamespace(hpa.Namespace) {
+		err = fmt.Errorf("custom metrics are not allowed in namespace %s: PromQL queries can read other namespaces", hpa.Namespace)
+	}
+	if err != nil {
+		log.Printf("[StorageHPA] %s: 커스텀 메트릭 설정 오류: %v", hpa.Name, err)
+		for _, t := range targets {
+			results = append(results, types.CustomMetricStatus{Name: t.Name, Error: err.Error()})
+		}
+	} else {
+		up, down, _ := scalingRulesOf(hpa)
+		results = evaluateCustomMetrics(r.K8sClient.QueryMetric, hpa.Namespace, hpa.Spec.WorkloadRef.Name, currentReplicas, targets, up, down)
+	}
+
+	statuses := make([]apollov1.CustomMetricStatus, 0, len(results))
+	for _, result := range results {
+		status := apollov1.CustomMetricStatus{
+			Name:            result.Name,
+			DesiredReplicas: result.DesiredReplicas,
+			Error:           result.Error,
+		}
+		if result.Error == "" {
+			status.CurrentValue = resource.NewMilliQuantity(int64(math.Round(result.CurrentValue*1000)), resource.DecimalSI)
+			log.Printf("[StorageHPA] %s: 커스텀 메트릭 %s = %s → %d replicas",
+				hpa.Name, result.Name, status.CurrentValue.String(), result.DesiredReplicas)
+		} else {
+			log.Printf("[StorageHPA] %s: 커스텀 메트릭 %s 사용 불가, 스케일 다운 보류: %s", hpa.Name, result.Name, result.Error)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// customMetricStatusRecommendations returns the recommendations of the custom metrics in the
+// status, and whether any of them is missing
+func customMetricStatusRecommendations(statuses []apollov1.CustomMetricStatus) ([]int32, bool) {
+	var recommendations []int32
+	missing := false
+	for _, s := range statuses {
+		if s.Error != "" {
+			missing = true
+			continue
+		}
+		recommendations = append(recommendations, s.DesiredReplicas)
+	}
+	return recommendations, missing
+}
@@ -0,0 +1,198 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	apollov1 "ai-storage-orchestrator/api/v1"
+	"ai-storage-orchestrator/pkg/types"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// coldStartPollInterval는 깨운 워크로드의 Ready 여부를 확인하는 주기
+const coldStartPollInterval = 5 * time.Second
+
+// wakeRequest returns why the StorageHPA is asked to wake its workload, "" without a request.
+// wake 어노테이션은 값이 바뀔 때마다 한 번, queue-depth 어노테이션은 임계값 이상인 동안 깨운다.
+func wakeRequest(hpa *apollov1.StorageHPA, state *apollov1.ScaleToZeroStatus) string {
+	if value, ok := hpa.Annotations[apollov1.WakeAnnotation]; ok && value != state.ObservedWakeRequest {
+		state.ObservedWakeRequest = value
+		return "wake annotation"
+	}
+	if value, ok := hpa.Annotations[apollov1.QueueDepthAnnotation]; ok {
+		depth, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			log.Printf("[StorageHPA] %s: 잘못된 %s 어노테이션 %q 무시", hpa.Name, apollov1.QueueDepthAnnotation, value)
+			return ""
+		}
+		if depth >= hpa.Spec.GetActivationQueueDepth() {
+			return fmt.Sprintf("queue depth %d", depth)
+		}
+	}
+	return ""
+}
+
+// reconcileScaleToZero handles a workload at zero replicas or waking up, before metrics are collected.
+// done이 true이면 이번 Reconcile은 여기서 끝난다 (0 레플리카에는 메트릭이 없고, 깨우는 중에는 스케일하지 않음).
+func (r *StorageHPAReconciler) reconcileScaleToZero(ctx context.Context, hpa *apollov1.StorageHPA, currentReplicas int32) (ctrl.Result, bool, error) {
+	if hpa.Status.ScaleToZero == nil {
+		hpa.Status.ScaleToZero = &apollov1.ScaleToZeroStatus{}
+		// 처음 본 wake 어노테이션은 이전 요청으로 간주
+		hpa.Status.ScaleToZero.ObservedWakeRequest = hpa.Annotations[apollov1.WakeAnnotation]
+	}
+	state := hpa.Status.ScaleToZero
+	reason := wakeRequest(hpa, state)
+	now := metav1.Now()
+
+	switch {
+	case state.ScaledToZero && reason == "":
+		result, err := r.updateStatusScaleToZero(ctx, hpa, 0, 0, "유휴 상태로 0 레플리카, 깨우기 대기")
+		return result, true, err
+
+	case state.ScaledToZero:
+		minReplicas := hpa.Spec.MinReplicas
+		if err := r.scaleWorkload(ctx, hpa, minReplicas); err != nil {
+			log.Printf("[StorageHPA] %s: 깨우기 실패: %v", hpa.Name, err)
+			result, err := r.updateStatusFailed(ctx, hpa, err.Error())
+			return result, true, err
+		}
+		state.ScaledToZero = false
+		state.Waking = true
+		state.IdleSince = nil
+		state.WakeCount++
+		state.LastWakeTime = &now
+		state.LastWakeReason = reason
+		hpa.Status.LastScaleTime = &now
+		hpa.Status.ScaleUpCount++
+		r.resetScaleHistory(hpa)
+		log.Printf("[StorageHPA] %s: 워크로드 깨움 0 → %d (%s)", hpa.Name, minReplicas, reason)
+
+		result, err := r.updateStatusScaleToZero(ctx, hpa, 0, minReplicas, fmt.Sprintf("워크로드 깨우는 중: %s", reason))
+		return result, true, err
+
+	case state.Waking:
+		ready, err := r.getReadyReplicas(ctx, hpa)
+		if err != nil {
+			result, err := r.updateStatusFailed(ctx, hpa, err.Error())
+			return result, true, err
+		}
+		if ready < 1 {
+			result, err := r.updateStatusScaleToZero(ctx, hpa, currentReplicas, hpa.Spec.MinReplicas, "콜드 스타트 중, 첫 레플리카 Ready 대기")
+			return result, true, err
+		}
+		state.Waking = false
+		if state.LastWakeTime != nil {
+			state.LastColdStartMilliseconds = now.Sub(state.LastWakeTime.Time).Milliseconds()
+		}
+		log.Printf("[StorageHPA] %s: 콜드 스타트 완료 (%dms)", hpa.Name, state.LastColdStartMilliseconds)
+		return ctrl.Result{}, false, nil
+
+	default:
+		if reason != "" {
+			// 실행 중인 워크로드에 대한 신호는 트래픽으로 보고 유휴 타이머를 다시 시작
+			state.IdleSince = nil
+		}
+		return ctrl.Result{}, false, nil
+	}
+}
+
+// scaleToZeroIfIdle scales the workload to zero once it has had no traffic or I/O for the idle timeout.
+// 0으로 스케일했으면 true를 반환한다.
+func (r *StorageHPAReconciler) scaleToZeroIfIdle(ctx context.Context, hpa *apollov1.StorageHPA, currentReplicas int32, metrics *metricsData) (bool, error) {
+	state := hpa.Status.ScaleToZero
+	if !isIdle(metrics.cpuPercent, metrics.gpuPercent, metrics.storageReadThroughput, metrics.storageWriteThroughput,
+		metrics.storageIOPS, metrics.signals) {
+		state.IdleSince = nil
+		return false, nil
+	}
+
+	now := metav1.Now()
+	if state.IdleSince == nil {
+		state.IdleSince = &now
+	}
+	idleFor := now.Sub(state.IdleSince.Time)
+	if idleFor < time.Duration(hpa.Spec.ScaleToZero.IdleTimeoutSeconds)*time.Second || currentReplicas == 0 {
+		return false, nil
+	}
+	// 0으로 스케일도 스케일 다운이므로 scaleDown이 Disabled(또는 설정 오류)이면 유지
+	if _, down, _ := scalingRulesOf(hpa); down.selectPolicy == types.SelectPolicyDisabled {
+		return false, nil
+	}
+
+	if err := r.scaleWorkload(ctx, hpa, 0); err != nil {
+		return false, err
+	}
+	state.ScaledToZero = true
+	state.ScaledToZeroAt = &now
+	state.ScaleToZeroCount++
+	state.IdleSince = nil
+	r.resetScaleHistory(hpa)
+	log.Printf("[StorageHPA] %s: %s 동안 유휴, 스케일 DOWN %d → 0", hpa.Name, idleFor.Round(time.Second), currentReplicas)
+	return true, nil
+}
+
+// resetScaleHistory clears the stabilization windows after a scale to or from zero
+func (r *StorageHPAReconciler) resetScaleHistory(hpa *apollov1.StorageHPA) {
+	if history := r.scaleHistory[fmt.Sprintf("%s/%s", hpa.Namespace, hpa.Name)]; history != nil {
+		history.scaleUpHistory = []scaleRecommendationEntry{}
+		history.scaleDownHistory = []scaleRecommendationEntry{}
+		history.scaleEvents = nil
+	}
+}
+
+// getReadyReplicas returns the number of ready replicas of the target workload
+func (r *StorageHPAReconciler) getReadyReplicas(ctx context.Context, hpa *apollov1.StorageHPA) (int32, error) {
+	key := ktypes.NamespacedName{Namespace: hpa.Namespace, Name: hpa.Spec.WorkloadRef.Name}
+	switch hpa.Spec.WorkloadRef.Kind {
+	case "Deployment":
+		var deployment appsv1.Deployment
+		if err := r.Get(ctx, key, &deployment); err != nil {
+			return 0, fmt.Errorf("Deployment 조회 실패: %w", err)
+		}
+		return deployment.Status.ReadyReplicas, nil
+
+	case "StatefulSet":
+		var statefulset appsv1.StatefulSet
+		if err := r.Get(ctx, key, &statefulset); err != nil {
+			return 0, fmt.Errorf("StatefulSet 조회 실패: %w", err)
+		}
+		return statefulset.Status.ReadyReplicas, nil
+
+	default:
+		return 0, fmt.Errorf("지원하지 않는 워크로드 종류: %s", hpa.Spec.WorkloadRef.Kind)
+	}
+}
+
+// updateStatusScaleToZero records a workload that is held at zero replicas or waking up
+func (r *StorageHPAReconciler) updateStatusScaleToZero(ctx context.Context, hpa *apollov1.StorageHPA, currentReplicas, desiredReplicas int32, message string) (ctrl.Result, error) {
+	now := metav1.Now()
+	hpa.Status.CurrentReplicas = currentReplicas
+	hpa.Status.DesiredReplicas = desiredReplicas
+	hpa.Status.Phase = apollov1.StorageHPAPhaseActive
+	hpa.Status.Message = message
+	hpa.Status.LastUpdated = &now
+	meta.SetStatusCondition(&hpa.Status.Conditions, metav1.Condition{
+		Type:               apollov1.ConditionTypeMetricsAvailable,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: hpa.Generation,
+		Reason:             "ScaledToZero",
+		Message:            "0 레플리카 또는 콜드 스타트 중에는 메트릭을 수집하지 않음",
+	})
+
+	if err := r.Status().Update(ctx, hpa); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if hpa.Status.ScaleToZero.Waking {
+		return ctrl.Result{RequeueAfter: coldStartPollInterval}, nil
+	}
+	return ctrl.Result{RequeueAfter: defaultRequeueInterval}, nil
+}
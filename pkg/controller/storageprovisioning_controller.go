@@ -0,0 +1,159 @@
+// Package controller implements the StorageProvisioning CRD controller
+// kubectl apply로 선언한 StorageProvisioning을 ProvisioningController 작업으로 실행
+// 리소스 삭제 시 finalizer로 프로비저닝(PVC)도 함께 정리
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	apollov1 "ai-storage-orchestrator/api/v1"
+	"ai-storage-orchestrator/pkg/types"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// StorageProvisioningReconciler reconciles a StorageProvisioning object
+type StorageProvisioningReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	Provisionings *ProvisioningController
+
+	started *jobTracker
+}
+
+// NewStorageProvisioningReconciler creates a new reconciler
+func NewStorageProvisioningReconciler(client client.Client, scheme *runtime.Scheme, provisionings *ProvisioningController) *StorageProvisioningReconciler {
+	return &StorageProvisioningReconciler{
+		Client:        client,
+		Scheme:        scheme,
+		Provisionings: provisionings,
+		started:       newJobTracker(),
+	}
+}
+
+// +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=storageprovisionings,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=storageprovisionings/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apollo.keti.re.kr,resources=storageprovisionings/finalizers,verbs=update
+
+// Reconcile provisions the storage described by a StorageProvisioning and mirrors its state
+func (r *StorageProvisioningReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var sp apollov1.StorageProvisioning
+	if err := r.Get(ctx, req.NamespacedName, &sp); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// 삭제 처리: 프로비저닝 정리 후 finalizer 제거
+	if !sp.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(&sp, apollov1.JobFinalizer) {
+			if sp.Status.JobID != "" {
+				if err := r.Provisionings.DeleteProvisioning(sp.Status.JobID); err != nil {
+					log.Printf("[StorageProvisioning] %s/%s: 프로비저닝 정리 생략: %v", sp.Namespace, sp.Name, err)
+				}
+			}
+			controllerutil.RemoveFinalizer(&sp, apollov1.JobFinalizer)
+			if err := r.Update(ctx, &sp); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		r.started.forget(sp.UID)
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(&sp, apollov1.JobFinalizer) {
+		controllerutil.AddFinalizer(&sp, apollov1.JobFinalizer)
+		if err := r.Update(ctx, &sp); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// 실패한 프로비저닝은 다시 실행하지 않음 (재시도는 리소스 재생성으로)
+	if sp.Status.Phase == jobPhase(string(types.ProvisioningStatusFailed)) {
+		return ctrl.Result{}, nil
+	}
+
+	// 1. 프로비저닝 시작
+	if sp.Status.JobID == "" {
+		jobID := r.started.get(sp.UID)
+		if jobID == "" {
+			resp, err := r.Provisionings.CreateProvisioning(&types.ProvisioningRequest{
+				WorkloadName:            sp.Spec.WorkloadName,
+				WorkloadNamespace:       sp.Namespace,
+				WorkloadType:            sp.Spec.WorkloadType,
+				StorageSize:             sp.Spec.StorageSize,
+				StorageClass:            sp.Spec.StorageClass,
+				AccessMode:              sp.Spec.AccessMode,
+				AutoSize:                sp.Spec.AutoSize,
+				RequiredReadThroughput:  sp.Spec.RequiredReadThroughput,
+				RequiredWriteThroughput: sp.Spec.RequiredWriteThroughput,
+				RequiredIOPS:            sp.Spec.RequiredIOPS,
+				MountPath:               sp.Spec.MountPath,
+				VolumeMode:              sp.Spec.VolumeMode,
+				Labels:                  sp.Spec.Labels,
+				Annotations:             sp.Spec.Annotations,
+			})
+			if err != nil {
+				setJobStatus(&sp.Status.JobStatus, sp.Generation, string(types.ProvisioningStatusFailed), err.Error(), false, true)
+				return ctrl.Result{}, r.Status().Update(ctx, &sp)
+			}
+			jobID = resp.ProvisioningID
+			r.started.set(sp.UID, jobID)
+			log.Printf("[StorageProvisioning] %s/%s: 프로비저닝 시작 (%s)", sp.Namespace, sp.Name, jobID)
+		}
+		startJobStatus(&sp.Status.JobStatus, jobID, sp.Generation)
+	}
+
+	// 2. 작업 상태 반영
+	ready, finished := r.mirrorStatus(&sp)
+	if err := r.Status().Update(ctx, &sp); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.started.forget(sp.UID)
+
+	switch {
+	case finished:
+		log.Printf("[StorageProvisioning] %s/%s: %s", sp.Namespace, sp.Name, sp.Status.Phase)
+		return ctrl.Result{}, nil
+	case ready:
+		// 준비 완료 후에는 마운트된 Pod 정보만 주기적으로 갱신
+		return ctrl.Result{RequeueAfter: defaultRequeueInterval}, nil
+	default:
+		return ctrl.Result{RequeueAfter: jobRequeueInterval}, nil
+	}
+}
+
+// mirrorStatus copies the provisioning job state into the StorageProvisioning status
+// and reports whether the storage is ready and whether the job has failed for good
+func (r *StorageProvisioningReconciler) mirrorStatus(sp *apollov1.StorageProvisioning) (ready, finished bool) {
+	resp, err := r.Provisionings.GetProvisioning(sp.Status.JobID)
+	if err != nil {
+		setJobStatus(&sp.Status.JobStatus, sp.Generation, string(types.ProvisioningStatusFailed),
+			fmt.Sprintf("%s: %s", jobLostMessage, sp.Status.JobID), false, true)
+		return false, true
+	}
+
+	if resp.Details != nil {
+		sp.Status.PVCName = resp.Details.PVCName
+		sp.Status.PVName = resp.Details.PVName
+		sp.Status.ActualSize = resp.Details.ActualSize
+		sp.Status.ActualClass = resp.Details.ActualClass
+		sp.Status.MountedPods = resp.Details.MountedPods
+	}
+
+	ready = resp.Status == types.ProvisioningStatusReady
+	finished = resp.Status == types.ProvisioningStatusFailed
+	setJobStatus(&sp.Status.JobStatus, sp.Generation, string(resp.Status), resp.Message, ready, ready || finished)
+	return ready, finished
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *StorageProvisioningReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&apollov1.StorageProvisioning{}, builder.WithPredicates(jobObjectPredicate())).
+		Complete(r)
+}
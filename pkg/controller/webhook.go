@@ -0,0 +1,495 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"ai-storage-orchestrator/pkg/eventbus"
+	"ai-storage-orchestrator/pkg/notify"
+	"ai-storage-orchestrator/pkg/store"
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultWebhookRetries is the number of retries after the first attempt when a request sets none
+	defaultWebhookRetries = 5
+	// maxWebhookRetries bounds max_retries of a webhook
+	maxWebhookRetries = 10
+	// webhookQueueSize is the number of events queued per target before they are dead-lettered
+	webhookQueueSize = 100
+	// webhookTimeout bounds a single delivery attempt
+	webhookTimeout = 10 * time.Second
+	// maxDeadLetters bounds the dead-letter records kept; the oldest are dropped first
+	maxDeadLetters = 500
+)
+
+// WebhookController delivers job events from the event bus to registered webhook targets
+type WebhookController struct {
+	webhooks    map[string]*webhookTarget
+	webhooksMux sync.RWMutex
+	deadLetters []*types.WebhookDeadLetter
+	sender      *notify.Sender
+	jobStore    store.JobStore
+
+	// Retry backoff: doubled per attempt from backoffBase up to backoffMax
+	backoffBase time.Duration
+	backoffMax  time.Duration
+}
+
+// webhookTarget is a registered webhook with its delivery worker
+type webhookTarget struct {
+	ID        string
+	Request   *types.WebhookRequest
+	Stats     types.WebhookStats
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+	queue     chan eventbus.Event
+	cancel    context.CancelFunc
+}
+
+// NewWebhookController creates a new webhook controller
+func NewWebhookController() *WebhookController {
+	return &WebhookController{
+		webhooks:    make(map[string]*webhookTarget),
+		sender:      notify.NewSender(webhookTimeout),
+		jobStore:    store.NewNopStore(),
+		backoffBase: time.Second,
+		backoffMax:  time.Minute,
+	}
+}
+
+// SetJobStore configures the store that webhook targets and dead letters are written through
+func (wc *WebhookController) SetJobStore(s store.JobStore) {
+	wc.jobStore = s
+}
+
+// RestoreJobs reloads persisted webhook targets and dead letters
+func (wc *WebhookController) RestoreJobs() error {
+	restored := 0
+	err := wc.jobStore.ForEach(store.KindWebhook, func(id string, data []byte) error {
+		var rec webhookRecord
+		if err := json.Unmarshal(data, &rec); err != nil {
+			log.Printf("Warning: Skipping corrupt webhook record %s: %v", id, err)
+			return nil
+		}
+		if rec.Request == nil {
+			log.Printf("Warning: Skipping webhook record %s without a request", id)
+			return nil
+		}
+		target := &webhookTarget{
+			ID:        rec.ID,
+			Request:   rec.Request,
+			Stats:     rec.Stats,
+			CreatedAt: rec.CreatedAt,
+			UpdatedAt: rec.UpdatedAt,
+		}
+		wc.webhooksMux.Lock()
+		wc.webhooks[target.ID] = target
+		wc.startWorker(target)
+		wc.webhooksMux.Unlock()
+		restored++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore webhooks: %w", err)
+	}
+
+	err = wc.jobStore.ForEach(store.KindDeadLetter, func(id string, data []byte) error {
+		var letter types.WebhookDeadLetter
+		if err := json.Unmarshal(data, &letter); err != nil {
+			log.Printf("Warning: Skipping corrupt dead letter %s: %v", id, err)
+			return nil
+		}
+		wc.deadLetters = append(wc.deadLetters, &letter)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore webhook dead letters: %w", err)
+	}
+	sort.Slice(wc.deadLetters, func(i, j int) bool {
+		return wc.deadLetters[i].FailedAt.Before(wc.deadLetters[j].FailedAt)
+	})
+
+	log.Printf("Restored %d webhooks and %d dead letters", restored, len(wc.deadLetters))
+	return nil
+}
+
+// persistTarget writes a webhook target to the job store; the caller holds webhooksMux
+func (wc *WebhookController) persistTarget(target *webhookTarget) {
+	saveRecord(wc.jobStore, store.KindWebhook, target.ID, &webhookRecord{
+		ID:        target.ID,
+		Request:   target.Request,
+		Stats:     target.Stats,
+		CreatedAt: target.CreatedAt,
+		UpdatedAt: target.UpdatedAt,
+	})
+}
+
+// Run delivers the events of the bus until ctx is cancelled. Events still in the bus history
+// are delivered first, so transitions of jobs restored at startup are not missed.
+func (wc *WebhookController) Run(ctx context.Context, bus *eventbus.Bus) {
+	sub, replay := bus.Resume(eventbus.Filter{}, 0)
+	var lastID uint64
+	for i := range replay {
+		wc.dispatch(&replay[i])
+		lastID = replay[i].ID
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			sub.Close()
+			return
+		case event, ok := <-sub.Events():
+			if !ok {
+				if ctx.Err() != nil {
+					return
+				}
+				// Dropped for falling behind: resume after the last dispatched event
+				log.Printf("Warning: Webhook dispatcher fell behind, resuming after event %d", lastID)
+				var replay []eventbus.Event
+				sub, replay = bus.Resume(eventbus.Filter{}, lastID)
+				for i := range replay {
+					wc.dispatch(&replay[i])
+					lastID = replay[i].ID
+				}
+				continue
+			}
+			wc.dispatch(&event)
+			lastID = event.ID
+		}
+	}
+}
+
+// dispatch queues an event for every enabled target whose filter matches
+func (wc *WebhookController) dispatch(event *eventbus.Event) {
+	wc.webhooksMux.RLock()
+	defer wc.webhooksMux.RUnlock()
+
+	for _, target := range wc.webhooks {
+		if target.Request.Disabled || !webhookFilterMatches(&target.Request.Filter, event) {
+			continue
+		}
+		select {
+		case target.queue <- *event:
+		default:
+			go wc.deadLetter(target.ID, event, nil, 0, 0, errors.New("delivery queue full"))
+		}
+	}
+}
+
+// startWorker starts the delivery worker of a target; the caller holds webhooksMux
+func (wc *WebhookController) startWorker(target *webhookTarget) {
+	ctx, cancel := context.WithCancel(context.Background())
+	target.queue = make(chan eventbus.Event, webhookQueueSize)
+	target.cancel = cancel
+
+	// 타깃별 워커가 이벤트를 순서대로 전달한다
+	go func(queue <-chan eventbus.Event) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-queue:
+				wc.deliver(ctx, target, &event)
+			}
+		}
+	}(target.queue)
+}
+
+// deliver sends an event to a target, retrying with backoff; failed deliveries are dead-lettered
+func (wc *WebhookController) deliver(ctx context.Context, target *webhookTarget, event *eventbus.Event) {
+	wc.webhooksMux.RLock()
+	req := *target.Request
+	wc.webhooksMux.RUnlock()
+	maxRetries := defaultWebhookRetries
+	if req.MaxRetries != nil {
+		maxRetries = *req.MaxRetries
+	}
+
+	body, err := notify.Payload(req.Format, target.ID, event)
+	if err != nil {
+		wc.deadLetter(target.ID, event, nil, 0, 0, err)
+		return
+	}
+
+	deliveryID := fmt.Sprintf("%s-%d", target.ID, event.ID)
+	for attempt := 1; ; attempt++ {
+		err := wc.sender.Send(ctx, req.URL, req.Secret, deliveryID, event, body)
+		if err == nil {
+			wc.webhooksMux.Lock()
+			now := time.Now()
+			target.Stats.Delivered++
+			target.Stats.LastDeliveryAt = &now
+			wc.webhooksMux.Unlock()
+			return
+		}
+		if ctx.Err() != nil {
+			return // target deleted while delivering
+		}
+
+		var deliveryErr *notify.DeliveryError
+		retryable := errors.As(err, &deliveryErr) && deliveryErr.Retryable()
+		if !retryable || attempt > maxRetries {
+			statusCode := 0
+			if deliveryErr != nil {
+				statusCode = deliveryErr.StatusCode
+			}
+			wc.deadLetter(target.ID, event, body, attempt, statusCode, err)
+			return
+		}
+
+		wait := notify.Backoff(attempt, wc.backoffBase, wc.backoffMax)
+		log.Printf("Webhook %s: Delivery of event %d failed (attempt %d/%d), retrying in %s: %v",
+			target.ID, event.ID, attempt, maxRetries+1, wait.Round(time.Millisecond), err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// deadLetter records a delivery that will not be attempted again
+func (wc *WebhookController) deadLetter(webhookID string, event *eventbus.Event, payload []byte, attempts, statusCode int, cause error) {
+	letter := &types.WebhookDeadLetter{
+		ID:             fmt.Sprintf("deadletter-%s", uuid.New().String()[:8]),
+		WebhookID:      webhookID,
+		EventID:        event.ID,
+		Kind:           event.Kind,
+		JobID:          event.JobID,
+		Payload:        payload,
+		Attempts:       attempts,
+		LastStatusCode: statusCode,
+		LastError:      cause.Error(),
+		FailedAt:       time.Now(),
+	}
+	log.Printf("Warning: Webhook %s: Dead-lettered event %d (%s %s) after %d attempts: %v",
+		webhookID, event.ID, event.Kind, event.JobID, attempts, cause)
+
+	wc.webhooksMux.Lock()
+	defer wc.webhooksMux.Unlock()
+
+	wc.deadLetters = append(wc.deadLetters, letter)
+	if len(wc.deadLetters) > maxDeadLetters {
+		for _, old := range wc.deadLetters[:len(wc.deadLetters)-maxDeadLetters] {
+			deleteRecord(wc.jobStore, store.KindDeadLetter, old.ID)
+		}
+		wc.deadLetters = append([]*types.WebhookDeadLetter(nil), wc.deadLetters[len(wc.deadLetters)-maxDeadLetters:]...)
+	}
+	saveRecord(wc.jobStore, store.KindDeadLetter, letter.ID, letter)
+
+	if target, ok := wc.webhooks[webhookID]; ok {
+		target.Stats.DeadLettered++
+		target.Stats.LastError = letter.LastError
+		wc.persistTarget(target)
+	}
+}
+
+// CreateWebhook registers a webhook target
+func (wc *WebhookController) CreateWebhook(req *types.WebhookRequest) (*types.WebhookResponse, error) {
+	if err := validateWebhookRequest(req); err != nil {
+		return nil, err
+	}
+	applyWebhookDefaults(req)
+
+	target := &webhookTarget{
+		ID:        fmt.Sprintf("webhook-%s", uuid.New().String()[:8]),
+		Request:   req,
+		CreatedAt: time.Now(),
+	}
+
+	wc.webhooksMux.Lock()
+	defer wc.webhooksMux.Unlock()
+	wc.webhooks[target.ID] = target
+	wc.startWorker(target)
+	wc.persistTarget(target)
+
+	log.Printf("Webhook %s registered: %s (%s)", target.ID, req.Name, req.Format)
+	return target.response(), nil
+}
+
+// GetWebhook returns a webhook target
+func (wc *WebhookController) GetWebhook(id string) (*types.WebhookResponse, error) {
+	wc.webhooksMux.RLock()
+	defer wc.webhooksMux.RUnlock()
+
+	target, exists := wc.webhooks[id]
+	if !exists {
+		return nil, fmt.Errorf("webhook %s not found", id)
+	}
+	return target.response(), nil
+}
+
+// ListWebhooks returns every webhook target, oldest first
+func (wc *WebhookController) ListWebhooks() []*types.WebhookResponse {
+	wc.webhooksMux.RLock()
+	defer wc.webhooksMux.RUnlock()
+
+	responses := make([]*types.WebhookResponse, 0, len(wc.webhooks))
+	for _, target := range wc.webhooks {
+		responses = append(responses, target.response())
+	}
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].CreatedAt.Before(responses[j].CreatedAt)
+	})
+	return responses
+}
+
+// UpdateWebhook replaces the settings of a webhook target. An empty secret keeps the current one,
+// since secrets are never returned to clients.
+func (wc *WebhookController) UpdateWebhook(id string, req *types.WebhookRequest) (*types.WebhookResponse, error) {
+	if err := validateWebhookRequest(req); err != nil {
+		return nil, err
+	}
+	applyWebhookDefaults(req)
+
+	wc.webhooksMux.Lock()
+	defer wc.webhooksMux.Unlock()
+
+	target, exists := wc.webhooks[id]
+	if !exists {
+		return nil, fmt.Errorf("webhook %s not found", id)
+	}
+	if req.Secret == "" {
+		req.Secret = target.Request.Secret
+	}
+	target.Request = req
+	now := time.Now()
+	target.UpdatedAt = &now
+	wc.persistTarget(target)
+
+	log.Printf("Webhook %s updated", id)
+	return target.response(), nil
+}
+
+// DeleteWebhook removes a webhook target; queued deliveries are dropped, dead letters are kept
+func (wc *WebhookController) DeleteWebhook(id string) error {
+	wc.webhooksMux.Lock()
+	defer wc.webhooksMux.Unlock()
+
+	target, exists := wc.webhooks[id]
+	if !exists {
+		return fmt.Errorf("webhook %s not found", id)
+	}
+	target.cancel()
+	delete(wc.webhooks, id)
+	deleteRecord(wc.jobStore, store.KindWebhook, id)
+
+	log.Printf("Webhook %s deleted", id)
+	return nil
+}
+
+// ListDeadLetters returns the dead letters of a webhook (all webhooks if webhookID is empty), newest first
+func (wc *WebhookController) ListDeadLetters(webhookID string) []*types.WebhookDeadLetter {
+	wc.webhooksMux.RLock()
+	defer wc.webhooksMux.RUnlock()
+
+	letters := make([]*types.WebhookDeadLetter, 0)
+	for i := len(wc.deadLetters) - 1; i >= 0; i-- {
+		if webhookID == "" || wc.deadLetters[i].WebhookID == webhookID {
+			letters = append(letters, wc.deadLetters[i])
+		}
+	}
+	return letters
+}
+
+// response converts a target to its API form; the caller holds webhooksMux
+func (t *webhookTarget) response() *types.WebhookResponse {
+	maxRetries := defaultWebhookRetries
+	if t.Request.MaxRetries != nil {
+		maxRetries = *t.Request.MaxRetries
+	}
+	return &types.WebhookResponse{
+		WebhookID:  t.ID,
+		Name:       t.Request.Name,
+		URL:        t.Request.URL,
+		Format:     t.Request.Format,
+		HasSecret:  t.Request.Secret != "",
+		Filter:     t.Request.Filter,
+		MaxRetries: maxRetries,
+		Disabled:   t.Request.Disabled,
+		CreatedAt:  t.CreatedAt,
+		UpdatedAt:  t.UpdatedAt,
+		Stats:      t.Stats,
+	}
+}
+
+// validateWebhookRequest validates a webhook request
+func validateWebhookRequest(req *types.WebhookRequest) error {
+	if req.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	u, err := url.Parse(req.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url must be an absolute http or https URL")
+	}
+	switch req.Format {
+	case "", types.WebhookFormatGeneric, types.WebhookFormatSlack, types.WebhookFormatTeams:
+	default:
+		return fmt.Errorf("invalid format %s (must be generic, slack or teams)", req.Format)
+	}
+	if req.MaxRetries != nil && (*req.MaxRetries < 0 || *req.MaxRetries > maxWebhookRetries) {
+		return fmt.Errorf("max_retries must be between 0 and %d", maxWebhookRetries)
+	}
+	for _, kind := range req.Filter.Kinds {
+		if !containsString(eventbus.Kinds, kind) {
+			return fmt.Errorf("invalid filter kind %s", kind)
+		}
+	}
+	for _, t := range req.Filter.Types {
+		valid := false
+		for _, known := range eventbus.Types {
+			if string(known) == t {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("invalid filter type %s", t)
+		}
+	}
+	return nil
+}
+
+// applyWebhookDefaults sets default values for a webhook request
+func applyWebhookDefaults(req *types.WebhookRequest) {
+	if req.Format == "" {
+		req.Format = types.WebhookFormatGeneric
+	}
+	if req.MaxRetries == nil {
+		retries := defaultWebhookRetries
+		req.MaxRetries = &retries
+	}
+}
+
+// webhookFilterMatches reports whether an event passes the filter of a webhook
+func webhookFilterMatches(f *types.WebhookFilter, event *eventbus.Event) bool {
+	return matchesAny(f.Kinds, event.Kind) &&
+		matchesAny(f.Types, string(event.Type)) &&
+		matchesAny(f.Statuses, event.Status) &&
+		matchesAny(f.Reasons, event.Reason) &&
+		matchesAny(f.Namespaces, event.Namespace)
+}
+
+// matchesAny reports whether value is in list; an empty list matches everything
+func matchesAny(list []string, value string) bool {
+	return len(list) == 0 || containsString(list, value)
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
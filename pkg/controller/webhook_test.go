@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"ai-storage-orchestrator/pkg/eventbus"
+	"ai-storage-orchestrator/pkg/notify"
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDelivery(t *testing.T) {
+	var mux sync.Mutex
+	calls := map[string]int{}
+	verified := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		defer mux.Unlock()
+		calls[r.URL.Path]++
+		if r.URL.Path == "/rejecting" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if calls[r.URL.Path] == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(notify.HeaderTimestamp), 10, 64)
+		if r.Header.Get(notify.HeaderSignature) == notify.Sign("s3cret", timestamp, body) {
+			verified++
+		}
+	}))
+	defer server.Close()
+
+	wc := NewWebhookController()
+	wc.backoffBase = time.Millisecond
+	wc.backoffMax = 5 * time.Millisecond
+
+	failures, err := wc.CreateWebhook(&types.WebhookRequest{
+		Name:   "migration-failures",
+		URL:    server.URL + "/hook",
+		Secret: "s3cret",
+		Filter: types.WebhookFilter{Kinds: []string{eventbus.KindMigration}, Statuses: []string{"failed"}},
+	})
+	require.NoError(t, err)
+	assert.True(t, failures.HasSecret)
+	assert.Equal(t, defaultWebhookRetries, failures.MaxRetries)
+
+	rejecting, err := wc.CreateWebhook(&types.WebhookRequest{
+		Name:   "rejecting",
+		URL:    server.URL + "/rejecting",
+		Filter: types.WebhookFilter{Reasons: []string{eventbus.ReasonMaxReplicasReached}},
+	})
+	require.NoError(t, err)
+
+	bus := eventbus.NewBus(eventbus.DefaultHistorySize)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go wc.Run(ctx, bus)
+
+	bus.Publish(eventbus.Event{Kind: eventbus.KindMigration, Type: eventbus.TypeStatus, JobID: "migration-1", Status: "running"})
+	bus.Publish(eventbus.Event{Kind: eventbus.KindMigration, Type: eventbus.TypeStatus, JobID: "migration-1", Status: "failed"})
+	bus.Publish(eventbus.Event{Kind: eventbus.KindAutoscaling, Type: eventbus.TypeOutcome, JobID: "autoscaling-1",
+		Reason: eventbus.ReasonMaxReplicasReached})
+
+	// The failed migration is delivered on the retry, the rejected event is dead-lettered without retries
+	require.Eventually(t, func() bool {
+		hook, _ := wc.GetWebhook(failures.WebhookID)
+		return hook.Stats.Delivered == 1 && len(wc.ListDeadLetters("")) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	mux.Lock()
+	assert.Equal(t, 2, calls["/hook"])
+	assert.Equal(t, 1, calls["/rejecting"])
+	assert.Equal(t, 1, verified)
+	mux.Unlock()
+
+	letters := wc.ListDeadLetters(rejecting.WebhookID)
+	require.Len(t, letters, 1)
+	assert.Equal(t, "autoscaling-1", letters[0].JobID)
+	assert.Equal(t, 1, letters[0].Attempts)
+	assert.Equal(t, http.StatusBadRequest, letters[0].LastStatusCode)
+	hook, _ := wc.GetWebhook(rejecting.WebhookID)
+	assert.Equal(t, int64(1), hook.Stats.DeadLettered)
+	assert.Empty(t, wc.ListDeadLetters(failures.WebhookID))
+}
+
+func TestWebhookValidationAndUpdate(t *testing.T) {
+	wc := NewWebhookController()
+
+	invalid := []*types.WebhookRequest{
+		{URL: "https://hooks.example.com"},
+		{Name: "no-scheme", URL: "hooks.example.com/x"},
+		{Name: "format", URL: "https://hooks.example.com", Format: "pager"},
+		{Name: "kind", URL: "https://hooks.example.com", Filter: types.WebhookFilter{Kinds: []string{"backup"}}},
+		{Name: "type", URL: "https://hooks.example.com", Filter: types.WebhookFilter{Types: []string{"progress"}}},
+	}
+	for _, req := range invalid {
+		_, err := wc.CreateWebhook(req)
+		assert.Error(t, err, req.Name)
+	}
+
+	created, err := wc.CreateWebhook(&types.WebhookRequest{Name: "slack", URL: "https://hooks.example.com", Format: types.WebhookFormatSlack, Secret: "s3cret"})
+	require.NoError(t, err)
+
+	// An update without secret keeps the current secret
+	updated, err := wc.UpdateWebhook(created.WebhookID, &types.WebhookRequest{Name: "slack", URL: "https://hooks.example.com/v2", Disabled: true})
+	require.NoError(t, err)
+	assert.True(t, updated.HasSecret)
+	assert.True(t, updated.Disabled)
+	assert.Equal(t, types.WebhookFormatGeneric, updated.Format)
+	assert.NotNil(t, updated.UpdatedAt)
+
+	require.NoError(t, wc.DeleteWebhook(created.WebhookID))
+	assert.Error(t, wc.DeleteWebhook(created.WebhookID))
+	assert.Empty(t, wc.ListWebhooks())
+}
+
+func TestWebhookFilterMatches(t *testing.T) {
+	event := &eventbus.Event{Kind: eventbus.KindPreemption, Type: eventbus.TypeOutcome, Namespace: "ml", Reason: "Preempted"}
+
+	assert.True(t, webhookFilterMatches(&types.WebhookFilter{}, event))
+	assert.True(t, webhookFilterMatches(&types.WebhookFilter{Kinds: []string{eventbus.KindPreemption}, Reasons: []string{"Preempted"}}, event))
+	assert.False(t, webhookFilterMatches(&types.WebhookFilter{Reasons: []string{"PreemptionFailed"}}, event))
+	assert.False(t, webhookFilterMatches(&types.WebhookFilter{Namespaces: []string{"default"}}, event))
+	assert.False(t, webhookFilterMatches(&types.WebhookFilter{Statuses: []string{"failed"}}, event))
+}
@@ -0,0 +1,221 @@
+// Package eventbus fans job progress published by the controllers out to API subscribers.
+// 컨트롤러가 발행한 작업 상태 전이/단계 진행/사이클 결과를 /api/v1/events 스트림으로 전달한다.
+package eventbus
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Job kinds that publish events
+const (
+	KindMigration     = "migration"
+	KindAutoscaling   = "autoscaling"
+	KindLoadbalancing = "loadbalancing"
+	KindProvisioning  = "provisioning"
+	KindPreemption    = "preemption"
+)
+
+// Kinds lists every kind a subscriber can filter on
+var Kinds = []string{KindMigration, KindAutoscaling, KindLoadbalancing, KindProvisioning, KindPreemption}
+
+// Type classifies an event
+type Type string
+
+const (
+	// TypeStatus is a job status transition
+	TypeStatus Type = "status"
+	// TypeStep is progress within a running job, such as a migration step
+	TypeStep Type = "step"
+	// TypeCycle is the result of one loadbalancing cycle
+	TypeCycle Type = "cycle"
+	// TypeOutcome is the result of an action on a single object, such as an eviction or a scale operation
+	TypeOutcome Type = "outcome"
+)
+
+// ReasonMaxReplicasReached is the reason of an autoscaling outcome that scaled a workload to its max replicas
+const ReasonMaxReplicasReached = "MaxReplicasReached"
+
+// Types lists every event type a subscriber can filter on
+var Types = []Type{TypeStatus, TypeStep, TypeCycle, TypeOutcome}
+
+const (
+	// DefaultHistorySize is the number of events kept for subscribers resuming with a last event ID
+	DefaultHistorySize = 256
+	// subscriberBuffer is the number of events queued per subscriber before it is dropped
+	subscriberBuffer = 64
+)
+
+// Event is one progress notification
+type Event struct {
+	ID        uint64      `json:"id"`
+	Time      time.Time   `json:"time"`
+	Kind      string      `json:"kind"`
+	Type      Type        `json:"type"`
+	JobID     string      `json:"job_id"`
+	Namespace string      `json:"namespace,omitempty"`
+	Status    string      `json:"status,omitempty"`
+	Step      string      `json:"step,omitempty"`
+	Reason    string      `json:"reason,omitempty"` // machine-readable outcome, e.g. Preempted, MaxReplicasReached
+	Message   string      `json:"message,omitempty"`
+	Data      interface{} `json:"data,omitempty"`
+}
+
+// Filter selects the events delivered to a subscriber; zero fields match everything
+type Filter struct {
+	Kinds     []string
+	JobID     string
+	Namespace string
+}
+
+// Matches reports whether the event passes the filter
+func (f Filter) Matches(e *Event) bool {
+	if len(f.Kinds) > 0 {
+		matched := false
+		for _, kind := range f.Kinds {
+			if strings.EqualFold(kind, e.Kind) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if f.JobID != "" && e.JobID != f.JobID {
+		return false
+	}
+	if f.Namespace != "" && e.Namespace != f.Namespace {
+		return false
+	}
+	return true
+}
+
+// Bus delivers published events to every matching subscriber.
+// Publishing never blocks: a subscriber that falls behind is closed and has to resubscribe.
+type Bus struct {
+	mux         sync.Mutex
+	nextID      uint64
+	history     []Event
+	historySize int
+	subscribers map[*Subscription]struct{}
+	closed      bool
+}
+
+// NewBus creates a bus keeping the last historySize events for replay
+func NewBus(historySize int) *Bus {
+	if historySize <= 0 {
+		historySize = DefaultHistorySize
+	}
+	return &Bus{
+		historySize: historySize,
+		history:     make([]Event, 0, historySize),
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish assigns the event an ID and delivers it. Publishing on a nil bus is a no-op,
+// so controllers need no check when streaming is not configured.
+func (b *Bus) Publish(e Event) {
+	if b == nil {
+		return
+	}
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.nextID++
+	e.ID = b.nextID
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	if len(b.history) == b.historySize {
+		copy(b.history, b.history[1:])
+		b.history = b.history[:len(b.history)-1]
+	}
+	b.history = append(b.history, e)
+
+	for sub := range b.subscribers {
+		if !sub.filter.Matches(&e) {
+			continue
+		}
+		select {
+		case sub.events <- e:
+		default:
+			// 느린 구독자는 끊고, 클라이언트가 마지막 ID로 재접속해 이어받게 한다
+			b.removeLocked(sub)
+		}
+	}
+}
+
+// Subscribe registers a subscriber for events published from now on
+func (b *Bus) Subscribe(filter Filter) *Subscription {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	return b.subscribeLocked(filter)
+}
+
+// Resume registers a subscriber and returns the events after lastID that are still in the history,
+// so a client that lost its connection does not miss events. Event IDs start at 1.
+func (b *Bus) Resume(filter Filter, lastID uint64) (*Subscription, []Event) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	var replay []Event
+	for _, e := range b.history {
+		if e.ID > lastID && filter.Matches(&e) {
+			replay = append(replay, e)
+		}
+	}
+	return b.subscribeLocked(filter), replay
+}
+
+func (b *Bus) subscribeLocked(filter Filter) *Subscription {
+	sub := &Subscription{bus: b, filter: filter, events: make(chan Event, subscriberBuffer)}
+	if b.closed {
+		close(sub.events)
+		return sub
+	}
+	b.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// Close ends every subscription so that streaming requests return, e.g. on server shutdown.
+// Events published afterwards are still kept in the history.
+func (b *Bus) Close() {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.closed = true
+	for sub := range b.subscribers {
+		b.removeLocked(sub)
+	}
+}
+
+// removeLocked unregisters a subscriber and closes its channel; b.mux must be held
+func (b *Bus) removeLocked(sub *Subscription) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.events)
+}
+
+// Subscription receives the events matching its filter
+type Subscription struct {
+	bus    *Bus
+	filter Filter
+	events chan Event
+}
+
+// Events returns the event channel; it is closed when the subscription is closed or dropped
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Close unsubscribes; closing twice is safe
+func (s *Subscription) Close() {
+	s.bus.mux.Lock()
+	defer s.bus.mux.Unlock()
+	s.bus.removeLocked(s)
+}
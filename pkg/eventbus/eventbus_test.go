@@ -0,0 +1,67 @@
+package eventbus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBus tests filtering, replay after a last event ID and closing subscriptions
+func TestBus(t *testing.T) {
+	bus := NewBus(3)
+
+	migrations := bus.Subscribe(Filter{Kinds: []string{KindMigration}, Namespace: "team-a"})
+	all := bus.Subscribe(Filter{})
+
+	bus.Publish(Event{Kind: KindMigration, Type: TypeStatus, JobID: "migration-1", Namespace: "team-a", Status: "running"})
+	bus.Publish(Event{Kind: KindMigration, Type: TypeStatus, JobID: "migration-2", Namespace: "team-b", Status: "running"})
+	bus.Publish(Event{Kind: KindPreemption, Type: TypeOutcome, JobID: "preemption-1", Namespace: "team-a"})
+	bus.Publish(Event{Kind: KindMigration, Type: TypeStep, JobID: "migration-1", Namespace: "team-a", Step: "checkpoint"})
+
+	got := <-migrations.Events()
+	assert.Equal(t, uint64(1), got.ID)
+	assert.False(t, got.Time.IsZero())
+	got = <-migrations.Events()
+	assert.Equal(t, "checkpoint", got.Step)
+	assert.Len(t, all.Events(), 4)
+
+	// Only the last 3 events are kept for replay
+	_, replay := bus.Resume(Filter{}, 0)
+	require.Len(t, replay, 3)
+	assert.Equal(t, uint64(2), replay[0].ID)
+	_, replay = bus.Resume(Filter{JobID: "migration-1"}, 1)
+	require.Len(t, replay, 1)
+	assert.Equal(t, uint64(4), replay[0].ID)
+
+	migrations.Close()
+	migrations.Close()
+	_, open := <-migrations.Events()
+	assert.False(t, open)
+
+	bus.Close()
+	for range all.Events() {
+	}
+	late := bus.Subscribe(Filter{})
+	_, open = <-late.Events()
+	assert.False(t, open, "subscriptions on a closed bus end immediately")
+
+	var nilBus *Bus
+	nilBus.Publish(Event{Kind: KindMigration})
+}
+
+// TestBusDropsSlowSubscriber tests that a full subscriber is dropped instead of blocking publishers
+func TestBusDropsSlowSubscriber(t *testing.T) {
+	bus := NewBus(0)
+	slow := bus.Subscribe(Filter{})
+
+	for i := 0; i < subscriberBuffer+1; i++ {
+		bus.Publish(Event{Kind: KindLoadbalancing, Type: TypeCycle, JobID: "loadbalancing-1"})
+	}
+
+	received := 0
+	for range slow.Events() {
+		received++
+	}
+	assert.Equal(t, subscriberBuffer, received)
+}
@@ -0,0 +1,419 @@
+// Package exporter exposes the orchestrator's controller metrics in the Prometheus exposition format.
+// 컨트롤러의 JSON 메트릭(/api/v1/.../metrics)을 scrape 시점에 읽어 Prometheus 메트릭으로 변환한다.
+package exporter
+
+import (
+	"net/http"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// namespace prefixes every exported metric name
+const namespace = "ai_storage_orchestrator"
+
+// MigrationDurationBuckets are the histogram buckets (seconds) of the migration duration
+var MigrationDurationBuckets = []float64{5, 15, 30, 60, 120, 300, 600, 1200, 1800, 3600}
+
+// MigrationSource is the part of the migration controller read by the exporter
+type MigrationSource interface {
+	GetMetrics() *types.MigrationMetrics
+	ListMigrations() []*types.MigrationResponse
+}
+
+// AutoscalingSource is the part of the autoscaling controller read by the exporter
+type AutoscalingSource interface {
+	GetMetrics() *types.AutoscalingMetrics
+	ListAutoscalers() []*types.AutoscalingResponse
+}
+
+// LoadbalancingSource is the part of the loadbalancing controller read by the exporter
+type LoadbalancingSource interface {
+	GetMetrics() *types.LoadbalancingMetrics
+	ListLoadbalancingJobs() []*types.LoadbalancingResponse
+}
+
+// PreemptionSource is the part of the preemption controller read by the exporter
+type PreemptionSource interface {
+	GetMetrics() *types.PreemptionMetrics
+	ListPreemptions() []*types.PreemptionResponse
+}
+
+// CachingSource is the part of the caching controller read by the exporter
+type CachingSource interface {
+	GetMetrics() *types.CachingMetrics
+	ListCaches() []*types.CachingResponse
+}
+
+// ProvisioningSource is the part of the provisioning controller read by the exporter
+type ProvisioningSource interface {
+	GetMetrics() *types.ProvisioningMetrics
+	ListProvisionings() []*types.ProvisioningResponse
+}
+
+// InsightSource is the part of the insight controller read by the exporter
+type InsightSource interface {
+	GetMetrics() *types.InsightMetrics
+}
+
+// Sources are the controllers to export; nil sources are skipped
+type Sources struct {
+	Migration     MigrationSource
+	Autoscaling   AutoscalingSource
+	Loadbalancing LoadbalancingSource
+	Preemption    PreemptionSource
+	Caching       CachingSource
+	Provisioning  ProvisioningSource
+	Insight       InsightSource
+}
+
+// Collector is a prometheus.Collector that snapshots the controllers on every scrape,
+// so the exported values always match the JSON metrics endpoints
+type Collector struct {
+	sources Sources
+
+	migrationsTotal    *prometheus.Desc
+	migrationsByStatus *prometheus.Desc
+	migrationDuration  *prometheus.Desc
+
+	autoscalersTotal      *prometheus.Desc
+	autoscalersByStatus   *prometheus.Desc
+	autoscalingScaleTotal *prometheus.Desc
+	autoscalingAvgCPU     *prometheus.Desc
+	autoscalingToZero     *prometheus.Desc
+	autoscalingWakes      *prometheus.Desc
+	autoscalingColdStart  *prometheus.Desc
+
+	loadbalancingJobsTotal       *prometheus.Desc
+	loadbalancingJobsActive      *prometheus.Desc
+	loadbalancingJobsByStatus    *prometheus.Desc
+	loadbalancingMigrationsTotal *prometheus.Desc
+	loadbalancingBalanceScore    *prometheus.Desc
+	loadbalancingLastRun         *prometheus.Desc
+
+	preemptionJobsTotal      *prometheus.Desc
+	preemptionJobsActive     *prometheus.Desc
+	preemptionJobsByStatus   *prometheus.Desc
+	preemptionPodsTotal      *prometheus.Desc
+	preemptionResultTotal    *prometheus.Desc
+	preemptionCPUFreed       *prometheus.Desc
+	preemptionMemoryFreed    *prometheus.Desc
+	preemptionGPUFreed       *prometheus.Desc
+	preemptionLastPreemption *prometheus.Desc
+
+	cachesTotal        *prometheus.Desc
+	cachesByStatus     *prometheus.Desc
+	cacheCachedBytes   *prometheus.Desc
+	cacheHitRatio      *prometheus.Desc
+	cacheTierHitRatio  *prometheus.Desc
+	cacheThroughput    *prometheus.Desc
+	cacheIOPS          *prometheus.Desc
+	cacheIOSavedBytes  *prometheus.Desc
+	cacheTimeSavedSecs *prometheus.Desc
+
+	provisioningsTotal      *prometheus.Desc
+	provisioningsByStatus   *prometheus.Desc
+	provisionedStorageBytes *prometheus.Desc
+	provisioningAvgTimeSecs *prometheus.Desc
+
+	insightReportsTotal    *prometheus.Desc
+	insightReportsByType   *prometheus.Desc
+	insightReportsByNS     *prometheus.Desc
+	insightActiveWorkloads *prometheus.Desc
+	insightLastReport      *prometheus.Desc
+}
+
+// NewCollector creates a collector for the given controllers
+func NewCollector(sources Sources) *Collector {
+	desc := func(subsystem, name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, name), help, labels, nil)
+	}
+
+	return &Collector{
+		sources: sources,
+
+		migrationsTotal:    desc("migration", "migrations_total", "Finished pod migrations by result.", "result"),
+		migrationsByStatus: desc("migration", "migrations", "Migrations currently known to the orchestrator by status.", "status"),
+		migrationDuration:  desc("migration", "duration_seconds", "Duration of completed pod migrations."),
+
+		autoscalersTotal:      desc("autoscaling", "autoscalers_created_total", "Autoscalers created."),
+		autoscalersByStatus:   desc("autoscaling", "autoscalers", "Autoscalers by status.", "status"),
+		autoscalingScaleTotal: desc("autoscaling", "scale_events_total", "Scaling actions by direction.", "direction"),
+		autoscalingAvgCPU:     desc("autoscaling", "average_cpu_utilization_percent", "Average CPU utilization across active autoscalers."),
+		autoscalingToZero:     desc("autoscaling", "scale_to_zero_total", "Idle workloads scaled to zero."),
+		autoscalingWakes:      desc("autoscaling", "wakes_total", "Workloads woken from zero replicas."),
+		autoscalingColdStart:  desc("autoscaling", "average_cold_start_seconds", "Average time from a wake to the first ready replica."),
+
+		loadbalancingJobsTotal:       desc("loadbalancing", "jobs_created_total", "Loadbalancing jobs created."),
+		loadbalancingJobsActive:      desc("loadbalancing", "jobs_active", "Loadbalancing jobs currently running."),
+		loadbalancingJobsByStatus:    desc("loadbalancing", "jobs", "Loadbalancing jobs by status.", "status"),
+		loadbalancingMigrationsTotal: desc("loadbalancing", "migrations_total", "Pod migrations executed by loadbalancing jobs by result.", "result"),
+		loadbalancingBalanceScore:    desc("loadbalancing", "average_balance_score", "Average cluster balance score after loadbalancing (0-100)."),
+		loadbalancingLastRun:         desc("loadbalancing", "last_run_timestamp_seconds", "Unix time of the last loadbalancing run."),
+
+		preemptionJobsTotal:      desc("preemption", "jobs_created_total", "Preemption jobs created."),
+		preemptionJobsActive:     desc("preemption", "jobs_active", "Preemption jobs currently running."),
+		preemptionJobsByStatus:   desc("preemption", "jobs", "Preemption jobs by status.", "status"),
+		preemptionPodsTotal:      desc("preemption", "pods_preempted_total", "Pods preempted."),
+		preemptionResultTotal:    desc("preemption", "preemptions_total", "Finished preemption jobs by result.", "result"),
+		preemptionCPUFreed:       desc("preemption", "cpu_freed_cores_total", "CPU freed by preemption in cores."),
+		preemptionMemoryFreed:    desc("preemption", "memory_freed_bytes_total", "Memory freed by preemption in bytes."),
+		preemptionGPUFreed:       desc("preemption", "gpu_freed_total", "GPUs freed by preemption."),
+		preemptionLastPreemption: desc("preemption", "last_preemption_timestamp_seconds", "Unix time of the last preemption."),
+
+		cachesTotal:        desc("caching", "caches_created_total", "Caches created."),
+		cachesByStatus:     desc("caching", "caches", "Caches by status and storage tier.", "status", "tier"),
+		cacheCachedBytes:   desc("caching", "cached_bytes", "Data currently held in caches."),
+		cacheHitRatio:      desc("caching", "hit_ratio", "Hit ratio across all caches (0-1)."),
+		cacheTierHitRatio:  desc("caching", "tier_hit_ratio", "Hit ratio of the caches on a storage tier (0-1).", "tier"),
+		cacheThroughput:    desc("caching", "throughput_mbps", "Current cache throughput by direction in MB/s.", "direction"),
+		cacheIOPS:          desc("caching", "iops", "Current cache IOPS."),
+		cacheIOSavedBytes:  desc("caching", "io_saved_bytes", "Estimated backend I/O saved by cache hits."),
+		cacheTimeSavedSecs: desc("caching", "time_saved_seconds", "Estimated I/O time saved by cache hits."),
+
+		provisioningsTotal:      desc("provisioning", "provisionings_created_total", "Storage provisionings created."),
+		provisioningsByStatus:   desc("provisioning", "provisionings", "Storage provisionings by status.", "status"),
+		provisionedStorageBytes: desc("provisioning", "storage_provisioned_bytes", "Storage capacity provisioned."),
+		provisioningAvgTimeSecs: desc("provisioning", "average_provision_time_seconds", "Average time until a provisioning is ready."),
+
+		insightReportsTotal:    desc("insight", "reports_total", "Workload signature reports received."),
+		insightReportsByType:   desc("insight", "reports_by_type_total", "Workload signature reports by workload type.", "type"),
+		insightReportsByNS:     desc("insight", "reports_by_namespace_total", "Workload signature reports by namespace.", "namespace"),
+		insightActiveWorkloads: desc("insight", "active_workloads", "Workloads with a current signature."),
+		insightLastReport:      desc("insight", "last_report_timestamp_seconds", "Unix time of the last workload signature report."),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+// Collect implements prometheus.Collector
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	if c.sources.Migration != nil {
+		c.collectMigration(ch)
+	}
+	if c.sources.Autoscaling != nil {
+		c.collectAutoscaling(ch)
+	}
+	if c.sources.Loadbalancing != nil {
+		c.collectLoadbalancing(ch)
+	}
+	if c.sources.Preemption != nil {
+		c.collectPreemption(ch)
+	}
+	if c.sources.Caching != nil {
+		c.collectCaching(ch)
+	}
+	if c.sources.Provisioning != nil {
+		c.collectProvisioning(ch)
+	}
+	if c.sources.Insight != nil {
+		c.collectInsight(ch)
+	}
+}
+
+func (c *Collector) collectMigration(ch chan<- prometheus.Metric) {
+	m := c.sources.Migration.GetMetrics()
+	ch <- prometheus.MustNewConstMetric(c.migrationsTotal, prometheus.CounterValue, float64(m.SuccessfulMigrations), "succeeded")
+	ch <- prometheus.MustNewConstMetric(c.migrationsTotal, prometheus.CounterValue, float64(m.FailedMigrations), "failed")
+
+	byStatus := make(map[string]int)
+	hist := newHistogram(MigrationDurationBuckets)
+	for _, migration := range c.sources.Migration.ListMigrations() {
+		byStatus[string(migration.Status)]++
+		if migration.Status == types.MigrationStatusCompleted && migration.Details != nil && migration.Details.Duration != nil {
+			hist.observe(migration.Details.Duration.Seconds())
+		}
+	}
+	emitByLabel(ch, c.migrationsByStatus, byStatus)
+	ch <- prometheus.MustNewConstHistogram(c.migrationDuration, hist.count, hist.sum, hist.buckets)
+}
+
+func (c *Collector) collectAutoscaling(ch chan<- prometheus.Metric) {
+	m := c.sources.Autoscaling.GetMetrics()
+	ch <- prometheus.MustNewConstMetric(c.autoscalersTotal, prometheus.CounterValue, float64(m.TotalAutoscalers))
+	ch <- prometheus.MustNewConstMetric(c.autoscalingScaleTotal, prometheus.CounterValue, float64(m.TotalScaleUps), "up")
+	ch <- prometheus.MustNewConstMetric(c.autoscalingScaleTotal, prometheus.CounterValue, float64(m.TotalScaleDowns), "down")
+	ch <- prometheus.MustNewConstMetric(c.autoscalingAvgCPU, prometheus.GaugeValue, m.AverageCPUUtilization)
+	ch <- prometheus.MustNewConstMetric(c.autoscalingToZero, prometheus.CounterValue, float64(m.TotalScaleToZero))
+	ch <- prometheus.MustNewConstMetric(c.autoscalingWakes, prometheus.CounterValue, float64(m.TotalWakes))
+	ch <- prometheus.MustNewConstMetric(c.autoscalingColdStart, prometheus.GaugeValue, m.AverageColdStartSeconds)
+
+	byStatus := make(map[string]int)
+	for _, autoscaler := range c.sources.Autoscaling.ListAutoscalers() {
+		byStatus[string(autoscaler.Status)]++
+	}
+	emitByLabel(ch, c.autoscalersByStatus, byStatus)
+}
+
+func (c *Collector) collectLoadbalancing(ch chan<- prometheus.Metric) {
+	m := c.sources.Loadbalancing.GetMetrics()
+	ch <- prometheus.MustNewConstMetric(c.loadbalancingJobsTotal, prometheus.CounterValue, float64(m.TotalLoadbalancingJobs))
+	ch <- prometheus.MustNewConstMetric(c.loadbalancingJobsActive, prometheus.GaugeValue, float64(m.ActiveLoadbalancingJobs))
+	ch <- prometheus.MustNewConstMetric(c.loadbalancingMigrationsTotal, prometheus.CounterValue, float64(m.SuccessfulMigrations), "succeeded")
+	ch <- prometheus.MustNewConstMetric(c.loadbalancingMigrationsTotal, prometheus.CounterValue, float64(m.FailedMigrations), "failed")
+	ch <- prometheus.MustNewConstMetric(c.loadbalancingBalanceScore, prometheus.GaugeValue, m.AverageBalanceScore)
+	if m.LastLoadbalancingTime != nil {
+		ch <- prometheus.MustNewConstMetric(c.loadbalancingLastRun, prometheus.GaugeValue, unixSeconds(*m.LastLoadbalancingTime))
+	}
+
+	byStatus := make(map[string]int)
+	for _, job := range c.sources.Loadbalancing.ListLoadbalancingJobs() {
+		byStatus[string(job.Status)]++
+	}
+	emitByLabel(ch, c.loadbalancingJobsByStatus, byStatus)
+}
+
+func (c *Collector) collectPreemption(ch chan<- prometheus.Metric) {
+	m := c.sources.Preemption.GetMetrics()
+	ch <- prometheus.MustNewConstMetric(c.preemptionJobsTotal, prometheus.CounterValue, float64(m.TotalPreemptionJobs))
+	ch <- prometheus.MustNewConstMetric(c.preemptionJobsActive, prometheus.GaugeValue, float64(m.ActivePreemptionJobs))
+	ch <- prometheus.MustNewConstMetric(c.preemptionPodsTotal, prometheus.CounterValue, float64(m.TotalPodsPreempted))
+	ch <- prometheus.MustNewConstMetric(c.preemptionResultTotal, prometheus.CounterValue, float64(m.SuccessfulPreemptions), "succeeded")
+	ch <- prometheus.MustNewConstMetric(c.preemptionResultTotal, prometheus.CounterValue, float64(m.FailedPreemptions), "failed")
+	ch <- prometheus.MustNewConstMetric(c.preemptionCPUFreed, prometheus.CounterValue, parseQuantity(m.TotalCPUFreed))
+	ch <- prometheus.MustNewConstMetric(c.preemptionMemoryFreed, prometheus.CounterValue, parseQuantity(m.TotalMemoryFreed))
+	ch <- prometheus.MustNewConstMetric(c.preemptionGPUFreed, prometheus.CounterValue, float64(m.TotalGPUFreed))
+	if m.LastPreemptionTime != nil {
+		ch <- prometheus.MustNewConstMetric(c.preemptionLastPreemption, prometheus.GaugeValue, unixSeconds(*m.LastPreemptionTime))
+	}
+
+	byStatus := make(map[string]int)
+	for _, job := range c.sources.Preemption.ListPreemptions() {
+		byStatus[string(job.Status)]++
+	}
+	emitByLabel(ch, c.preemptionJobsByStatus, byStatus)
+}
+
+func (c *Collector) collectCaching(ch chan<- prometheus.Metric) {
+	m := c.sources.Caching.GetMetrics()
+	ch <- prometheus.MustNewConstMetric(c.cachesTotal, prometheus.CounterValue, float64(m.TotalCaches))
+	ch <- prometheus.MustNewConstMetric(c.cacheCachedBytes, prometheus.GaugeValue, float64(m.TotalCachedBytes))
+	ch <- prometheus.MustNewConstMetric(c.cacheHitRatio, prometheus.GaugeValue, m.GlobalHitRatio)
+	ch <- prometheus.MustNewConstMetric(c.cacheThroughput, prometheus.GaugeValue, float64(m.TotalReadThroughputMBps), "read")
+	ch <- prometheus.MustNewConstMetric(c.cacheThroughput, prometheus.GaugeValue, float64(m.TotalWriteThroughputMBps), "write")
+	ch <- prometheus.MustNewConstMetric(c.cacheIOPS, prometheus.GaugeValue, float64(m.TotalIOPS))
+	ch <- prometheus.MustNewConstMetric(c.cacheIOSavedBytes, prometheus.GaugeValue, float64(m.EstimatedIOSavedBytes))
+	ch <- prometheus.MustNewConstMetric(c.cacheTimeSavedSecs, prometheus.GaugeValue, float64(m.EstimatedTimeSavedMs)/1000)
+
+	// 티어별 hit ratio: 티어에 속한 캐시들의 hit/request 합으로 계산
+	type statusTier struct{ status, tier string }
+	byStatus := make(map[statusTier]int)
+	hits := make(map[string]int64)
+	requests := make(map[string]int64)
+	for _, cache := range c.sources.Caching.ListCaches() {
+		tier := ""
+		if cache.Details != nil {
+			tier = string(cache.Details.TargetTier)
+		}
+		byStatus[statusTier{string(cache.Status), tier}]++
+
+		if cache.Details == nil || cache.Details.Stats == nil {
+			continue
+		}
+		hits[tier] += cache.Details.Stats.CacheHits
+		requests[tier] += cache.Details.Stats.TotalRequests
+	}
+	for key, count := range byStatus {
+		ch <- prometheus.MustNewConstMetric(c.cachesByStatus, prometheus.GaugeValue, float64(count), key.status, key.tier)
+	}
+	for tier, total := range requests {
+		ratio := 0.0
+		if total > 0 {
+			ratio = float64(hits[tier]) / float64(total)
+		}
+		ch <- prometheus.MustNewConstMetric(c.cacheTierHitRatio, prometheus.GaugeValue, ratio, tier)
+	}
+}
+
+func (c *Collector) collectProvisioning(ch chan<- prometheus.Metric) {
+	m := c.sources.Provisioning.GetMetrics()
+	ch <- prometheus.MustNewConstMetric(c.provisioningsTotal, prometheus.CounterValue, float64(m.TotalProvisionings))
+	ch <- prometheus.MustNewConstMetric(c.provisionedStorageBytes, prometheus.GaugeValue, parseQuantity(m.TotalStorageProvisioned))
+	ch <- prometheus.MustNewConstMetric(c.provisioningAvgTimeSecs, prometheus.GaugeValue, m.AverageProvisionTime)
+
+	byStatus := make(map[string]int)
+	for _, provisioning := range c.sources.Provisioning.ListProvisionings() {
+		byStatus[string(provisioning.Status)]++
+	}
+	emitByLabel(ch, c.provisioningsByStatus, byStatus)
+}
+
+func (c *Collector) collectInsight(ch chan<- prometheus.Metric) {
+	m := c.sources.Insight.GetMetrics()
+	ch <- prometheus.MustNewConstMetric(c.insightReportsTotal, prometheus.CounterValue, float64(m.TotalReports))
+	ch <- prometheus.MustNewConstMetric(c.insightActiveWorkloads, prometheus.GaugeValue, float64(m.ActiveWorkloads))
+	for workloadType, count := range m.ReportsByType {
+		ch <- prometheus.MustNewConstMetric(c.insightReportsByType, prometheus.CounterValue, float64(count), workloadType)
+	}
+	for ns, count := range m.ReportsByNamespace {
+		ch <- prometheus.MustNewConstMetric(c.insightReportsByNS, prometheus.CounterValue, float64(count), ns)
+	}
+	if !m.LastReportTime.IsZero() {
+		ch <- prometheus.MustNewConstMetric(c.insightLastReport, prometheus.GaugeValue, unixSeconds(m.LastReportTime))
+	}
+}
+
+// Handler returns an HTTP handler serving the collector together with the Go runtime and process metrics
+func Handler(sources Sources) http.Handler {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		NewCollector(sources),
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// histogram accumulates observations into cumulative buckets for MustNewConstHistogram
+type histogram struct {
+	bounds  []float64
+	buckets map[float64]uint64
+	count   uint64
+	sum     float64
+}
+
+func newHistogram(bounds []float64) *histogram {
+	buckets := make(map[float64]uint64, len(bounds))
+	for _, b := range bounds {
+		buckets[b] = 0
+	}
+	return &histogram{bounds: bounds, buckets: buckets}
+}
+
+func (h *histogram) observe(v float64) {
+	h.count++
+	h.sum += v
+	for _, b := range h.bounds {
+		if v <= b {
+			h.buckets[b]++
+		}
+	}
+}
+
+// emitByLabel emits one gauge per label value
+func emitByLabel(ch chan<- prometheus.Metric, desc *prometheus.Desc, counts map[string]int) {
+	for label, count := range counts {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(count), label)
+	}
+}
+
+// parseQuantity converts a Kubernetes quantity string (e.g. "2500m", "4Gi") to a float, 0 if invalid
+func parseQuantity(s string) float64 {
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return 0
+	}
+	return q.AsApproximateFloat64()
+}
+
+func unixSeconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
@@ -0,0 +1,117 @@
+package exporter
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ai-storage-orchestrator/pkg/controller"
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMigrationSource struct {
+	metrics    *types.MigrationMetrics
+	migrations []*types.MigrationResponse
+}
+
+func (f *fakeMigrationSource) GetMetrics() *types.MigrationMetrics        { return f.metrics }
+func (f *fakeMigrationSource) ListMigrations() []*types.MigrationResponse { return f.migrations }
+
+type fakeCachingSource struct {
+	metrics *types.CachingMetrics
+	caches  []*types.CachingResponse
+}
+
+func (f *fakeCachingSource) GetMetrics() *types.CachingMetrics    { return f.metrics }
+func (f *fakeCachingSource) ListCaches() []*types.CachingResponse { return f.caches }
+
+func completedMigration(id string, duration time.Duration) *types.MigrationResponse {
+	return &types.MigrationResponse{
+		MigrationID: id,
+		Status:      types.MigrationStatusCompleted,
+		Details:     &types.MigrationDetails{Duration: &duration},
+	}
+}
+
+func cache(tier types.StorageTier, hits, requests int64) *types.CachingResponse {
+	return &types.CachingResponse{
+		Status: types.CachingStatusActive,
+		Details: &types.CacheDetails{
+			TargetTier: tier,
+			Stats:      &types.CacheStats{CacheHits: hits, TotalRequests: requests},
+		},
+	}
+}
+
+func scrape(t *testing.T, sources Sources) string {
+	server := httptest.NewServer(Handler(sources))
+	defer server.Close()
+
+	resp, err := server.Client().Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, 200, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+// TestExporterMetrics tests the migration duration histogram and per-tier cache hit ratio
+func TestExporterMetrics(t *testing.T) {
+	body := scrape(t, Sources{
+		Migration: &fakeMigrationSource{
+			metrics: &types.MigrationMetrics{TotalMigrations: 3, SuccessfulMigrations: 2, FailedMigrations: 1},
+			migrations: []*types.MigrationResponse{
+				completedMigration("m1", 20*time.Second),
+				completedMigration("m2", 90*time.Second),
+				{MigrationID: "m3", Status: types.MigrationStatusFailed, Details: &types.MigrationDetails{}},
+			},
+		},
+		Caching: &fakeCachingSource{
+			metrics: &types.CachingMetrics{TotalCaches: 3, GlobalHitRatio: 0.5},
+			caches: []*types.CachingResponse{
+				cache(types.TierNVMe, 90, 100),
+				cache(types.TierNVMe, 10, 100),
+				cache(types.TierHDD, 0, 0),
+			},
+		},
+	})
+
+	assert.Contains(t, body, `ai_storage_orchestrator_migration_migrations_total{result="succeeded"} 2`)
+	assert.Contains(t, body, `ai_storage_orchestrator_migration_migrations_total{result="failed"} 1`)
+	assert.Contains(t, body, `ai_storage_orchestrator_migration_migrations{status="failed"} 1`)
+	assert.Contains(t, body, `ai_storage_orchestrator_migration_duration_seconds_bucket{le="15"} 0`)
+	assert.Contains(t, body, `ai_storage_orchestrator_migration_duration_seconds_bucket{le="30"} 1`)
+	assert.Contains(t, body, `ai_storage_orchestrator_migration_duration_seconds_bucket{le="120"} 2`)
+	assert.Contains(t, body, `ai_storage_orchestrator_migration_duration_seconds_sum 110`)
+	assert.Contains(t, body, `ai_storage_orchestrator_migration_duration_seconds_count 2`)
+
+	assert.Contains(t, body, `ai_storage_orchestrator_caching_tier_hit_ratio{tier="nvme"} 0.5`)
+	assert.Contains(t, body, `ai_storage_orchestrator_caching_tier_hit_ratio{tier="hdd"} 0`)
+	assert.Contains(t, body, `ai_storage_orchestrator_caching_caches{status="active",tier="nvme"} 2`)
+	assert.Contains(t, body, `ai_storage_orchestrator_caching_hit_ratio 0.5`)
+
+	// Sources that are not set are skipped
+	assert.NotContains(t, body, "ai_storage_orchestrator_autoscaling_")
+	assert.Contains(t, body, "go_goroutines")
+}
+
+// TestExporterControllers tests that freshly created controllers can be scraped
+func TestExporterControllers(t *testing.T) {
+	body := scrape(t, Sources{
+		Migration:    controller.NewMigrationController(nil),
+		Preemption:   controller.NewPreemptionController(nil),
+		Provisioning: controller.NewProvisioningController(nil),
+		Insight:      controller.NewInsightController(),
+	})
+
+	assert.Contains(t, body, `ai_storage_orchestrator_migration_duration_seconds_count 0`)
+	assert.Contains(t, body, `ai_storage_orchestrator_preemption_cpu_freed_cores_total 0`)
+	assert.Contains(t, body, `ai_storage_orchestrator_provisioning_storage_provisioned_bytes 0`)
+	assert.Contains(t, body, `ai_storage_orchestrator_insight_reports_total 0`)
+}
@@ -0,0 +1,270 @@
+// Package forecast predicts periodic workload demand from a short metric history.
+// 학습 에폭 경계마다 반복되는 데이터 로딩 버스트를 미리 예측해 오토스케일러가 선제적으로 스케일할 수 있게 한다.
+package forecast
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Forecasting methods selectable on autoscalers
+const (
+	// MethodHoltWinters is additive Holt-Winters (level, trend and season)
+	MethodHoltWinters = "holt_winters"
+	// MethodSeasonalMovingAverage averages the samples at the same phase of previous seasons
+	MethodSeasonalMovingAverage = "seasonal_moving_average"
+)
+
+// Methods lists every forecasting method
+var Methods = []string{MethodHoltWinters, MethodSeasonalMovingAverage}
+
+// Holt-Winters smoothing factors of level, trend and season
+const (
+	alpha = 0.5
+	beta  = 0.1
+	gamma = 0.3
+)
+
+// historySeasons is the number of seasons kept in the history ring
+const historySeasons = 3
+
+// errorSmoothing weights the latest forecast error in the exponentially weighted error
+const errorSmoothing = 0.2
+
+// IsMethod reports whether method is a known forecasting method
+func IsMethod(method string) bool {
+	for _, m := range Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// Ring is a fixed-size ring buffer of metric samples
+type Ring struct {
+	values []float64
+	start  int
+	size   int
+}
+
+// NewRing creates a ring holding the last capacity samples
+func NewRing(capacity int) *Ring {
+	return &Ring{values: make([]float64, capacity)}
+}
+
+// Add appends a sample, overwriting the oldest one when the ring is full
+func (r *Ring) Add(v float64) {
+	if len(r.values) == 0 {
+		return
+	}
+	if r.size < len(r.values) {
+		r.values[(r.start+r.size)%len(r.values)] = v
+		r.size++
+		return
+	}
+	r.values[r.start] = v
+	r.start = (r.start + 1) % len(r.values)
+}
+
+// Len returns the number of samples in the ring
+func (r *Ring) Len() int {
+	return r.size
+}
+
+// Values returns the samples, oldest first
+func (r *Ring) Values() []float64 {
+	out := make([]float64, r.size)
+	for i := 0; i < r.size; i++ {
+		out[i] = r.values[(r.start+i)%len(r.values)]
+	}
+	return out
+}
+
+// Predict forecasts the value horizon samples after the last sample of history, given a season of
+// season samples. It returns false while the history is too short: Holt-Winters needs two full
+// seasons to initialize its trend, the seasonal moving average one sample at the forecast phase.
+func Predict(method string, history []float64, season, horizon int) (float64, bool) {
+	if season < 2 || horizon < 1 {
+		return 0, false
+	}
+
+	var value float64
+	switch method {
+	case MethodHoltWinters:
+		if len(history) < 2*season {
+			return 0, false
+		}
+		value = holtWinters(history, season, horizon)
+	case MethodSeasonalMovingAverage:
+		var ok bool
+		if value, ok = seasonalMovingAverage(history, season, horizon); !ok {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	// 수요는 음수가 될 수 없다
+	return math.Max(value, 0), true
+}
+
+// holtWinters runs additive Holt-Winters over the history and forecasts horizon samples ahead.
+// The level and trend are initialized from the means of the first two seasons and the seasonal
+// components from the deviations of the first season.
+func holtWinters(x []float64, m, h int) float64 {
+	first := mean(x[:m])
+	second := mean(x[m : 2*m])
+	level := first
+	trend := (second - first) / float64(m)
+	seasonal := make([]float64, m)
+	for i := 0; i < m; i++ {
+		seasonal[i] = x[i] - first
+	}
+
+	for t := m; t < len(x); t++ {
+		s := seasonal[t%m]
+		prevLevel := level
+		level = alpha*(x[t]-s) + (1-alpha)*(level+trend)
+		trend = beta*(level-prevLevel) + (1-beta)*trend
+		seasonal[t%m] = gamma*(x[t]-level) + (1-gamma)*s
+	}
+
+	return level + float64(h)*trend + seasonal[(len(x)-1+h)%m]
+}
+
+// seasonalMovingAverage averages the samples of previous seasons at the phase of the forecast
+func seasonalMovingAverage(x []float64, m, h int) (float64, bool) {
+	target := len(x) - 1 + h
+	sum, count := 0.0, 0
+	for i := target - m; i >= 0; i -= m {
+		if i < len(x) {
+			sum += x[i]
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, false
+	}
+	return sum / float64(count), true
+}
+
+func mean(x []float64) float64 {
+	sum := 0.0
+	for _, v := range x {
+		sum += v
+	}
+	return sum / float64(len(x))
+}
+
+// Series keeps the history of one metric sampled at a fixed step, forecasts it and tracks how far
+// the forecasts were off once their time has come
+type Series struct {
+	method  string
+	step    time.Duration
+	season  int // samples per season
+	horizon int // samples between a sample and its forecast
+	history *Ring
+
+	lastSample time.Time
+	forecast   float64 // forecast of the latest sample
+	ready      bool    // whether forecast is set
+	// pending holds the forecasts of the next horizon samples, the oldest first (NaN: no forecast)
+	pending  []float64
+	expected float64 // forecast that was made for the latest sample, NaN if none
+	errorPct float64 // exponentially weighted absolute percentage error
+	errors   int
+}
+
+// NewSeries creates a series sampled every step. season is the period of the demand pattern,
+// e.g. the length of a training epoch, and horizon how far ahead forecasts look.
+func NewSeries(method string, step, season, horizon time.Duration) (*Series, error) {
+	if !IsMethod(method) {
+		return nil, fmt.Errorf("unknown forecasting method %q", method)
+	}
+	if step <= 0 {
+		return nil, fmt.Errorf("sampling step must be positive")
+	}
+	seasonSamples := int(season / step)
+	if seasonSamples < 2 {
+		return nil, fmt.Errorf("season of %s is shorter than two samples of %s", season, step)
+	}
+	horizonSamples := int((horizon + step - 1) / step)
+	if horizonSamples < 1 {
+		horizonSamples = 1
+	}
+
+	return &Series{
+		method:   method,
+		step:     step,
+		season:   seasonSamples,
+		horizon:  horizonSamples,
+		history:  NewRing(historySeasons * seasonSamples),
+		expected: math.NaN(),
+	}, nil
+}
+
+// Observe records the sample taken at t and returns the forecast for one horizon later; ok is
+// false while the history is too short. Samples closer than half a step to the previous one are not
+// recorded, so extra calls (e.g. additional reconciles) do not distort the season; they return the
+// forecast of the previous sample.
+func (s *Series) Observe(t time.Time, value float64) (forecast float64, ok bool) {
+	if !s.lastSample.IsZero() && t.Sub(s.lastSample) < s.step/2 {
+		return s.forecast, s.ready
+	}
+	s.lastSample = t
+
+	// 한 horizon 전에 이 시점을 예측한 값과 실제 값을 비교한다
+	s.expected = math.NaN()
+	if len(s.pending) == s.horizon {
+		s.expected = s.pending[0]
+		s.pending = s.pending[1:]
+		if !math.IsNaN(s.expected) {
+			errorPct := math.Abs(s.expected-value) / math.Max(math.Abs(value), 1) * 100
+			if s.errors == 0 {
+				s.errorPct = errorPct
+			} else {
+				s.errorPct = errorSmoothing*errorPct + (1-errorSmoothing)*s.errorPct
+			}
+			s.errors++
+		}
+	}
+
+	s.history.Add(value)
+	s.forecast, s.ready = Predict(s.method, s.history.Values(), s.season, s.horizon)
+	if s.ready {
+		s.pending = append(s.pending, s.forecast)
+	} else {
+		s.pending = append(s.pending, math.NaN())
+	}
+	return s.forecast, s.ready
+}
+
+// Snapshot describes the state of a series
+type Snapshot struct {
+	Samples int
+	// Expected is the forecast that was made for the latest sample; HasExpected is false without one
+	Expected    float64
+	HasExpected bool
+	// ErrorPercent is the exponentially weighted absolute percentage error of past forecasts
+	ErrorPercent float64
+}
+
+// Snapshot returns the current state of the series
+func (s *Series) Snapshot() Snapshot {
+	snapshot := Snapshot{
+		Samples:      s.history.Len(),
+		ErrorPercent: s.errorPct,
+	}
+	if !math.IsNaN(s.expected) {
+		snapshot.Expected = s.expected
+		snapshot.HasExpected = true
+	}
+	return snapshot
+}
+
+// Horizon returns the forecast horizon rounded up to whole samples
+func (s *Series) Horizon() time.Duration {
+	return time.Duration(s.horizon) * s.step
+}
@@ -0,0 +1,91 @@
+package forecast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// epoch is a season of 4 samples with a data-loading burst at the epoch boundary
+var epoch = []float64{100, 100, 100, 500}
+
+func repeat(season []float64, n int) []float64 {
+	var out []float64
+	for i := 0; i < n; i++ {
+		out = append(out, season...)
+	}
+	return out
+}
+
+// TestRing tests that the ring keeps the latest samples in order
+func TestRing(t *testing.T) {
+	r := NewRing(3)
+	for i := 1; i <= 5; i++ {
+		r.Add(float64(i))
+	}
+	assert.Equal(t, 3, r.Len())
+	assert.Equal(t, []float64{3, 4, 5}, r.Values())
+}
+
+// TestPredict tests that both methods anticipate the periodic burst
+func TestPredict(t *testing.T) {
+	// History ends just before the burst
+	history := repeat(epoch, 3)
+	history = history[:len(history)-1]
+
+	for _, method := range Methods {
+		t.Run(method, func(t *testing.T) {
+			burst, ok := Predict(method, history, 4, 1)
+			require.True(t, ok)
+			assert.InDelta(t, 500, burst, 50)
+
+			quiet, ok := Predict(method, history, 4, 2)
+			require.True(t, ok)
+			assert.InDelta(t, 100, quiet, 50)
+		})
+	}
+
+	// Not enough history yet
+	_, ok := Predict(MethodHoltWinters, epoch, 4, 1)
+	assert.False(t, ok)
+	_, ok = Predict(MethodSeasonalMovingAverage, epoch[:2], 4, 1)
+	assert.False(t, ok)
+	_, ok = Predict("linear", repeat(epoch, 3), 4, 1)
+	assert.False(t, ok)
+}
+
+// TestSeries tests sampling, forecast accuracy tracking and ignoring extra samples
+func TestSeries(t *testing.T) {
+	_, err := NewSeries(MethodHoltWinters, 15*time.Second, 20*time.Second, time.Minute)
+	assert.Error(t, err)
+	_, err = NewSeries("linear", 15*time.Second, time.Minute, time.Minute)
+	assert.Error(t, err)
+
+	s, err := NewSeries(MethodSeasonalMovingAverage, 15*time.Second, time.Minute, 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Second, s.Horizon())
+
+	start := time.Now()
+	var forecast float64
+	var ok bool
+	for i, v := range repeat(epoch, 3) {
+		forecast, ok = s.Observe(start.Add(time.Duration(i)*15*time.Second), v)
+	}
+	require.True(t, ok)
+	// The next sample starts a new epoch
+	assert.Equal(t, 100.0, forecast)
+
+	snapshot := s.Snapshot()
+	assert.Equal(t, 12, snapshot.Samples)
+	require.True(t, snapshot.HasExpected)
+	assert.Equal(t, 500.0, snapshot.Expected)
+	assert.InDelta(t, 0, snapshot.ErrorPercent, 0.01)
+
+	// A second sample within the same step is not recorded
+	again, ok := s.Observe(start.Add(11*15*time.Second+time.Second), 900)
+	assert.True(t, ok)
+	assert.Equal(t, forecast, again)
+	assert.Equal(t, 12, s.Snapshot().Samples)
+}
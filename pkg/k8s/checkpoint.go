@@ -0,0 +1,301 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ErrCheckpointUnsupported is returned when the node cannot checkpoint containers
+// (ContainerCheckpoint feature gate disabled or a container runtime without CRIU support)
+var ErrCheckpointUnsupported = errors.New("container checkpoint is not supported on this node")
+
+const (
+	// kubeletCheckpointDir is where the kubelet writes checkpoint archives on the node
+	kubeletCheckpointDir = "/var/lib/kubelet/checkpoints"
+	// checkpointMountPath is where the checkpoint PVC is mounted in migrated pods
+	checkpointMountPath = "/migration-checkpoint"
+	// checkpointArchiveMountPath is where the transfer pod sees the archives it builds images from
+	checkpointArchiveMountPath = "/checkpoints"
+	// checkpointCleanupTimeout bounds the helper pod that deletes archives from the node
+	checkpointCleanupTimeout = 2 * time.Minute
+	// checkpointAnnotation marks an OCI image as a CRI-O checkpoint of the named container
+	checkpointAnnotation = "io.kubernetes.cri-o.annotations.checkpoint.name"
+)
+
+// CheckpointContainer asks the kubelet on the node to checkpoint a running container
+// through the API server node proxy and returns the archive path on the node
+func (c *Client) CheckpointContainer(ctx context.Context, nodeName, namespace, podName, containerName string) (string, error) {
+	var statusCode int
+	raw, err := c.clientset.CoreV1().RESTClient().Post().
+		AbsPath("/api/v1/nodes", nodeName, "proxy", "checkpoint", namespace, podName, containerName).
+		Do(ctx).
+		StatusCode(&statusCode).
+		Raw()
+	if err != nil {
+		// 404: feature gate disabled, 500 "not implemented": runtime without checkpoint support
+		if statusCode == http.StatusNotFound || apierrors.IsNotFound(err) ||
+			strings.Contains(strings.ToLower(err.Error()), "not implemented") ||
+			strings.Contains(strings.ToLower(string(raw)), "not implemented") {
+			return "", fmt.Errorf("%w: %v", ErrCheckpointUnsupported, err)
+		}
+		return "", fmt.Errorf("failed to checkpoint container %s/%s/%s: %w", namespace, podName, containerName, err)
+	}
+
+	var result struct {
+		Items []string `json:"items"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return "", fmt.Errorf("failed to parse checkpoint response: %w", err)
+	}
+	if len(result.Items) == 0 {
+		return "", fmt.Errorf("kubelet returned no checkpoint archive for %s/%s/%s", namespace, podName, containerName)
+	}
+
+	return result.Items[0], nil
+}
+
+// RunCheckpointTransfer runs a helper pod on the source node that builds CRI-O checkpoint images from
+// the kubelet checkpoint archives and pushes them, then removes the archives from the node
+func (c *Client) RunCheckpointTransfer(ctx context.Context, transfer *types.CheckpointTransfer) error {
+	err := c.runHelperPod(ctx, buildCheckpointTransferPod(transfer))
+
+	// 아카이브에는 컨테이너 메모리가 그대로 들어있으므로 결과와 관계없이 노드에서 삭제
+	cleanupCtx, cancel := context.WithTimeout(context.Background(), checkpointCleanupTimeout)
+	defer cancel()
+	if cleanupErr := c.runHelperPod(cleanupCtx, buildCheckpointCleanupPod(transfer)); cleanupErr != nil {
+		log.Printf("Warning: failed to delete checkpoint archives on node %s: %v", transfer.NodeName, cleanupErr)
+	}
+
+	return err
+}
+
+// CopyImagePullSecret copies the docker config secret name from namespace from into namespace to,
+// so pods there can pull checkpoint images; a secret of that name already in the target is kept as is
+func (c *Client) CopyImagePullSecret(ctx context.Context, from, to, name string) error {
+	if from == to {
+		return nil
+	}
+	secrets := c.clientset.CoreV1().Secrets(to)
+	if _, err := secrets.Get(ctx, name, metav1.GetOptions{}); err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get secret %s/%s: %w", to, name, err)
+	}
+
+	source, err := c.clientset.CoreV1().Secrets(from).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get secret %s/%s: %w", from, name, err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: to,
+			Labels:    checkpointHelperLabels(),
+		},
+		Type: source.Type,
+		Data: source.Data,
+	}
+	if _, err := secrets.Create(ctx, secret, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create secret %s/%s: %w", to, name, err)
+	}
+	return nil
+}
+
+// hasImagePullSecret reports whether the pod already references the named imagePullSecret
+func hasImagePullSecret(pod *corev1.Pod, name string) bool {
+	for _, ref := range pod.Spec.ImagePullSecrets {
+		if ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// runHelperPod creates a run-to-completion pod, waits for it to finish and deletes it
+func (c *Client) runHelperPod(ctx context.Context, pod *corev1.Pod) error {
+	pods := c.clientset.CoreV1().Pods(pod.Namespace)
+	if _, err := pods.Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("failed to create helper pod %s: %w", pod.Name, err)
+	}
+	defer func() {
+		// The helper pod is removed whatever the outcome so it does not keep its host mounts
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := pods.Delete(cleanupCtx, pod.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			log.Printf("Warning: failed to delete helper pod %s/%s: %v", pod.Namespace, pod.Name, err)
+		}
+	}()
+
+	ticker := time.NewTicker(workloadPollInterval)
+	defer ticker.Stop()
+	for {
+		current, err := pods.Get(ctx, pod.Name, metav1.GetOptions{})
+		if err == nil {
+			switch current.Status.Phase {
+			case corev1.PodSucceeded:
+				return nil
+			case corev1.PodFailed:
+				return fmt.Errorf("helper pod %s failed: %s", pod.Name, current.Status.Message)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for helper pod %s: %w", pod.Name, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkpointArchiveContainers returns the checkpointed container names in a stable order
+func checkpointArchiveContainers(transfer *types.CheckpointTransfer) []string {
+	containers := make([]string, 0, len(transfer.Archives))
+	for name := range transfer.Archives {
+		containers = append(containers, name)
+	}
+	sort.Strings(containers)
+	return containers
+}
+
+// checkpointHelperLabels labels the helper pods that handle checkpoint archives
+func checkpointHelperLabels() map[string]string {
+	return map[string]string{
+		"app":       "ai-storage-orchestrator",
+		"component": "migration-checkpoint",
+	}
+}
+
+// buildCheckpointTransferPod builds the helper pod spec for RunCheckpointTransfer.
+// Each archive is mounted read-only on its own, so the pod cannot see other checkpoints on the node,
+// and buildah runs unprivileged with the vfs driver since the images are only assembled, never run.
+func buildCheckpointTransferPod(transfer *types.CheckpointTransfer) *corev1.Pod {
+	var script strings.Builder
+	script.WriteString("set -e\n")
+
+	hostPathType := corev1.HostPathFile
+	var mounts []corev1.VolumeMount
+	var volumes []corev1.Volume
+	for i, name := range checkpointArchiveContainers(transfer) {
+		image, ok := transfer.Images[name]
+		if !ok {
+			continue
+		}
+		volume := fmt.Sprintf("archive-%d", i)
+		archive := path.Join(checkpointArchiveMountPath, name+".tar")
+		mounts = append(mounts, corev1.VolumeMount{Name: volume, MountPath: archive, ReadOnly: true})
+		volumes = append(volumes, corev1.Volume{
+			Name: volume,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: path.Join(kubeletCheckpointDir, path.Base(transfer.Archives[name])),
+					Type: &hostPathType,
+				},
+			},
+		})
+
+		fmt.Fprintf(&script, "ctr=$(buildah from scratch)\n")
+		fmt.Fprintf(&script, "buildah add \"$ctr\" %q /\n", archive)
+		fmt.Fprintf(&script, "buildah config --annotation=%s=%s \"$ctr\"\n", checkpointAnnotation, name)
+		fmt.Fprintf(&script, "buildah commit \"$ctr\" %q\n", image)
+		if transfer.RegistrySecret != "" {
+			fmt.Fprintf(&script, "buildah push --authfile /auth/.dockerconfigjson %q\n", image)
+		} else {
+			fmt.Fprintf(&script, "buildah push %q\n", image)
+		}
+		fmt.Fprintf(&script, "buildah rm \"$ctr\"\n")
+	}
+
+	privileged := false
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      transfer.PodName,
+			Namespace: transfer.Namespace,
+			Labels:    checkpointHelperLabels(),
+		},
+		Spec: corev1.PodSpec{
+			NodeName:      transfer.NodeName,
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{{
+				Name:    "transfer",
+				Image:   transfer.Image,
+				Command: []string{"/bin/sh", "-c", script.String()},
+				Env: []corev1.EnvVar{
+					{Name: "STORAGE_DRIVER", Value: "vfs"},
+					{Name: "BUILDAH_ISOLATION", Value: "chroot"},
+				},
+				SecurityContext: &corev1.SecurityContext{
+					Privileged:               &privileged,
+					AllowPrivilegeEscalation: &privileged,
+				},
+				VolumeMounts: mounts,
+			}},
+			Volumes: volumes,
+		},
+	}
+
+	if transfer.RegistrySecret != "" {
+		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts,
+			corev1.VolumeMount{Name: "registry-auth", MountPath: "/auth", ReadOnly: true})
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: "registry-auth",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: transfer.RegistrySecret},
+			},
+		})
+	}
+
+	return pod
+}
+
+// buildCheckpointCleanupPod builds the helper pod that deletes the transferred archives from the node.
+// It only runs rm on the archive paths; unlinking needs the checkpoint directory itself to be mounted.
+func buildCheckpointCleanupPod(transfer *types.CheckpointTransfer) *corev1.Pod {
+	command := []string{"rm", "-f"}
+	for _, name := range checkpointArchiveContainers(transfer) {
+		command = append(command, path.Join(kubeletCheckpointDir, path.Base(transfer.Archives[name])))
+	}
+
+	hostPathType := corev1.HostPathDirectory
+	privileged := false
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      transfer.PodName + "-cleanup",
+			Namespace: transfer.Namespace,
+			Labels:    checkpointHelperLabels(),
+		},
+		Spec: corev1.PodSpec{
+			NodeName:      transfer.NodeName,
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{{
+				Name:    "cleanup",
+				Image:   transfer.CleanupImage,
+				Command: command,
+				SecurityContext: &corev1.SecurityContext{
+					Privileged:               &privileged,
+					AllowPrivilegeEscalation: &privileged,
+				},
+				VolumeMounts: []corev1.VolumeMount{{Name: "kubelet-checkpoints", MountPath: kubeletCheckpointDir}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "kubelet-checkpoints",
+				VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{Path: kubeletCheckpointDir, Type: &hostPathType},
+				},
+			}},
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// TestBuildCheckpointTransferPod tests the helper pods that build checkpoint images and delete the archives
+func TestBuildCheckpointTransferPod(t *testing.T) {
+	transfer := &types.CheckpointTransfer{
+		Namespace:    "kube-system",
+		PodName:      "migration-1234-checkpoint",
+		NodeName:     "node-1",
+		Image:        "quay.io/buildah/stable:v1.33",
+		CleanupImage: "busybox:1.36",
+		Archives: map[string]string{
+			"trainer": "/var/lib/kubelet/checkpoints/checkpoint-trainer_ai-workloads-trainer-2026.tar",
+		},
+		Images:         map[string]string{"trainer": "registry.local/trainer-trainer:migration-1234"},
+		RegistrySecret: "registry-auth",
+	}
+	pod := buildCheckpointTransferPod(transfer)
+
+	assert.Equal(t, "kube-system", pod.Namespace)
+	assert.Equal(t, "node-1", pod.Spec.NodeName)
+	require.Len(t, pod.Spec.Containers, 1)
+	container := pod.Spec.Containers[0]
+	script := container.Command[2]
+	assert.Contains(t, script, `buildah add "$ctr" "/checkpoints/trainer.tar" /`)
+	assert.Contains(t, script, "--annotation="+checkpointAnnotation+"=trainer")
+	assert.Contains(t, script, `buildah push --authfile /auth/.dockerconfigjson "registry.local/trainer-trainer:migration-1234"`)
+	assert.False(t, *container.SecurityContext.Privileged)
+	assert.False(t, *container.SecurityContext.AllowPrivilegeEscalation)
+
+	// 아카이브 파일 하나만 읽기 전용으로 마운트
+	require.Len(t, pod.Spec.Volumes, 2)
+	archive := pod.Spec.Volumes[0].HostPath
+	require.NotNil(t, archive)
+	assert.Equal(t, "/var/lib/kubelet/checkpoints/checkpoint-trainer_ai-workloads-trainer-2026.tar", archive.Path)
+	assert.Equal(t, corev1.HostPathFile, *archive.Type)
+	for _, mount := range container.VolumeMounts {
+		assert.True(t, mount.ReadOnly, mount.Name)
+		assert.NotEqual(t, kubeletCheckpointDir, mount.MountPath)
+	}
+
+	// 전송 후 노드의 아카이브 삭제
+	cleanup := buildCheckpointCleanupPod(transfer)
+	assert.Equal(t, "kube-system", cleanup.Namespace)
+	assert.Equal(t, "node-1", cleanup.Spec.NodeName)
+	assert.Equal(t, []string{"rm", "-f", "/var/lib/kubelet/checkpoints/checkpoint-trainer_ai-workloads-trainer-2026.tar"},
+		cleanup.Spec.Containers[0].Command)
+	assert.False(t, *cleanup.Spec.Containers[0].SecurityContext.Privileged)
+}
+
+// TestRestoredPodPullsCheckpointImagesWithSecret tests that the registry secret reaches the restored pod's namespace
+func TestRestoredPodPullsCheckpointImagesWithSecret(t *testing.T) {
+	ctx := context.Background()
+	registrySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkpoint-registry-auth", Namespace: "kube-system"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)},
+	}
+	original := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "trainer", Namespace: "ai-workloads"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "trainer", Image: "trainer:v1"},
+			{Name: "sidecar", Image: "sidecar:v1"},
+		}},
+	}
+	clientset := fake.NewSimpleClientset(registrySecret)
+	c := NewClientForClientset(clientset)
+
+	require.NoError(t, c.CopyImagePullSecret(ctx, "kube-system", "ai-workloads", "checkpoint-registry-auth"))
+	copied, err := clientset.CoreV1().Secrets("ai-workloads").Get(ctx, "checkpoint-registry-auth", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, corev1.SecretTypeDockerConfigJson, copied.Type)
+	assert.Equal(t, registrySecret.Data, copied.Data)
+	// 이미 있으면 그대로 둠
+	require.NoError(t, c.CopyImagePullSecret(ctx, "kube-system", "ai-workloads", "checkpoint-registry-auth"))
+
+	states := []types.ContainerState{
+		{Name: "trainer", ShouldMigrate: true},
+		{Name: "sidecar", ShouldMigrate: true},
+	}
+	pod, err := c.CreateOptimizedPod(ctx, original, "node-2", states, "",
+		map[string]string{"trainer": "registry.local/trainer-trainer:migration-1234"}, "checkpoint-registry-auth")
+	require.NoError(t, err)
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "checkpoint-registry-auth"}}, pod.Spec.ImagePullSecrets)
+	assert.Equal(t, "registry.local/trainer-trainer:migration-1234", pod.Spec.Containers[0].Image)
+	assert.Equal(t, "sidecar:v1", pod.Spec.Containers[1].Image)
+
+	// 체크포인트 이미지가 없으면 pull secret을 추가하지 않음
+	original.Namespace = "ai-batch"
+	pod, err = c.CreateOptimizedPod(ctx, original, "node-2", states, "", nil, "checkpoint-registry-auth")
+	require.NoError(t, err)
+	assert.Empty(t, pod.Spec.ImagePullSecrets)
+}
@@ -5,16 +5,19 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"math/rand"
+	"log"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
+	"ai-storage-orchestrator/pkg/metrics"
 	"ai-storage-orchestrator/pkg/types"
 
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -27,11 +30,17 @@ import (
 
 // Client wraps Kubernetes client with migration-specific functionality
 type Client struct {
-	clientset       kubernetes.Interface
+	clientset        kubernetes.Interface
 	metricsClientset metricsclientset.Interface
-	config          *rest.Config
+	metrics          metrics.Provider
+	events           *EventRecorder
+	config           *rest.Config
+	dcgmURL          string // DCGM exporter metrics endpoint, empty when GPU utilization is not collected
 }
 
+// DefaultDCGMExporterURL is the DCGM exporter metrics endpoint of deployments/dcgm-exporter.yaml
+const DefaultDCGMExporterURL = "http://dcgm-exporter.gpu-monitoring.svc.cluster.local:9400/metrics"
+
 // NewClient creates a new Kubernetes client
 func NewClient(kubeconfig string) (*Client, error) {
 	var config *rest.Config
@@ -60,10 +69,55 @@ func NewClient(kubeconfig string) (*Client, error) {
 	return &Client{
 		clientset:        clientset,
 		metricsClientset: metricsClientset,
+		metrics:          metrics.NewMetricsServerProvider(metricsClientset),
+		events:           NewEventRecorder(clientset),
 		config:           config,
+		dcgmURL:          DefaultDCGMExporterURL,
 	}, nil
 }
 
+// NewClientForClientset creates a client on an existing clientset, such as a fake one in tests.
+// Resource metrics stay unavailable until SetMetricsProvider is called, and GPU utilization is not collected.
+func NewClientForClientset(clientset kubernetes.Interface) *Client {
+	return &Client{
+		clientset: clientset,
+		metrics:   metrics.NewFakeProvider(nil),
+		events:    NewEventRecorder(clientset),
+	}
+}
+
+// SetDCGMExporterURL sets the DCGM exporter metrics endpoint GPU utilization is read from;
+// an empty URL disables it and GPU utilization is reported as unavailable
+func (c *Client) SetDCGMExporterURL(url string) {
+	c.dcgmURL = url
+}
+
+// SetMetricsProvider replaces the metrics provider (metrics-server by default)
+func (c *Client) SetMetricsProvider(provider metrics.Provider) {
+	c.metrics = provider
+}
+
+// MetricsProvider returns the metrics provider used by the client
+func (c *Client) MetricsProvider() metrics.Provider {
+	return c.metrics
+}
+
+// Clientset returns the Kubernetes clientset, used by the REST API to review tokens
+func (c *Client) Clientset() kubernetes.Interface {
+	return c.clientset
+}
+
+// MetricsClientset returns the metrics.k8s.io clientset, used to build a metrics-server provider
+func (c *Client) MetricsClientset() metricsclientset.Interface {
+	return c.metricsClientset
+}
+
+// RestConfig returns the rest config the client was built from, so that the
+// controller-runtime manager talks to the same cluster
+func (c *Client) RestConfig() *rest.Config {
+	return c.config
+}
+
 // GetPod retrieves a pod by name and namespace
 func (c *Client) GetPod(ctx context.Context, namespace, name string) (*corev1.Pod, error) {
 	return c.clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
@@ -149,7 +203,9 @@ func (c *Client) DeletePod(ctx context.Context, namespace, name string) error {
 }
 
 // CreateOptimizedPod creates a new pod with only running containers
-func (c *Client) CreateOptimizedPod(ctx context.Context, originalPod *corev1.Pod, targetNode string, containerStates []types.ContainerState, checkpointPVC string) (*corev1.Pod, error) {
+// Containers listed in restoreImages start from their CRI-O checkpoint image and resume the saved process state;
+// restorePullSecret, if set, is added to the pod's imagePullSecrets to pull those images
+func (c *Client) CreateOptimizedPod(ctx context.Context, originalPod *corev1.Pod, targetNode string, containerStates []types.ContainerState, checkpointPVC string, restoreImages map[string]string, restorePullSecret string) (*corev1.Pod, error) {
 	// Create new pod spec based on original but optimized
 	newPod := originalPod.DeepCopy()
 	
@@ -180,9 +236,14 @@ func (c *Client) CreateOptimizedPod(ctx context.Context, originalPod *corev1.Pod
 				if checkpointPVC != "" {
 					container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
 						Name:      "checkpoint-volume",
-						MountPath: "/migration-checkpoint",
+						MountPath: checkpointMountPath,
 					})
 				}
+				// Restore from checkpoint image if one was built for this container
+				if image, ok := restoreImages[container.Name]; ok {
+					container.Image = image
+					container.ImagePullPolicy = corev1.PullAlways
+				}
 				optimizedContainers = append(optimizedContainers, container)
 				break
 			}
@@ -190,6 +251,11 @@ func (c *Client) CreateOptimizedPod(ctx context.Context, originalPod *corev1.Pod
 	}
 	
 	newPod.Spec.Containers = optimizedContainers
+
+	// Pull secret for the checkpoint images, unless the pod already references it
+	if restorePullSecret != "" && len(restoreImages) > 0 && !hasImagePullSecret(newPod, restorePullSecret) {
+		newPod.Spec.ImagePullSecrets = append(newPod.Spec.ImagePullSecrets, corev1.LocalObjectReference{Name: restorePullSecret})
+	}
 	
 	// Add checkpoint volume if specified
 	if checkpointPVC != "" {
@@ -208,25 +274,15 @@ func (c *Client) CreateOptimizedPod(ctx context.Context, originalPod *corev1.Pod
 
 // GetPodMetrics retrieves CPU and memory metrics for a pod
 func (c *Client) GetPodMetrics(ctx context.Context, namespace, name string) (*types.ResourceUsage, error) {
-	podMetrics, err := c.metricsClientset.MetricsV1beta1().PodMetricses(namespace).Get(ctx, name, metav1.GetOptions{})
+	usage, err := c.metrics.PodUsage(ctx, namespace, name)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get pod metrics: %w", err)
-	}
-
-	var totalCPU, totalMemory int64
-	
-	for _, container := range podMetrics.Containers {
-		cpu := container.Usage[corev1.ResourceCPU]
-		memory := container.Usage[corev1.ResourceMemory]
-		
-		totalCPU += cpu.MilliValue()     // Convert to millicores
-		totalMemory += memory.Value()    // Bytes
+		return nil, fmt.Errorf("failed to get pod metrics from %s: %w", c.metrics.Name(), err)
 	}
 
 	return &types.ResourceUsage{
-		CPUUsage:    float64(totalCPU) / 1000.0, // Convert millicores to cores
-		MemoryUsage: totalMemory,
-		Timestamp:   podMetrics.Timestamp.Time,
+		CPUUsage:    float64(usage.CPUMilli) / 1000.0, // Convert millicores to cores
+		MemoryUsage: usage.MemoryBytes,
+		Timestamp:   usage.Timestamp,
 	}, nil
 }
 
@@ -285,6 +341,35 @@ func (c *Client) GetWorkloadReplicas(ctx context.Context, namespace, name, workl
 	}
 }
 
+// GetWorkloadReadyReplicas gets the number of ready replicas of a workload (Deployment, StatefulSet, ReplicaSet)
+func (c *Client) GetWorkloadReadyReplicas(ctx context.Context, namespace, name, workloadType string) (int32, error) {
+	switch workloadType {
+	case "Deployment":
+		deployment, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return 0, fmt.Errorf("failed to get deployment: %w", err)
+		}
+		return deployment.Status.ReadyReplicas, nil
+
+	case "StatefulSet":
+		statefulSet, err := c.clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return 0, fmt.Errorf("failed to get statefulset: %w", err)
+		}
+		return statefulSet.Status.ReadyReplicas, nil
+
+	case "ReplicaSet":
+		replicaSet, err := c.clientset.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return 0, fmt.Errorf("failed to get replicaset: %w", err)
+		}
+		return replicaSet.Status.ReadyReplicas, nil
+
+	default:
+		return 0, fmt.Errorf("unsupported workload type: %s", workloadType)
+	}
+}
+
 // ScaleWorkload scales a workload to the desired number of replicas
 func (c *Client) ScaleWorkload(ctx context.Context, namespace, name, workloadType string, replicas int32) error {
 	switch workloadType {
@@ -330,7 +415,9 @@ func (c *Client) ScaleWorkload(ctx context.Context, namespace, name, workloadTyp
 }
 
 // GetWorkloadPodMetrics gets the average CPU, Memory, GPU, and Storage I/O metrics for all pods in a workload
-func (c *Client) GetWorkloadPodMetrics(ctx context.Context, namespace, workloadName string) (cpuPercent, memoryPercent, gpuPercent int32, storageReadMBps, storageWriteMBps, storageIOPS int64, err error) {
+// provenance holds, per signal, the least trustworthy provenance of the samples the averages were built from;
+// a signal that could not be read for some pod is unavailable rather than a real zero
+func (c *Client) GetWorkloadPodMetrics(ctx context.Context, namespace, workloadName string) (cpuPercent, memoryPercent, gpuPercent int32, storageReadMBps, storageWriteMBps, storageIOPS int64, provenance types.WorkloadMetricsProvenance, err error) {
 	// Get label selector for the workload
 	// Try Deployment first, then StatefulSet, then ReplicaSet
 	var labelSelector string
@@ -362,16 +449,21 @@ func (c *Client) GetWorkloadPodMetrics(ctx context.Context, namespace, workloadN
 		LabelSelector: labelSelector,
 	})
 	if err != nil {
-		return 0, 0, 0, 0, 0, 0, fmt.Errorf("failed to list pods: %w", err)
+		return 0, 0, 0, 0, 0, 0, types.WorkloadMetricsProvenance{}, fmt.Errorf("failed to list pods: %w", err)
 	}
 
 	if len(pods.Items) == 0 {
-		return 0, 0, 0, 0, 0, 0, fmt.Errorf("no pods found for workload %s (selector: %s)", workloadName, labelSelector)
+		return 0, 0, 0, 0, 0, 0, types.WorkloadMetricsProvenance{}, fmt.Errorf("no pods found for workload %s (selector: %s)", workloadName, labelSelector)
 	}
 
 	var totalCPUPercent, totalMemoryPercent, totalGPUPercent int64
 	var totalStorageReadMBps, totalStorageWriteMBps, totalStorageIOPS int64
 	podCount := int64(0)
+	provenance = types.WorkloadMetricsProvenance{
+		Resource: types.ProvenanceReal,
+		GPU:      types.ProvenanceReal,
+		Storage:  types.ProvenanceReal,
+	}
 
 	for _, pod := range pods.Items {
 		// Skip pods that are not running
@@ -380,21 +472,17 @@ func (c *Client) GetWorkloadPodMetrics(ctx context.Context, namespace, workloadN
 		}
 
 		// Get pod metrics
-		podMetrics, err := c.metricsClientset.MetricsV1beta1().PodMetricses(namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		usage, err := c.metrics.PodUsage(ctx, namespace, pod.Name)
 		if err != nil {
 			// If metrics not available for this pod, skip it
 			continue
 		}
 
 		// Calculate resource usage for this pod
-		var podCPUMillis, podMemoryBytes int64
+		podCPUMillis, podMemoryBytes := usage.CPUMilli, usage.MemoryBytes
+		provenance.Resource = types.WorstProvenance(provenance.Resource, usage.Provenance)
 		var podCPURequests, podMemoryRequests int64
 
-		for _, container := range podMetrics.Containers {
-			podCPUMillis += container.Usage.Cpu().MilliValue()
-			podMemoryBytes += container.Usage.Memory().Value()
-		}
-
 		// Get resource requests from pod spec
 		for _, container := range pod.Spec.Containers {
 			if cpuReq := container.Resources.Requests.Cpu(); cpuReq != nil {
@@ -414,20 +502,25 @@ func (c *Client) GetWorkloadPodMetrics(ctx context.Context, namespace, workloadN
 		}
 
 		// GPU metrics - attempt to get from custom metrics or calculate from resource requests
-		gpuPercent := c.calculatePodGPUUtilization(&pod)
+		gpuPercent, gpuProvenance := c.calculatePodGPUUtilization(&pod)
 		totalGPUPercent += int64(gpuPercent)
-
-		// Storage I/O metrics - get from pod's cgroup stats or Prometheus
-		storageRead, storageWrite, iops := c.calculatePodStorageMetrics(ctx, &pod)
-		totalStorageReadMBps += storageRead
-		totalStorageWriteMBps += storageWrite
-		totalStorageIOPS += iops
+		provenance.GPU = types.WorstProvenance(provenance.GPU, gpuProvenance)
+
+		// Storage I/O metrics - from the metrics provider; a pod without them makes the averages unreliable
+		if storage := c.podStorageIO(ctx, &pod); storage != nil {
+			totalStorageReadMBps += storage.ReadMBps
+			totalStorageWriteMBps += storage.WriteMBps
+			totalStorageIOPS += storage.IOPS
+			provenance.Storage = types.WorstProvenance(provenance.Storage, storage.Provenance)
+		} else {
+			provenance.Storage = types.WorstProvenance(provenance.Storage, types.ProvenanceUnavailable)
+		}
 
 		podCount++
 	}
 
 	if podCount == 0 {
-		return 0, 0, 0, 0, 0, 0, fmt.Errorf("no running pods with metrics found for workload %s", workloadName)
+		return 0, 0, 0, 0, 0, 0, types.WorkloadMetricsProvenance{}, fmt.Errorf("no running pods with metrics found for workload %s", workloadName)
 	}
 
 	// Calculate average
@@ -438,12 +531,12 @@ func (c *Client) GetWorkloadPodMetrics(ctx context.Context, namespace, workloadN
 	avgStorageWrite := totalStorageWriteMBps / podCount
 	avgIOPS := totalStorageIOPS / podCount
 
-	return avgCPU, avgMemory, avgGPU, avgStorageRead, avgStorageWrite, avgIOPS, nil
+	return avgCPU, avgMemory, avgGPU, avgStorageRead, avgStorageWrite, avgIOPS, provenance, nil
 }
 
-// calculatePodGPUUtilization calculates GPU utilization for a pod
-// Attempts to get real GPU metrics via DCGM Exporter, falls back to simulation if unavailable
-func (c *Client) calculatePodGPUUtilization(pod *corev1.Pod) int32 {
+// calculatePodGPUUtilization calculates GPU utilization for a pod from the DCGM exporter.
+// Without DCGM data the utilization is reported as 0 with ProvenanceUnavailable so no decision is based on it.
+func (c *Client) calculatePodGPUUtilization(pod *corev1.Pod) (int32, types.MetricProvenance) {
 	// Check if pod has GPU resources requested
 	var hasGPU bool
 
@@ -461,12 +554,12 @@ func (c *Client) calculatePodGPUUtilization(pod *corev1.Pod) int32 {
 	}
 
 	if !hasGPU {
-		return 0
+		return 0, types.ProvenanceReal
 	}
 
 	// Only try to get metrics for running pods
 	if pod.Status.Phase != corev1.PodRunning {
-		return 0
+		return 0, types.ProvenanceReal
 	}
 
 	// Try to get real GPU metrics via DCGM Exporter
@@ -475,26 +568,22 @@ func (c *Client) calculatePodGPUUtilization(pod *corev1.Pod) int32 {
 
 	gpuUtil, err := c.getGPUUtilizationFromDCGM(ctx, pod.Namespace, pod.Name)
 	if err == nil && gpuUtil >= 0 {
-		return gpuUtil
+		return gpuUtil, types.ProvenanceReal
 	}
 
-	// Fallback: Simulate GPU utilization if DCGM metrics unavailable
-	// This happens when:
-	// - DCGM Exporter is not deployed
-	// - Pod-level metrics not yet available
-	// - Network issues accessing DCGM service
-	baseUtil := int32(60)
-	variance := int32(rand.New(rand.NewSource(time.Now().UnixNano())).Int31n(30))
-	return baseUtil + variance
+	// DCGM Exporter not deployed or not configured, pod-level metrics not yet available, or network issues
+	log.Printf("GPU utilization unavailable for pod %s/%s: %v", pod.Namespace, pod.Name, err)
+	return 0, types.ProvenanceUnavailable
 }
 
 // getGPUUtilizationFromDCGM queries DCGM Exporter for GPU utilization of a specific pod
 func (c *Client) getGPUUtilizationFromDCGM(ctx context.Context, namespace, podName string) (int32, error) {
-	// DCGM Exporter service endpoint
-	dcgmURL := "http://dcgm-exporter.gpu-monitoring.svc.cluster.local:9400/metrics"
+	if c.dcgmURL == "" {
+		return -1, fmt.Errorf("DCGM exporter endpoint not configured")
+	}
 
 	// Create HTTP request
-	req, err := http.NewRequestWithContext(ctx, "GET", dcgmURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", c.dcgmURL, nil)
 	if err != nil {
 		return -1, fmt.Errorf("failed to create DCGM request: %w", err)
 	}
@@ -679,34 +768,32 @@ func (c *Client) ListNodes(ctx context.Context) ([]string, error) {
 }
 
 // GetNodeMetrics gets CPU and Memory utilization percentage for a node
-func (c *Client) GetNodeMetrics(ctx context.Context, nodeName string) (cpuPercent, memoryPercent int32, err error) {
-	// Get node metrics from metrics-server
-	nodeMetrics, err := c.metricsClientset.MetricsV1beta1().NodeMetricses().Get(ctx, nodeName, metav1.GetOptions{})
+func (c *Client) GetNodeMetrics(ctx context.Context, nodeName string) (cpuPercent, memoryPercent int32, provenance types.MetricProvenance, err error) {
+	// Get node usage from the metrics provider
+	usage, err := c.metrics.NodeUsage(ctx, nodeName)
 	if err != nil {
-		return 0, 0, fmt.Errorf("failed to get node metrics: %w", err)
+		return 0, 0, "", fmt.Errorf("failed to get node metrics from %s: %w", c.metrics.Name(), err)
 	}
 
 	// Get node capacity
 	node, err := c.clientset.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
 	if err != nil {
-		return 0, 0, fmt.Errorf("failed to get node: %w", err)
+		return 0, 0, "", fmt.Errorf("failed to get node: %w", err)
 	}
 
 	// Calculate CPU percentage
-	cpuUsage := nodeMetrics.Usage.Cpu().MilliValue()
 	cpuCapacity := node.Status.Allocatable.Cpu().MilliValue()
 	if cpuCapacity > 0 {
-		cpuPercent = int32(float64(cpuUsage) / float64(cpuCapacity) * 100)
+		cpuPercent = int32(float64(usage.CPUMilli) / float64(cpuCapacity) * 100)
 	}
 
 	// Calculate Memory percentage
-	memUsage := nodeMetrics.Usage.Memory().Value()
 	memCapacity := node.Status.Allocatable.Memory().Value()
 	if memCapacity > 0 {
-		memoryPercent = int32(float64(memUsage) / float64(memCapacity) * 100)
+		memoryPercent = int32(float64(usage.MemoryBytes) / float64(memCapacity) * 100)
 	}
 
-	return cpuPercent, memoryPercent, nil
+	return cpuPercent, memoryPercent, usage.Provenance, nil
 }
 
 // GetNodeCapacity returns the total capacity of a node
@@ -770,9 +857,11 @@ func (c *Client) GetNodeLabel(ctx context.Context, nodeName string, labelKey str
 // GetNodeGPUUtilization returns GPU utilization percentage for a node
 func (c *Client) GetNodeGPUUtilization(ctx context.Context, nodeName string) (int32, error) {
 	// Query DCGM Exporter for node-level GPU metrics
-	dcgmURL := "http://dcgm-exporter.gpu-monitoring.svc.cluster.local:9400/metrics"
+	if c.dcgmURL == "" {
+		return 0, fmt.Errorf("DCGM exporter endpoint not configured")
+	}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", dcgmURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", c.dcgmURL, nil)
 	if err != nil {
 		return 0, fmt.Errorf("failed to create DCGM request: %w", err)
 	}
@@ -838,114 +927,14 @@ func (c *Client) GetNodeGPUUtilization(ctx context.Context, nodeName string) (in
 }
 
 // GetNodeStorageMetrics retrieves Storage I/O metrics for a node
-// Returns readMBps, writeMBps, iops, and utilization percentage
-func (c *Client) GetNodeStorageMetrics(ctx context.Context, nodeName string) (readMBps, writeMBps, iops int64, utilization int32, err error) {
-	// Query node_exporter or storage metrics provider for I/O metrics
-	// This could be node_exporter, custom CSD exporter, or Prometheus
-	nodeExporterURL := fmt.Sprintf("http://%s:9100/metrics", nodeName)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", nodeExporterURL, nil)
-	if err != nil {
-		// Fall back to simulated metrics if node_exporter is not available
-		return c.getSimulatedStorageMetrics(nodeName)
-	}
-
-	client := &http.Client{Timeout: 5 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		// Fall back to simulated metrics
-		return c.getSimulatedStorageMetrics(nodeName)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return c.getSimulatedStorageMetrics(nodeName)
-	}
-
-	body, err := io.ReadAll(resp.Body)
+// Returns readMBps, writeMBps, iops, and utilization percentage.
+// An error wrapping metrics.ErrUnavailable is returned when the provider has no data for the node.
+func (c *Client) GetNodeStorageMetrics(ctx context.Context, nodeName string) (readMBps, writeMBps, iops int64, utilization int32, provenance types.MetricProvenance, err error) {
+	sample, err := c.metrics.NodeStorageIO(ctx, nodeName)
 	if err != nil {
-		return c.getSimulatedStorageMetrics(nodeName)
+		return 0, 0, 0, 0, "", fmt.Errorf("failed to get node storage metrics from %s: %w", c.metrics.Name(), err)
 	}
-
-	// Parse Prometheus metrics for storage I/O
-	lines := strings.Split(string(body), "\n")
-	var totalReadBytes, totalWriteBytes float64
-	var readOps, writeOps float64
-
-	for _, line := range lines {
-		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
-			continue
-		}
-
-		// Parse node_disk_read_bytes_total
-		if strings.HasPrefix(line, "node_disk_read_bytes_total{") {
-			parts := strings.Fields(line)
-			if len(parts) >= 2 {
-				if val, e := strconv.ParseFloat(parts[len(parts)-1], 64); e == nil {
-					totalReadBytes += val
-				}
-			}
-		}
-
-		// Parse node_disk_written_bytes_total
-		if strings.HasPrefix(line, "node_disk_written_bytes_total{") {
-			parts := strings.Fields(line)
-			if len(parts) >= 2 {
-				if val, e := strconv.ParseFloat(parts[len(parts)-1], 64); e == nil {
-					totalWriteBytes += val
-				}
-			}
-		}
-
-		// Parse node_disk_reads_completed_total
-		if strings.HasPrefix(line, "node_disk_reads_completed_total{") {
-			parts := strings.Fields(line)
-			if len(parts) >= 2 {
-				if val, e := strconv.ParseFloat(parts[len(parts)-1], 64); e == nil {
-					readOps += val
-				}
-			}
-		}
-
-		// Parse node_disk_writes_completed_total
-		if strings.HasPrefix(line, "node_disk_writes_completed_total{") {
-			parts := strings.Fields(line)
-			if len(parts) >= 2 {
-				if val, e := strconv.ParseFloat(parts[len(parts)-1], 64); e == nil {
-					writeOps += val
-				}
-			}
-		}
-	}
-
-	// Convert to MB/s (assuming 1-second sampling interval for rate calculation)
-	// In production, this should use rate calculation from Prometheus
-	readMBps = int64(totalReadBytes / (1024 * 1024))
-	writeMBps = int64(totalWriteBytes / (1024 * 1024))
-	iops = int64(readOps + writeOps)
-
-	// Calculate utilization as percentage (simplified)
-	// In production, use node_disk_io_time_seconds_total for accurate utilization
-	utilization = int32(min(100, (readMBps+writeMBps)/10)) // Rough estimate
-
-	return readMBps, writeMBps, iops, utilization, nil
-}
-
-// getSimulatedStorageMetrics returns simulated storage metrics for testing
-func (c *Client) getSimulatedStorageMetrics(nodeName string) (readMBps, writeMBps, iops int64, utilization int32, err error) {
-	// Generate deterministic but varied metrics based on node name
-	hash := 0
-	for _, ch := range nodeName {
-		hash += int(ch)
-	}
-
-	// Simulate realistic storage I/O metrics
-	readMBps = int64(50 + (hash % 200))   // 50-250 MB/s
-	writeMBps = int64(20 + (hash % 100))  // 20-120 MB/s
-	iops = int64(1000 + (hash % 3000))    // 1000-4000 IOPS
-	utilization = int32(20 + (hash % 60)) // 20-80%
-
-	return readMBps, writeMBps, iops, utilization, nil
+	return sample.ReadMBps, sample.WriteMBps, sample.IOPS, sample.Utilization, sample.Provenance, nil
 }
 
 // ListPodsOnNode returns a list of pod names running on a specific node
@@ -972,150 +961,24 @@ func (c *Client) ListPodsOnNode(ctx context.Context, nodeName string) ([]types.P
 	return pods, nil
 }
 
-// calculatePodStorageMetrics calculates storage I/O metrics for a pod
-// Returns: (read throughput MB/s, write throughput MB/s, IOPS)
+// podStorageIO returns the storage I/O of a pod, or nil when the provider has no data for it
 // For AI/ML workloads, storage I/O is critical for data loading performance
-func (c *Client) calculatePodStorageMetrics(ctx context.Context, pod *corev1.Pod) (readMBps, writeMBps, iops int64) {
-	// Try to get real storage metrics from node exporter or cAdvisor
-	// Priority 1: Query Prometheus node-exporter for container-level disk I/O
-	realRead, realWrite, realIOPS, err := c.getStorageMetricsFromPrometheus(ctx, pod.Namespace, pod.Name)
-	if err == nil && (realRead > 0 || realWrite > 0 || realIOPS > 0) {
-		return realRead, realWrite, realIOPS
-	}
-
-	// Priority 2: Estimate from PVC usage patterns
-	estimatedRead, estimatedWrite, estimatedIOPS := c.estimateStorageMetricsFromPVC(ctx, pod)
-	if estimatedRead > 0 || estimatedWrite > 0 || estimatedIOPS > 0 {
-		return estimatedRead, estimatedWrite, estimatedIOPS
-	}
-
-	// Fallback: Simulate storage metrics for AI/ML workloads
-	// AI training typically has high read throughput (dataset loading)
-	// and moderate write throughput (checkpoints, logs)
-
-	// Check if pod has PVCs (indicates data-intensive workload)
-	hasPVC := false
-	for _, vol := range pod.Spec.Volumes {
-		if vol.PersistentVolumeClaim != nil {
-			hasPVC = true
-			break
-		}
-	}
-
-	if hasPVC {
-		// Simulate typical AI training I/O pattern
-		// Read: 200-800 MB/s (dataset streaming)
-		// Write: 50-200 MB/s (checkpoint saving)
-		// IOPS: 1000-5000 (mixed read/write)
-		baseRead := int64(400 + rand.New(rand.NewSource(time.Now().UnixNano())).Int63n(400))
-		baseWrite := int64(100 + rand.New(rand.NewSource(time.Now().UnixNano())).Int63n(100))
-		baseIOPS := int64(2000 + rand.New(rand.NewSource(time.Now().UnixNano())).Int63n(3000))
-
-		return baseRead, baseWrite, baseIOPS
-	}
-
-	// No PVC - minimal storage I/O
-	return 0, 0, 0
-}
-
-// getStorageMetricsFromPrometheus queries Prometheus for container disk I/O metrics
-// Uses node-exporter or cAdvisor metrics: container_fs_reads_bytes_total, container_fs_writes_bytes_total
-func (c *Client) getStorageMetricsFromPrometheus(ctx context.Context, namespace, podName string) (readMBps, writeMBps, iops int64, err error) {
-	// Prometheus endpoint (assumes prometheus-server in monitoring namespace)
-	promURL := "http://prometheus-server.monitoring.svc.cluster.local:9090/api/v1/query"
-
-	// Query for read throughput (bytes/sec over last 1 minute)
-	readQuery := fmt.Sprintf(`rate(container_fs_reads_bytes_total{namespace="%s",pod="%s"}[1m])`, namespace, podName)
-	readBytes, err := c.queryPrometheus(ctx, promURL, readQuery)
-	if err != nil {
-		return 0, 0, 0, fmt.Errorf("failed to query read metrics: %w", err)
-	}
-
-	// Query for write throughput
-	writeQuery := fmt.Sprintf(`rate(container_fs_writes_bytes_total{namespace="%s",pod="%s"}[1m])`, namespace, podName)
-	writeBytes, err := c.queryPrometheus(ctx, promURL, writeQuery)
-	if err != nil {
-		return 0, 0, 0, fmt.Errorf("failed to query write metrics: %w", err)
-	}
-
-	// Query for IOPS (reads + writes per second)
-	iopsQuery := fmt.Sprintf(`rate(container_fs_reads_total{namespace="%s",pod="%s"}[1m]) + rate(container_fs_writes_total{namespace="%s",pod="%s"}[1m])`,
-		namespace, podName, namespace, podName)
-	iopsValue, err := c.queryPrometheus(ctx, promURL, iopsQuery)
+func (c *Client) podStorageIO(ctx context.Context, pod *corev1.Pod) *metrics.StorageIO {
+	sample, err := c.metrics.PodStorageIO(ctx, pod.Namespace, pod.Name)
 	if err != nil {
-		return 0, 0, 0, fmt.Errorf("failed to query IOPS metrics: %w", err)
+		log.Printf("Storage I/O metrics unavailable for pod %s/%s (provider %s): %v", pod.Namespace, pod.Name, c.metrics.Name(), err)
+		return nil
 	}
-
-	// Convert bytes/sec to MB/sec
-	readMBps = int64(readBytes / (1024 * 1024))
-	writeMBps = int64(writeBytes / (1024 * 1024))
-	iops = int64(iopsValue)
-
-	return readMBps, writeMBps, iops, nil
+	return sample
 }
 
-// queryPrometheus executes a PromQL query and returns the result value
-func (c *Client) queryPrometheus(ctx context.Context, promURL, query string) (float64, error) {
-	// Create HTTP request with query parameter
-	req, err := http.NewRequestWithContext(ctx, "GET", promURL, nil)
-	if err != nil {
-		return 0, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	q := req.URL.Query()
-	q.Add("query", query)
-	req.URL.RawQuery = q.Encode()
-
-	// Execute request
-	client := &http.Client{Timeout: 5 * time.Second}
-	resp, err := client.Do(req)
+// QueryMetric runs a PromQL instant query for a custom autoscaling metric through the metrics provider
+func (c *Client) QueryMetric(ctx context.Context, promql string) (float64, types.MetricProvenance, error) {
+	sample, err := c.metrics.Query(ctx, promql)
 	if err != nil {
-		return 0, fmt.Errorf("failed to query Prometheus: %w", err)
+		return 0, "", err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return 0, fmt.Errorf("Prometheus returned status %d", resp.StatusCode)
-	}
-
-	// Parse JSON response
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return 0, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	// Simple parsing: look for "value":[timestamp, "value"]
-	// Full JSON parsing would be better, but this is a fallback
-	valueStr := string(body)
-	if strings.Contains(valueStr, `"result":[]`) {
-		return 0, fmt.Errorf("no metrics found")
-	}
-
-	// Extract numeric value (simplified parsing)
-	// Real implementation should use proper JSON unmarshaling
-	startIdx := strings.LastIndex(valueStr, `"value":[`)
-	if startIdx == -1 {
-		return 0, fmt.Errorf("invalid response format")
-	}
-
-	endIdx := strings.Index(valueStr[startIdx:], "]")
-	if endIdx == -1 {
-		return 0, fmt.Errorf("invalid response format")
-	}
-
-	valuesPart := valueStr[startIdx+9 : startIdx+endIdx]
-	parts := strings.Split(valuesPart, ",")
-	if len(parts) < 2 {
-		return 0, fmt.Errorf("invalid value format")
-	}
-
-	valueNumStr := strings.Trim(parts[1], `" `)
-	value, err := strconv.ParseFloat(valueNumStr, 64)
-	if err != nil {
-		return 0, fmt.Errorf("failed to parse value: %w", err)
-	}
-
-	return value, nil
+	return sample.Value, sample.Provenance, nil
 }
 
 // ============================================================================
@@ -1178,10 +1041,14 @@ func (c *Client) GetPodResourceInfo(ctx context.Context, namespace, name string)
 
 	// Get Storage I/O metrics for AI/ML workload preemption
 	// This is critical for storage-aware scheduling decisions
-	storageRead, storageWrite, storageIOPS := c.calculatePodStorageMetrics(ctx, pod)
-	info.StorageReadMBps = storageRead
-	info.StorageWriteMBps = storageWrite
-	info.StorageIOPS = storageIOPS
+	if storage := c.podStorageIO(ctx, pod); storage != nil {
+		info.StorageReadMBps = storage.ReadMBps
+		info.StorageWriteMBps = storage.WriteMBps
+		info.StorageIOPS = storage.IOPS
+		info.StorageMetricsProvenance = storage.Provenance
+	} else {
+		info.StorageMetricsProvenance = types.ProvenanceUnavailable
+	}
 
 	// Calculate PVC information
 	var pvcCount int32
@@ -1226,47 +1093,154 @@ func (c *Client) EvictPod(ctx context.Context, namespace, name string, gracePeri
 	return nil
 }
 
-// estimateStorageMetricsFromPVC estimates storage I/O based on PVC size and age
-// Assumes larger/older PVCs have higher I/O activity for AI/ML data loading
-func (c *Client) estimateStorageMetricsFromPVC(ctx context.Context, pod *corev1.Pod) (readMBps, writeMBps, iops int64) {
-	totalPVCSize := int64(0)
-	pvcCount := 0
+// CreatePVC creates a PVC for a provisioning job
+// An already existing claim with the same name is treated as success so that restored jobs can resume
+func (c *Client) CreatePVC(ctx context.Context, spec *types.PVCSpec) error {
+	size, err := resource.ParseQuantity(spec.Size)
+	if err != nil {
+		return fmt.Errorf("invalid storage size %q: %w", spec.Size, err)
+	}
 
-	for _, vol := range pod.Spec.Volumes {
-		if vol.PersistentVolumeClaim == nil {
-			continue
+	labels := map[string]string{
+		"app":       "ai-storage-orchestrator",
+		"component": "storage-provisioning",
+	}
+	for k, v := range spec.Labels {
+		labels[k] = v
+	}
+
+	accessMode := corev1.ReadWriteOnce
+	if spec.AccessMode != "" {
+		accessMode = corev1.PersistentVolumeAccessMode(spec.AccessMode)
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        spec.Name,
+			Namespace:   spec.Namespace,
+			Labels:      labels,
+			Annotations: spec.Annotations,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{accessMode},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: size,
+				},
+			},
+		},
+	}
+	if spec.StorageClass != "" {
+		storageClass := spec.StorageClass
+		pvc.Spec.StorageClassName = &storageClass
+	}
+	if spec.VolumeMode != "" {
+		volumeMode := corev1.PersistentVolumeMode(spec.VolumeMode)
+		pvc.Spec.VolumeMode = &volumeMode
+	}
+
+	_, err = c.clientset.CoreV1().PersistentVolumeClaims(spec.Namespace).Create(ctx, pvc, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create PVC %s/%s: %w", spec.Namespace, spec.Name, err)
+	}
+	return nil
+}
+
+// GetPVCStatus returns the binding state of a PVC
+func (c *Client) GetPVCStatus(ctx context.Context, namespace, name string) (*types.PVCStatus, error) {
+	pvc, err := c.clientset.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PVC %s/%s: %w", namespace, name, err)
+	}
+
+	status := &types.PVCStatus{
+		Phase:      string(pvc.Status.Phase),
+		VolumeName: pvc.Spec.VolumeName,
+	}
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		status.Capacity = capacity.String()
+	}
+
+	// WaitForFirstConsumer StorageClass는 Pod가 스케줄될 때까지 바인딩하지 않음
+	if pvc.Status.Phase == corev1.ClaimPending && pvc.Spec.StorageClassName != nil {
+		sc, err := c.clientset.StorageV1().StorageClasses().Get(ctx, *pvc.Spec.StorageClassName, metav1.GetOptions{})
+		if err == nil && sc.VolumeBindingMode != nil && *sc.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer {
+			status.WaitForFirstConsumer = true
 		}
+	}
 
-		pvcName := vol.PersistentVolumeClaim.ClaimName
-		pvc, err := c.clientset.CoreV1().PersistentVolumeClaims(pod.Namespace).Get(ctx, pvcName, metav1.GetOptions{})
-		if err != nil {
+	return status, nil
+}
+
+// ListPodsUsingPVC returns the names of pods in the namespace that mount the given PVC
+func (c *Client) ListPodsUsingPVC(ctx context.Context, namespace, pvcName string) ([]string, error) {
+	pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods in %s: %w", namespace, err)
+	}
+
+	var names []string
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
 			continue
 		}
-
-		// Get PVC storage size
-		if storageSize, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
-			totalPVCSize += storageSize.Value()
-			pvcCount++
+		for _, vol := range pod.Spec.Volumes {
+			if vol.PersistentVolumeClaim != nil && vol.PersistentVolumeClaim.ClaimName == pvcName {
+				names = append(names, pod.Name)
+				break
+			}
 		}
 	}
 
-	if pvcCount == 0 {
-		return 0, 0, 0
+	return names, nil
+}
+
+// DeletePVC deletes a PVC, ignoring claims that are already gone
+func (c *Client) DeletePVC(ctx context.Context, namespace, name string) error {
+	err := c.clientset.CoreV1().PersistentVolumeClaims(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete PVC %s/%s: %w", namespace, name, err)
 	}
+	return nil
+}
 
-	// Estimate I/O based on PVC size
-	// Assumption: Larger datasets = more I/O activity
-	// 100GB PVC ≈ 200 MB/s read, 50 MB/s write
-	// 1TB PVC ≈ 500 MB/s read, 150 MB/s write
-	totalGB := totalPVCSize / (1024 * 1024 * 1024)
+// RestorePod recreates a pod from a snapshot taken before it was deleted
+// Waits for the old pod object to disappear first, since a terminating pod still holds the name
+func (c *Client) RestorePod(ctx context.Context, snapshot *corev1.Pod) error {
+	pods := c.clientset.CoreV1().Pods(snapshot.Namespace)
 
-	if totalGB > 1000 {
-		totalGB = 1000 // Cap at 1TB
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+	for {
+		_, err := pods.Get(ctx, snapshot.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to check pod %s/%s: %w", snapshot.Namespace, snapshot.Name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for pod %s/%s to terminate: %w", snapshot.Namespace, snapshot.Name, ctx.Err())
+		case <-ticker.C:
+		}
 	}
 
-	readMBps = 100 + (totalGB * 40 / 100)    // 100-500 MB/s
-	writeMBps = 30 + (totalGB * 12 / 100)    // 30-150 MB/s
-	iops = 1000 + (totalGB * 100 / 100)      // 1000-2000 IOPS
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            snapshot.Name,
+			Namespace:       snapshot.Namespace,
+			Labels:          snapshot.Labels,
+			Annotations:     snapshot.Annotations,
+			OwnerReferences: snapshot.OwnerReferences,
+		},
+		Spec: *snapshot.Spec.DeepCopy(),
+	}
 
-	return readMBps, writeMBps, iops
+	_, err := pods.Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to recreate pod %s/%s: %w", snapshot.Namespace, snapshot.Name, err)
+	}
+	return nil
 }
@@ -0,0 +1,77 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"ai-storage-orchestrator/pkg/metrics"
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func runningWorkloadPod(name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "trainer"}},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name: "trainer",
+			Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			}},
+		}}},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+// TestGetWorkloadPodMetricsStorageProvenance tests that missing storage I/O is reported as unavailable
+// without affecting the provenance of the CPU/memory signal
+func TestGetWorkloadPodMetricsStorageProvenance(t *testing.T) {
+	c := &Client{
+		clientset: fake.NewSimpleClientset(runningWorkloadPod("trainer-0"), runningWorkloadPod("trainer-1")),
+		metrics: metrics.NewFakeProvider(&metrics.ReplayData{
+			PodUsage: map[string][]metrics.Usage{
+				"default/trainer-0": {{CPUMilli: 500, MemoryBytes: 512 << 20}},
+				"default/trainer-1": {{CPUMilli: 500, MemoryBytes: 512 << 20}},
+			},
+			PodStorageIO: map[string][]metrics.StorageIO{
+				"default/trainer-0": {{ReadMBps: 100}},
+			},
+		}),
+	}
+
+	cpu, _, _, read, _, _, provenance, err := c.GetWorkloadPodMetrics(context.Background(), "default", "trainer")
+	require.NoError(t, err)
+	assert.Equal(t, int32(50), cpu)
+	assert.Equal(t, int64(50), read)
+	assert.Equal(t, types.ProvenanceReal, provenance.Resource)
+	assert.Equal(t, types.ProvenanceUnavailable, provenance.Storage)
+	assert.False(t, provenance.Of(false, false, true).IsReal())
+	assert.True(t, provenance.Of(true, true, false).IsReal())
+}
+
+// TestGetWorkloadPodMetricsGPUUnavailable tests that GPU utilization is reported as unavailable
+// rather than simulated when no DCGM exporter is configured
+func TestGetWorkloadPodMetricsGPUUnavailable(t *testing.T) {
+	pod := runningWorkloadPod("trainer-0")
+	pod.Spec.Containers[0].Resources.Requests["nvidia.com/gpu"] = resource.MustParse("1")
+	c := &Client{
+		clientset: fake.NewSimpleClientset(pod),
+		metrics: metrics.NewFakeProvider(&metrics.ReplayData{
+			PodUsage: map[string][]metrics.Usage{
+				"default/trainer-0": {{CPUMilli: 500, MemoryBytes: 512 << 20}},
+			},
+		}),
+	}
+
+	_, _, gpu, _, _, _, provenance, err := c.GetWorkloadPodMetrics(context.Background(), "default", "trainer")
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), gpu)
+	assert.Equal(t, types.ProvenanceUnavailable, provenance.GPU)
+	assert.Equal(t, types.ProvenanceReal, provenance.Resource)
+}
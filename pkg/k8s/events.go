@@ -0,0 +1,167 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// EventComponent is the source component of events recorded by the orchestrator
+const EventComponent = "ai-storage-orchestrator"
+
+// Event reasons recorded on the objects the controllers act on
+const (
+	EventReasonMigrating          = "Migrating"
+	EventReasonMigrated           = "Migrated"
+	EventReasonMigrationFailed    = "MigrationFailed"
+	EventReasonMigrationCancelled = "MigrationCancelled"
+	EventReasonCheckpointCreated  = "CheckpointCreated"
+	EventReasonPreempted          = "Preempted"
+	EventReasonPreemptionFailed   = "PreemptionFailed"
+	EventReasonScaled             = "Scaled"
+	EventReasonScaleFailed        = "ScaleFailed"
+	EventReasonScaledToZero       = "ScaledToZero"
+	EventReasonWoken              = "Woken"
+	EventReasonColdStarted        = "ColdStarted"
+	EventReasonScheduleChanged    = "ScheduleChanged"
+)
+
+const (
+	// eventLookupTimeout bounds the lookup of the object an event is recorded on
+	eventLookupTimeout = 5 * time.Second
+
+	// eventQueueSize bounds the events waiting for the lookup of their object; more are dropped
+	eventQueueSize = 256
+)
+
+// EventRecorder records Kubernetes Events on Pods, PVCs, Nodes, Deployments and StatefulSets,
+// so that orchestrator actions show up in `kubectl describe` of the affected object.
+// Events are queued and their objects looked up in the background, so callers never wait on the API server.
+type EventRecorder struct {
+	client      kubernetes.Interface
+	broadcaster record.EventBroadcaster
+	recorder    record.EventRecorder
+
+	mu     sync.RWMutex
+	closed bool
+	queue  chan pendingEvent
+	done   chan struct{}
+}
+
+// pendingEvent is an event waiting for the lookup of its object
+type pendingEvent struct {
+	kind, namespace, name      string
+	eventType, reason, message string
+}
+
+// NewEventRecorder creates an event recorder writing through the API server
+func NewEventRecorder(client kubernetes.Interface) *EventRecorder {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: client.CoreV1().Events("")})
+	r := &EventRecorder{
+		client:      client,
+		broadcaster: broadcaster,
+		recorder:    broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: EventComponent}),
+		queue:       make(chan pendingEvent, eventQueueSize),
+		done:        make(chan struct{}),
+	}
+	go r.run()
+	return r
+}
+
+// Event queues an event on the named object. Events are best effort: failures are logged only.
+func (r *EventRecorder) Event(kind, namespace, name, eventType, reason, message string) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		return
+	}
+	select {
+	case r.queue <- pendingEvent{kind, namespace, name, eventType, reason, message}:
+	default:
+		log.Printf("Warning: Event queue full, dropping %s event on %s %s/%s", reason, kind, namespace, name)
+	}
+}
+
+// run records the queued events until Shutdown
+func (r *EventRecorder) run() {
+	defer close(r.done)
+	for e := range r.queue {
+		ref, err := r.reference(e.kind, e.namespace, e.name)
+		if err != nil {
+			log.Printf("Warning: Not recording %s event on %s %s/%s: %v", e.reason, e.kind, e.namespace, e.name, err)
+			continue
+		}
+		r.recorder.Event(ref, e.eventType, e.reason, e.message)
+	}
+}
+
+// Shutdown records the queued events and stops the broadcaster after flushing them
+func (r *EventRecorder) Shutdown() {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.queue)
+	}
+	r.mu.Unlock()
+	<-r.done
+	r.broadcaster.Shutdown()
+}
+
+// reference resolves an object into an object reference; the UID is looked up so that
+// `kubectl describe` matches the event to the object
+func (r *EventRecorder) reference(kind, namespace, name string) (*corev1.ObjectReference, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), eventLookupTimeout)
+	defer cancel()
+
+	ref := &corev1.ObjectReference{Kind: kind, Namespace: namespace, Name: name}
+	var meta metav1.Object
+	var err error
+	switch kind {
+	case "Pod":
+		ref.APIVersion = "v1"
+		meta, err = r.client.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	case "PersistentVolumeClaim":
+		ref.APIVersion = "v1"
+		meta, err = r.client.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	case "Node":
+		ref.APIVersion = "v1"
+		meta, err = r.client.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	case "Deployment":
+		ref.APIVersion = "apps/v1"
+		meta, err = r.client.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	case "StatefulSet":
+		ref.APIVersion = "apps/v1"
+		meta, err = r.client.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", kind)
+	}
+	// 삭제된 오브젝트(evict된 파드 등)에도 UID 없이 이벤트를 남긴다
+	if err == nil {
+		ref.UID = meta.GetUID()
+		ref.ResourceVersion = meta.GetResourceVersion()
+	}
+	return ref, nil
+}
+
+// EventRecorder returns the recorder of events on objects touched by the orchestrator
+func (c *Client) EventRecorder() *EventRecorder {
+	return c.events
+}
+
+// RecordEvent records a Normal or Warning event on an object; it is a no-op for a client without recorder
+func (c *Client) RecordEvent(kind, namespace, name, eventType, reason, message string) {
+	if c == nil || c.events == nil {
+		return
+	}
+	c.events.Event(kind, namespace, name, eventType, reason, message)
+}
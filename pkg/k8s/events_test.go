@@ -0,0 +1,84 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// TestRecordEvent tests that events are attached to the object, including objects that are already gone
+func TestRecordEvent(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "trainer-0", Namespace: "default", UID: "pod-uid"}}
+	clientset := fake.NewSimpleClientset(pod)
+	c := &Client{clientset: clientset, events: NewEventRecorder(clientset)}
+	defer c.events.Shutdown()
+
+	c.RecordEvent("Pod", "default", "trainer-0", corev1.EventTypeNormal, EventReasonMigrating, "Migrating from node a to b (migration migration-1234)")
+	c.RecordEvent("Pod", "default", "evicted-0", corev1.EventTypeWarning, EventReasonPreemptionFailed, "eviction refused")
+	c.RecordEvent("CronJob", "default", "nightly", corev1.EventTypeNormal, EventReasonScaled, "unsupported kind")
+
+	var events []corev1.Event
+	require.Eventually(t, func() bool {
+		list, err := clientset.CoreV1().Events("default").List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		events = list.Items
+		return len(events) == 2
+	}, 5*time.Second, 20*time.Millisecond)
+
+	byName := map[string]corev1.Event{}
+	for _, e := range events {
+		byName[e.InvolvedObject.Name] = e
+	}
+	migrating := byName["trainer-0"]
+	assert.Equal(t, EventReasonMigrating, migrating.Reason)
+	assert.Equal(t, "pod-uid", string(migrating.InvolvedObject.UID))
+	assert.Equal(t, EventComponent, migrating.Source.Component)
+	assert.Contains(t, migrating.Message, "migration-1234")
+	assert.Equal(t, corev1.EventTypeWarning, byName["evicted-0"].Type)
+
+	// A client without recorder (and a nil client) drops events
+	(&Client{}).RecordEvent("Pod", "default", "trainer-0", corev1.EventTypeNormal, EventReasonMigrated, "")
+	var nilClient *Client
+	nilClient.RecordEvent("Pod", "default", "trainer-0", corev1.EventTypeNormal, EventReasonMigrated, "")
+}
+
+// TestRecordEventDoesNotBlock tests that recording an event does not wait for the object lookup
+func TestRecordEventDoesNotBlock(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "trainer-0", Namespace: "default", UID: "pod-uid"}}
+	clientset := fake.NewSimpleClientset(pod)
+	release := make(chan struct{})
+	clientset.PrependReactor("get", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		<-release
+		return false, nil, nil
+	})
+	recorder := NewEventRecorder(clientset)
+
+	returned := make(chan struct{})
+	go func() {
+		recorder.Event("Pod", "default", "trainer-0", corev1.EventTypeNormal, EventReasonPreempted, "evicted")
+		close(returned)
+	}()
+	select {
+	case <-returned:
+	case <-time.After(time.Second):
+		t.Fatal("Event blocked on the object lookup")
+	}
+
+	// Shutdown records the queued event before stopping
+	close(release)
+	recorder.Shutdown()
+	require.Eventually(t, func() bool {
+		list, err := clientset.CoreV1().Events("default").List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		return len(list.Items) == 1 && string(list.Items[0].InvolvedObject.UID) == "pod-uid"
+	}, 5*time.Second, 20*time.Millisecond)
+	recorder.Event("Pod", "default", "trainer-0", corev1.EventTypeNormal, EventReasonPreempted, "after shutdown")
+}
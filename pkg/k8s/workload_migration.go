@@ -0,0 +1,363 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// hostnameLabel is the node label used to pin workloads to a single node
+const hostnameLabel = "kubernetes.io/hostname"
+
+// workloadPollInterval is how often rollout and pod replacement progress is checked
+const workloadPollInterval = 2 * time.Second
+
+// GetPodOwner resolves the workload controlling a pod
+// ReplicaSets owned by a Deployment are resolved to the Deployment; unowned pods return an empty kind
+func (c *Client) GetPodOwner(ctx context.Context, pod *corev1.Pod) (kind, name string, err error) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return "", "", nil
+	}
+
+	if owner.Kind == "ReplicaSet" {
+		rs, err := c.clientset.AppsV1().ReplicaSets(pod.Namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get replicaset %s: %w", owner.Name, err)
+		}
+		if rsOwner := metav1.GetControllerOf(rs); rsOwner != nil && rsOwner.Kind == "Deployment" {
+			return "Deployment", rsOwner.Name, nil
+		}
+	}
+
+	return owner.Kind, owner.Name, nil
+}
+
+// PinWorkloadToNode adds a hostname requirement for the given node to every required node selector term
+// of a Deployment or StatefulSet pod template, keeping its other node affinity, and returns the node
+// affinity it had before (nil when the template had none)
+func (c *Client) PinWorkloadToNode(ctx context.Context, namespace, kind, name, nodeName string) (*corev1.NodeAffinity, error) {
+	return c.updateWorkloadNodeAffinity(ctx, namespace, kind, name, func(current *corev1.NodeAffinity) *corev1.NodeAffinity {
+		return pinNodeAffinity(current, nodeName)
+	})
+}
+
+// SetWorkloadNodeAffinity sets the node affinity of a Deployment or StatefulSet pod template
+// and returns the previous value (nil when the template had none)
+func (c *Client) SetWorkloadNodeAffinity(ctx context.Context, namespace, kind, name string, nodeAffinity *corev1.NodeAffinity) (*corev1.NodeAffinity, error) {
+	return c.updateWorkloadNodeAffinity(ctx, namespace, kind, name, func(*corev1.NodeAffinity) *corev1.NodeAffinity {
+		return nodeAffinity
+	})
+}
+
+// updateWorkloadNodeAffinity replaces the node affinity of a Deployment or StatefulSet pod template
+// with the one derived from its current value and returns the previous value
+func (c *Client) updateWorkloadNodeAffinity(ctx context.Context, namespace, kind, name string, update func(current *corev1.NodeAffinity) *corev1.NodeAffinity) (*corev1.NodeAffinity, error) {
+	var previous *corev1.NodeAffinity
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		switch kind {
+		case "Deployment":
+			deploy, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			previous = setTemplateNodeAffinity(&deploy.Spec.Template, update(templateNodeAffinity(&deploy.Spec.Template)))
+			_, err = c.clientset.AppsV1().Deployments(namespace).Update(ctx, deploy, metav1.UpdateOptions{})
+			return err
+		case "StatefulSet":
+			sts, err := c.clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			previous = setTemplateNodeAffinity(&sts.Spec.Template, update(templateNodeAffinity(&sts.Spec.Template)))
+			_, err = c.clientset.AppsV1().StatefulSets(namespace).Update(ctx, sts, metav1.UpdateOptions{})
+			return err
+		default:
+			return fmt.Errorf("unsupported workload type for node affinity: %s", kind)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update node affinity of %s %s/%s: %w", kind, namespace, name, err)
+	}
+
+	return previous, nil
+}
+
+// templateNodeAffinity returns the node affinity of a pod template, nil when it has none
+func templateNodeAffinity(template *corev1.PodTemplateSpec) *corev1.NodeAffinity {
+	if template.Spec.Affinity == nil {
+		return nil
+	}
+	return template.Spec.Affinity.NodeAffinity
+}
+
+// setTemplateNodeAffinity swaps the node affinity of a pod template and returns the old one
+func setTemplateNodeAffinity(template *corev1.PodTemplateSpec, nodeAffinity *corev1.NodeAffinity) *corev1.NodeAffinity {
+	var previous *corev1.NodeAffinity
+	if template.Spec.Affinity != nil {
+		previous = template.Spec.Affinity.NodeAffinity
+	}
+
+	if template.Spec.Affinity == nil {
+		if nodeAffinity == nil {
+			return previous
+		}
+		template.Spec.Affinity = &corev1.Affinity{}
+	}
+	template.Spec.Affinity.NodeAffinity = nodeAffinity
+
+	return previous
+}
+
+// pinNodeAffinity returns a copy of the node affinity that also requires the given node.
+// Required terms are ORed, so the hostname requirement is ANDed into each of them;
+// preferred terms are kept as they are.
+func pinNodeAffinity(nodeAffinity *corev1.NodeAffinity, nodeName string) *corev1.NodeAffinity {
+	pinned := &corev1.NodeAffinity{}
+	if nodeAffinity != nil {
+		pinned = nodeAffinity.DeepCopy()
+	}
+
+	hostname := corev1.NodeSelectorRequirement{
+		Key:      hostnameLabel,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   []string{nodeName},
+	}
+	required := pinned.RequiredDuringSchedulingIgnoredDuringExecution
+	if required == nil || len(required.NodeSelectorTerms) == 0 {
+		pinned.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{hostname}}},
+		}
+		return pinned
+	}
+	for i := range required.NodeSelectorTerms {
+		term := &required.NodeSelectorTerms[i]
+		term.MatchExpressions = append(term.MatchExpressions, hostname)
+	}
+	return pinned
+}
+
+// SetStatefulSetUpdateStrategy replaces the whole update strategy of a StatefulSet, including its
+// rolling update partition and maxUnavailable, and returns the previous strategy unchanged
+// OnDelete keeps the StatefulSet controller from rolling pods while their template is being changed
+func (c *Client) SetStatefulSetUpdateStrategy(ctx context.Context, namespace, name string, strategy appsv1.StatefulSetUpdateStrategy) (appsv1.StatefulSetUpdateStrategy, error) {
+	var previous appsv1.StatefulSetUpdateStrategy
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		sts, err := c.clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		previous = *sts.Spec.UpdateStrategy.DeepCopy()
+		sts.Spec.UpdateStrategy = *strategy.DeepCopy()
+		_, err = c.clientset.AppsV1().StatefulSets(namespace).Update(ctx, sts, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return appsv1.StatefulSetUpdateStrategy{}, fmt.Errorf("failed to update strategy of statefulset %s/%s: %w", namespace, name, err)
+	}
+
+	return previous, nil
+}
+
+// WaitForDeploymentRollout waits until every replica of a Deployment runs the latest template and is available
+func (c *Client) WaitForDeploymentRollout(ctx context.Context, namespace, name string, timeout time.Duration) error {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(workloadPollInterval)
+	defer ticker.Stop()
+
+	for {
+		deploy, err := c.clientset.AppsV1().Deployments(namespace).Get(waitCtx, name, metav1.GetOptions{})
+		if err == nil {
+			desired := int32(1)
+			if deploy.Spec.Replicas != nil {
+				desired = *deploy.Spec.Replicas
+			}
+			if deploy.Status.ObservedGeneration >= deploy.Generation &&
+				deploy.Status.UpdatedReplicas == desired &&
+				deploy.Status.Replicas == desired &&
+				deploy.Status.AvailableReplicas == desired {
+				return nil
+			}
+		}
+
+		select {
+		case <-waitCtx.Done():
+			return fmt.Errorf("timeout waiting for deployment %s/%s rollout", namespace, name)
+		case <-ticker.C:
+		}
+	}
+}
+
+// FindWorkloadPodOnNode returns a running pod of the Deployment or StatefulSet that is scheduled on the node
+func (c *Client) FindWorkloadPodOnNode(ctx context.Context, namespace, kind, name, nodeName string) (string, error) {
+	var selector *metav1.LabelSelector
+	switch kind {
+	case "Deployment":
+		deploy, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("failed to get deployment: %w", err)
+		}
+		selector = deploy.Spec.Selector
+	case "StatefulSet":
+		sts, err := c.clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("failed to get statefulset: %w", err)
+		}
+		selector = sts.Spec.Selector
+	default:
+		return "", fmt.Errorf("unsupported workload type: %s", kind)
+	}
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return "", fmt.Errorf("invalid selector: %w", err)
+	}
+
+	pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector.String(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName == nodeName && pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning {
+			return pod.Name, nil
+		}
+	}
+
+	return "", fmt.Errorf("no running pod of %s %s/%s found on node %s", kind, namespace, name, nodeName)
+}
+
+// WaitForPodReplaced waits until the pod instance with the given UID is gone
+// and a replacement with the same name (as StatefulSets create) is ready
+// Returns the node the replacement was scheduled on
+func (c *Client) WaitForPodReplaced(ctx context.Context, namespace, name string, oldUID ktypes.UID, timeout time.Duration) (string, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(workloadPollInterval)
+	defer ticker.Stop()
+
+	for {
+		pod, err := c.clientset.CoreV1().Pods(namespace).Get(waitCtx, name, metav1.GetOptions{})
+		if err == nil && pod.UID != oldUID && isPodReady(pod) {
+			return pod.Spec.NodeName, nil
+		}
+		if err != nil && !apierrors.IsNotFound(err) && waitCtx.Err() == nil {
+			return "", fmt.Errorf("failed to get pod %s/%s: %w", namespace, name, err)
+		}
+
+		select {
+		case <-waitCtx.Done():
+			return "", fmt.Errorf("timeout waiting for pod %s/%s to be replaced", namespace, name)
+		case <-ticker.C:
+		}
+	}
+}
+
+// isPodReady reports whether the pod's Ready condition is true
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckPodVolumesReachable verifies that every PV mounted by the pod can be attached on the node
+// Node-local PVs (local, hostPath with node affinity) cannot follow a pod to another node
+func (c *Client) CheckPodVolumesReachable(ctx context.Context, pod *corev1.Pod, nodeName string) error {
+	var node *corev1.Node
+
+	for _, vol := range pod.Spec.Volumes {
+		if vol.PersistentVolumeClaim == nil {
+			continue
+		}
+
+		pvc, err := c.clientset.CoreV1().PersistentVolumeClaims(pod.Namespace).Get(ctx, vol.PersistentVolumeClaim.ClaimName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get PVC %s: %w", vol.PersistentVolumeClaim.ClaimName, err)
+		}
+		if pvc.Spec.VolumeName == "" {
+			continue
+		}
+
+		pv, err := c.clientset.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get PV %s: %w", pvc.Spec.VolumeName, err)
+		}
+		if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+			continue
+		}
+
+		if node == nil {
+			node, err = c.clientset.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to get node %s: %w", nodeName, err)
+			}
+		}
+		if !nodeSelectorMatches(pv.Spec.NodeAffinity.Required, node.Labels) {
+			return fmt.Errorf("PVC %s is bound to PV %s which cannot be attached on node %s",
+				pvc.Name, pv.Name, nodeName)
+		}
+	}
+
+	return nil
+}
+
+// nodeSelectorMatches evaluates a node selector (terms ORed, expressions ANDed) against node labels
+func nodeSelectorMatches(nodeSelector *corev1.NodeSelector, nodeLabels map[string]string) bool {
+	for _, term := range nodeSelector.NodeSelectorTerms {
+		if len(term.MatchExpressions) == 0 {
+			continue
+		}
+
+		selector := labels.NewSelector()
+		valid := true
+		for _, expr := range term.MatchExpressions {
+			var op selection.Operator
+			switch expr.Operator {
+			case corev1.NodeSelectorOpIn:
+				op = selection.In
+			case corev1.NodeSelectorOpNotIn:
+				op = selection.NotIn
+			case corev1.NodeSelectorOpExists:
+				op = selection.Exists
+			case corev1.NodeSelectorOpDoesNotExist:
+				op = selection.DoesNotExist
+			case corev1.NodeSelectorOpGt:
+				op = selection.GreaterThan
+			case corev1.NodeSelectorOpLt:
+				op = selection.LessThan
+			}
+
+			req, err := labels.NewRequirement(expr.Key, op, expr.Values)
+			if err != nil {
+				valid = false
+				break
+			}
+			selector = selector.Add(*req)
+		}
+
+		if valid && selector.Matches(labels.Set(nodeLabels)) {
+			return true
+		}
+	}
+
+	return false
+}
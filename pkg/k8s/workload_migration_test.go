@@ -0,0 +1,174 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func controllerRef(kind, name string) []metav1.OwnerReference {
+	isController := true
+	return []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: kind, Name: name, Controller: &isController}}
+}
+
+// TestGetPodOwner tests that ReplicaSet-owned pods resolve to their Deployment
+func TestGetPodOwner(t *testing.T) {
+	rs := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+		Name: "trainer-7d9f", Namespace: "default", OwnerReferences: controllerRef("Deployment", "trainer"),
+	}}
+	c := &Client{clientset: fake.NewSimpleClientset(rs)}
+	ctx := context.Background()
+
+	kind, name, err := c.GetPodOwner(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: "trainer-7d9f-abcde", Namespace: "default", OwnerReferences: controllerRef("ReplicaSet", "trainer-7d9f"),
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, "Deployment", kind)
+	assert.Equal(t, "trainer", name)
+
+	kind, name, err = c.GetPodOwner(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: "db-0", Namespace: "default", OwnerReferences: controllerRef("StatefulSet", "db"),
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, "StatefulSet", kind)
+	assert.Equal(t, "db", name)
+
+	kind, _, err = c.GetPodOwner(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "bare", Namespace: "default"}})
+	require.NoError(t, err)
+	assert.Empty(t, kind)
+}
+
+// TestPinWorkloadToNode tests pinning a Deployment and restoring its previous node affinity
+func TestPinWorkloadToNode(t *testing.T) {
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "trainer", Namespace: "default"}}
+	c := &Client{clientset: fake.NewSimpleClientset(deploy)}
+	ctx := context.Background()
+
+	previous, err := c.PinWorkloadToNode(ctx, "default", "Deployment", "trainer", "gpu-node-2")
+	require.NoError(t, err)
+	assert.Nil(t, previous)
+
+	got, err := c.clientset.AppsV1().Deployments("default").Get(ctx, "trainer", metav1.GetOptions{})
+	require.NoError(t, err)
+	terms := got.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	require.Len(t, terms, 1)
+	assert.Equal(t, []string{"gpu-node-2"}, terms[0].MatchExpressions[0].Values)
+
+	_, err = c.SetWorkloadNodeAffinity(ctx, "default", "Deployment", "trainer", previous)
+	require.NoError(t, err)
+	got, err = c.clientset.AppsV1().Deployments("default").Get(ctx, "trainer", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, got.Spec.Template.Spec.Affinity.NodeAffinity)
+}
+
+// TestPinWorkloadToNodeKeepsAffinity tests that pinning ANDs the hostname into existing required terms
+// and that the saved affinity restores the template unchanged
+func TestPinWorkloadToNodeKeepsAffinity(t *testing.T) {
+	zone := corev1.NodeSelectorRequirement{Key: "topology.kubernetes.io/zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"zone-a"}}
+	gpu := corev1.NodeSelectorRequirement{Key: "gpu", Operator: corev1.NodeSelectorOpExists}
+	original := &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{
+			{MatchExpressions: []corev1.NodeSelectorRequirement{zone}},
+			{MatchExpressions: []corev1.NodeSelectorRequirement{gpu}},
+		}},
+		PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{
+			{Weight: 10, Preference: corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{gpu}}},
+		},
+	}
+	sts := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}}
+	sts.Spec.Template.Spec.Affinity = &corev1.Affinity{NodeAffinity: original.DeepCopy()}
+	c := &Client{clientset: fake.NewSimpleClientset(sts)}
+	ctx := context.Background()
+
+	previous, err := c.PinWorkloadToNode(ctx, "default", "StatefulSet", "db", "node-2")
+	require.NoError(t, err)
+	assert.Equal(t, original, previous)
+
+	got, err := c.clientset.AppsV1().StatefulSets("default").Get(ctx, "db", metav1.GetOptions{})
+	require.NoError(t, err)
+	pinned := got.Spec.Template.Spec.Affinity.NodeAffinity
+	hostname := corev1.NodeSelectorRequirement{Key: hostnameLabel, Operator: corev1.NodeSelectorOpIn, Values: []string{"node-2"}}
+	assert.Equal(t, []corev1.NodeSelectorTerm{
+		{MatchExpressions: []corev1.NodeSelectorRequirement{zone, hostname}},
+		{MatchExpressions: []corev1.NodeSelectorRequirement{gpu, hostname}},
+	}, pinned.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+	assert.Equal(t, original.PreferredDuringSchedulingIgnoredDuringExecution, pinned.PreferredDuringSchedulingIgnoredDuringExecution)
+
+	_, err = c.SetWorkloadNodeAffinity(ctx, "default", "StatefulSet", "db", previous)
+	require.NoError(t, err)
+	got, err = c.clientset.AppsV1().StatefulSets("default").Get(ctx, "db", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, original, got.Spec.Template.Spec.Affinity.NodeAffinity)
+}
+
+// TestSetStatefulSetUpdateStrategy tests that the partition and maxUnavailable survive an OnDelete round trip
+func TestSetStatefulSetUpdateStrategy(t *testing.T) {
+	partition := int32(2)
+	maxUnavailable := intstr.FromString("25%")
+	original := appsv1.StatefulSetUpdateStrategy{
+		Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition, MaxUnavailable: &maxUnavailable},
+	}
+	sts := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}}
+	sts.Spec.UpdateStrategy = original
+	c := &Client{clientset: fake.NewSimpleClientset(sts)}
+	ctx := context.Background()
+
+	previous, err := c.SetStatefulSetUpdateStrategy(ctx, "default", "db",
+		appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType})
+	require.NoError(t, err)
+	assert.Equal(t, original, previous)
+
+	got, err := c.clientset.AppsV1().StatefulSets("default").Get(ctx, "db", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, appsv1.OnDeleteStatefulSetStrategyType, got.Spec.UpdateStrategy.Type)
+	assert.Nil(t, got.Spec.UpdateStrategy.RollingUpdate)
+
+	_, err = c.SetStatefulSetUpdateStrategy(ctx, "default", "db", previous)
+	require.NoError(t, err)
+	got, err = c.clientset.AppsV1().StatefulSets("default").Get(ctx, "db", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, original, got.Spec.UpdateStrategy)
+}
+
+// TestCheckPodVolumesReachable tests that node-local PVs block a migration to another node
+func TestCheckPodVolumesReachable(t *testing.T) {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-db-0", Namespace: "default"},
+		Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "local-pv-1"},
+	}
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "local-pv-1"},
+		Spec: corev1.PersistentVolumeSpec{NodeAffinity: &corev1.VolumeNodeAffinity{
+			Required: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{
+					Key: hostnameLabel, Operator: corev1.NodeSelectorOpIn, Values: []string{"node-1"},
+				}},
+			}}},
+		}},
+	}
+	node1 := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{hostnameLabel: "node-1"}}}
+	node2 := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-2", Labels: map[string]string{hostnameLabel: "node-2"}}}
+	c := &Client{clientset: fake.NewSimpleClientset(pvc, pv, node1, node2)}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-0", Namespace: "default"},
+		Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+			Name: "data",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data-db-0"},
+			},
+		}}},
+	}
+
+	ctx := context.Background()
+	assert.NoError(t, c.CheckPodVolumesReachable(ctx, pod, "node-1"))
+	assert.Error(t, c.CheckPodVolumesReachable(ctx, pod, "node-2"))
+}
@@ -0,0 +1,151 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
+)
+
+// Provider names accepted in Config.Provider
+const (
+	ProviderMetricsServer = "metrics-server"
+	ProviderPrometheus    = "prometheus"
+	ProviderFake          = "fake"
+)
+
+// Config selects and configures the metrics provider
+type Config struct {
+	// Provider is one of metrics-server (default), prometheus or fake
+	Provider string
+
+	// Prometheus settings; with the metrics-server provider a Prometheus URL adds
+	// storage I/O and cache statistics on top of the metrics-server CPU/memory data
+	Prometheus PrometheusConfig
+
+	// ReplayFile is the JSON recording replayed by the fake provider
+	ReplayFile string
+}
+
+// ConfigFromEnv reads the provider configuration from environment variables:
+// METRICS_PROVIDER, PROMETHEUS_URL, PROMETHEUS_BEARER_TOKEN (or PROMETHEUS_BEARER_TOKEN_FILE),
+// PROMETHEUS_USERNAME, PROMETHEUS_PASSWORD and METRICS_REPLAY_FILE
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Provider: os.Getenv("METRICS_PROVIDER"),
+		Prometheus: PrometheusConfig{
+			URL:         os.Getenv("PROMETHEUS_URL"),
+			BearerToken: os.Getenv("PROMETHEUS_BEARER_TOKEN"),
+			Username:    os.Getenv("PROMETHEUS_USERNAME"),
+			Password:    os.Getenv("PROMETHEUS_PASSWORD"),
+		},
+		ReplayFile: os.Getenv("METRICS_REPLAY_FILE"),
+	}
+
+	if tokenFile := os.Getenv("PROMETHEUS_BEARER_TOKEN_FILE"); tokenFile != "" && cfg.Prometheus.BearerToken == "" {
+		token, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return cfg, fmt.Errorf("failed to read prometheus bearer token: %w", err)
+		}
+		cfg.Prometheus.BearerToken = strings.TrimSpace(string(token))
+	}
+
+	return cfg, nil
+}
+
+// New builds the provider selected by the config
+func New(cfg Config, metricsClient metricsclientset.Interface) (Provider, error) {
+	switch cfg.Provider {
+	case "", ProviderMetricsServer:
+		base := NewMetricsServerProvider(metricsClient)
+		if cfg.Prometheus.URL == "" {
+			return base, nil
+		}
+		prom, err := NewPrometheusProvider(cfg.Prometheus)
+		if err != nil {
+			return nil, err
+		}
+		return NewChain(base, prom), nil
+
+	case ProviderPrometheus:
+		return NewPrometheusProvider(cfg.Prometheus)
+
+	case ProviderFake:
+		data := &ReplayData{}
+		if cfg.ReplayFile != "" {
+			var err error
+			if data, err = LoadReplayFile(cfg.ReplayFile); err != nil {
+				return nil, err
+			}
+		}
+		return NewFakeProvider(data), nil
+
+	default:
+		return nil, fmt.Errorf("unknown metrics provider %q (expected %s, %s or %s)",
+			cfg.Provider, ProviderMetricsServer, ProviderPrometheus, ProviderFake)
+	}
+}
+
+// Chain asks each provider in order and returns the first answer that is not unavailable
+type Chain struct {
+	providers []Provider
+}
+
+// NewChain creates a provider chain
+func NewChain(providers ...Provider) *Chain {
+	return &Chain{providers: providers}
+}
+
+// Name returns the names of the chained providers
+func (c *Chain) Name() string {
+	names := make([]string, 0, len(c.providers))
+	for _, p := range c.providers {
+		names = append(names, p.Name())
+	}
+	return strings.Join(names, "+")
+}
+
+// first calls fn on each provider until one has data; query errors are returned immediately
+func first[T any](c *Chain, fn func(Provider) (T, error)) (T, error) {
+	var zero T
+	err := unavailable("no metrics provider configured")
+	for _, p := range c.providers {
+		var v T
+		if v, err = fn(p); err == nil || !IsUnavailable(err) {
+			return v, err
+		}
+	}
+	return zero, err
+}
+
+// NodeUsage returns the first available node usage
+func (c *Chain) NodeUsage(ctx context.Context, nodeName string) (*Usage, error) {
+	return first(c, func(p Provider) (*Usage, error) { return p.NodeUsage(ctx, nodeName) })
+}
+
+// PodUsage returns the first available pod usage
+func (c *Chain) PodUsage(ctx context.Context, namespace, podName string) (*Usage, error) {
+	return first(c, func(p Provider) (*Usage, error) { return p.PodUsage(ctx, namespace, podName) })
+}
+
+// NodeStorageIO returns the first available node storage I/O
+func (c *Chain) NodeStorageIO(ctx context.Context, nodeName string) (*StorageIO, error) {
+	return first(c, func(p Provider) (*StorageIO, error) { return p.NodeStorageIO(ctx, nodeName) })
+}
+
+// PodStorageIO returns the first available pod storage I/O
+func (c *Chain) PodStorageIO(ctx context.Context, namespace, podName string) (*StorageIO, error) {
+	return first(c, func(p Provider) (*StorageIO, error) { return p.PodStorageIO(ctx, namespace, podName) })
+}
+
+// CacheStats returns the first available cache statistics
+func (c *Chain) CacheStats(ctx context.Context, namespace, cacheID string) (*CacheStats, error) {
+	return first(c, func(p Provider) (*CacheStats, error) { return p.CacheStats(ctx, namespace, cacheID) })
+}
+
+// Query runs the query on the first provider that supports PromQL
+func (c *Chain) Query(ctx context.Context, promql string) (*Sample, error) {
+	return first(c, func(p Provider) (*Sample, error) { return p.Query(ctx, promql) })
+}
@@ -0,0 +1,155 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+)
+
+// ReplayData holds recorded samples for the fake provider, keyed by node name,
+// "namespace/pod", "namespace/cache" or query
+type ReplayData struct {
+	NodeUsage     map[string][]Usage      `json:"node_usage,omitempty"`
+	PodUsage      map[string][]Usage      `json:"pod_usage,omitempty"`
+	NodeStorageIO map[string][]StorageIO  `json:"node_storage_io,omitempty"`
+	PodStorageIO  map[string][]StorageIO  `json:"pod_storage_io,omitempty"`
+	CacheStats    map[string][]CacheStats `json:"cache_stats,omitempty"`
+	Queries       map[string][]Sample     `json:"queries,omitempty"` // keyed by PromQL query
+}
+
+// LoadReplayFile reads replay data from a JSON file
+func LoadReplayFile(path string) (*ReplayData, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metrics replay file: %w", err)
+	}
+
+	var data ReplayData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse metrics replay file %s: %w", path, err)
+	}
+	return &data, nil
+}
+
+// FakeProvider replays recorded samples deterministically: each call for a key returns
+// the next sample and the last sample is repeated once the recording is exhausted.
+// Keys without samples report unavailable, like a real provider without data.
+// A recording can mark samples as "stale" or "simulated" to exercise those paths.
+type FakeProvider struct {
+	data   *ReplayData
+	cursor map[string]int
+	mu     sync.Mutex
+}
+
+// NewFakeProvider creates a fake provider replaying the given data
+func NewFakeProvider(data *ReplayData) *FakeProvider {
+	if data == nil {
+		data = &ReplayData{}
+	}
+	return &FakeProvider{
+		data:   data,
+		cursor: make(map[string]int),
+	}
+}
+
+// Name returns the provider name
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+// next returns the index of the next sample for a series of n samples
+func (p *FakeProvider) next(series string, n int) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	i := p.cursor[series]
+	if i < n-1 {
+		p.cursor[series] = i + 1
+	}
+	return i
+}
+
+// replayed stamps a replayed sample: samples recorded without a timestamp are taken now,
+// and samples without a recorded provenance are treated as measured
+func replayed(timestamp *time.Time, provenance *types.MetricProvenance) {
+	if timestamp.IsZero() {
+		*timestamp = time.Now()
+	}
+	if *provenance == "" {
+		*provenance = types.ProvenanceAt(*timestamp)
+	}
+}
+
+// NodeUsage replays node CPU/memory samples
+func (p *FakeProvider) NodeUsage(ctx context.Context, nodeName string) (*Usage, error) {
+	samples := p.data.NodeUsage[nodeName]
+	if len(samples) == 0 {
+		return nil, unavailable("no recorded usage for node %s", nodeName)
+	}
+	sample := samples[p.next("node_usage/"+nodeName, len(samples))]
+	replayed(&sample.Timestamp, &sample.Provenance)
+	return &sample, nil
+}
+
+// PodUsage replays pod CPU/memory samples
+func (p *FakeProvider) PodUsage(ctx context.Context, namespace, podName string) (*Usage, error) {
+	key := namespace + "/" + podName
+	samples := p.data.PodUsage[key]
+	if len(samples) == 0 {
+		return nil, unavailable("no recorded usage for pod %s", key)
+	}
+	sample := samples[p.next("pod_usage/"+key, len(samples))]
+	replayed(&sample.Timestamp, &sample.Provenance)
+	return &sample, nil
+}
+
+// NodeStorageIO replays node storage I/O samples
+func (p *FakeProvider) NodeStorageIO(ctx context.Context, nodeName string) (*StorageIO, error) {
+	samples := p.data.NodeStorageIO[nodeName]
+	if len(samples) == 0 {
+		return nil, unavailable("no recorded storage I/O for node %s", nodeName)
+	}
+	sample := samples[p.next("node_storage_io/"+nodeName, len(samples))]
+	replayed(&sample.Timestamp, &sample.Provenance)
+	return &sample, nil
+}
+
+// PodStorageIO replays pod storage I/O samples
+func (p *FakeProvider) PodStorageIO(ctx context.Context, namespace, podName string) (*StorageIO, error) {
+	key := namespace + "/" + podName
+	samples := p.data.PodStorageIO[key]
+	if len(samples) == 0 {
+		return nil, unavailable("no recorded storage I/O for pod %s", key)
+	}
+	sample := samples[p.next("pod_storage_io/"+key, len(samples))]
+	replayed(&sample.Timestamp, &sample.Provenance)
+	return &sample, nil
+}
+
+// CacheStats replays cache statistics samples
+func (p *FakeProvider) CacheStats(ctx context.Context, namespace, cacheID string) (*CacheStats, error) {
+	key := namespace + "/" + cacheID
+	samples := p.data.CacheStats[key]
+	if len(samples) == 0 {
+		return nil, unavailable("no recorded statistics for cache %s", key)
+	}
+	sample := samples[p.next("cache_stats/"+key, len(samples))]
+	replayed(&sample.Timestamp, &sample.Provenance)
+	return &sample, nil
+}
+
+// Query replays the samples recorded for a PromQL query
+func (p *FakeProvider) Query(ctx context.Context, promql string) (*Sample, error) {
+	samples := p.data.Queries[promql]
+	if len(samples) == 0 {
+		return nil, unavailable("no recorded samples for query %s", promql)
+	}
+	sample := samples[p.next("query/"+promql, len(samples))]
+	replayed(&sample.Timestamp, &sample.Provenance)
+	return &sample, nil
+}
@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"ai-storage-orchestrator/pkg/types"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
+)
+
+// MetricsServerProvider reads CPU and memory usage from the metrics.k8s.io API.
+// metrics-server has no storage I/O or cache data, so those methods always report unavailable.
+type MetricsServerProvider struct {
+	client metricsclientset.Interface
+}
+
+// NewMetricsServerProvider creates a metrics-server provider
+func NewMetricsServerProvider(client metricsclientset.Interface) *MetricsServerProvider {
+	return &MetricsServerProvider{client: client}
+}
+
+// Name returns the provider name
+func (p *MetricsServerProvider) Name() string {
+	return "metrics-server"
+}
+
+// NodeUsage returns the CPU/memory usage of a node
+func (p *MetricsServerProvider) NodeUsage(ctx context.Context, nodeName string) (*Usage, error) {
+	nodeMetrics, err := p.client.MetricsV1beta1().NodeMetricses().Get(ctx, nodeName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, unavailable("no metrics-server data for node %s", nodeName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node metrics: %w", err)
+	}
+
+	return &Usage{
+		CPUMilli:    nodeMetrics.Usage.Cpu().MilliValue(),
+		MemoryBytes: nodeMetrics.Usage.Memory().Value(),
+		Timestamp:   nodeMetrics.Timestamp.Time,
+		Provenance:  types.ProvenanceAt(nodeMetrics.Timestamp.Time),
+	}, nil
+}
+
+// PodUsage returns the CPU/memory usage of a pod summed over its containers
+func (p *MetricsServerProvider) PodUsage(ctx context.Context, namespace, podName string) (*Usage, error) {
+	podMetrics, err := p.client.MetricsV1beta1().PodMetricses(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, unavailable("no metrics-server data for pod %s/%s", namespace, podName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod metrics: %w", err)
+	}
+
+	usage := &Usage{
+		Timestamp:  podMetrics.Timestamp.Time,
+		Provenance: types.ProvenanceAt(podMetrics.Timestamp.Time),
+	}
+	for _, container := range podMetrics.Containers {
+		usage.CPUMilli += container.Usage.Cpu().MilliValue()
+		usage.MemoryBytes += container.Usage.Memory().Value()
+	}
+	return usage, nil
+}
+
+// NodeStorageIO is not available from metrics-server
+func (p *MetricsServerProvider) NodeStorageIO(ctx context.Context, nodeName string) (*StorageIO, error) {
+	return nil, unavailable("metrics-server does not report storage I/O")
+}
+
+// PodStorageIO is not available from metrics-server
+func (p *MetricsServerProvider) PodStorageIO(ctx context.Context, namespace, podName string) (*StorageIO, error) {
+	return nil, unavailable("metrics-server does not report storage I/O")
+}
+
+// CacheStats is not available from metrics-server
+func (p *MetricsServerProvider) CacheStats(ctx context.Context, namespace, cacheID string) (*CacheStats, error) {
+	return nil, unavailable("metrics-server does not report cache statistics")
+}
+
+// Query is not available from metrics-server; custom metrics need PROMETHEUS_URL
+func (p *MetricsServerProvider) Query(ctx context.Context, promql string) (*Sample, error) {
+	return nil, unavailable("metrics-server does not support PromQL queries")
+}
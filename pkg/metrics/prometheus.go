@@ -0,0 +1,281 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+)
+
+const (
+	// defaultPrometheusTimeout bounds a single PromQL query
+	defaultPrometheusTimeout = 5 * time.Second
+	// bytesPerMB converts byte rates to MB/s
+	bytesPerMB = 1024 * 1024
+)
+
+// PrometheusConfig configures the Prometheus provider
+type PrometheusConfig struct {
+	// URL is the Prometheus base URL, e.g. http://prometheus-server.monitoring.svc:9090
+	URL string
+	// BearerToken is sent as "Authorization: Bearer" when set
+	BearerToken string
+	// Username/Password are sent as basic auth when set (ignored if BearerToken is set)
+	Username string
+	Password string
+	// Timeout bounds each query (default 5s)
+	Timeout time.Duration
+}
+
+// PrometheusProvider reads metrics with PromQL instant queries.
+// Node and pod series are matched on the node/namespace/pod labels added by kube-prometheus,
+// cache series on the namespace/cache labels of the Manta exporter.
+type PrometheusProvider struct {
+	baseURL    string
+	config     PrometheusConfig
+	httpClient *http.Client
+}
+
+// NewPrometheusProvider creates a Prometheus provider
+func NewPrometheusProvider(cfg PrometheusConfig) (*PrometheusProvider, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("prometheus URL is required")
+	}
+	if _, err := url.Parse(cfg.URL); err != nil {
+		return nil, fmt.Errorf("invalid prometheus URL %q: %w", cfg.URL, err)
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultPrometheusTimeout
+	}
+
+	return &PrometheusProvider{
+		baseURL:    strings.TrimSuffix(cfg.URL, "/"),
+		config:     cfg,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+	}, nil
+}
+
+// Name returns the provider name
+func (p *PrometheusProvider) Name() string {
+	return "prometheus"
+}
+
+// NodeUsage returns the container CPU/memory usage summed over a node
+func (p *PrometheusProvider) NodeUsage(ctx context.Context, nodeName string) (*Usage, error) {
+	selector := fmt.Sprintf(`node=%q,container!=""`, nodeName)
+	return p.usage(ctx, selector)
+}
+
+// PodUsage returns the CPU/memory usage of a pod
+func (p *PrometheusProvider) PodUsage(ctx context.Context, namespace, podName string) (*Usage, error) {
+	selector := fmt.Sprintf(`namespace=%q,pod=%q,container!=""`, namespace, podName)
+	return p.usage(ctx, selector)
+}
+
+func (p *PrometheusProvider) usage(ctx context.Context, selector string) (*Usage, error) {
+	cpu, ts, err := p.query(ctx, fmt.Sprintf(`sum(rate(container_cpu_usage_seconds_total{%s}[1m]))`, selector))
+	if err != nil {
+		return nil, err
+	}
+	memory, _, err := p.query(ctx, fmt.Sprintf(`sum(container_memory_working_set_bytes{%s})`, selector))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Usage{
+		CPUMilli:    int64(cpu * 1000),
+		MemoryBytes: int64(memory),
+		Timestamp:   ts,
+		Provenance:  types.ProvenanceAt(ts),
+	}, nil
+}
+
+// NodeStorageIO returns the disk I/O of a node from node-exporter series
+func (p *PrometheusProvider) NodeStorageIO(ctx context.Context, nodeName string) (*StorageIO, error) {
+	selector := fmt.Sprintf(`node=%q`, nodeName)
+
+	read, ts, err := p.query(ctx, fmt.Sprintf(`sum(rate(node_disk_read_bytes_total{%s}[1m]))`, selector))
+	if err != nil {
+		return nil, err
+	}
+	write, _, err := p.query(ctx, fmt.Sprintf(`sum(rate(node_disk_written_bytes_total{%s}[1m]))`, selector))
+	if err != nil {
+		return nil, err
+	}
+	iops, _, err := p.query(ctx, fmt.Sprintf(`sum(rate(node_disk_reads_completed_total{%[1]s}[1m])) + sum(rate(node_disk_writes_completed_total{%[1]s}[1m]))`, selector))
+	if err != nil {
+		return nil, err
+	}
+	busy, _, err := p.query(ctx, fmt.Sprintf(`max(rate(node_disk_io_time_seconds_total{%s}[1m]))`, selector))
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageIO{
+		ReadMBps:    int64(read / bytesPerMB),
+		WriteMBps:   int64(write / bytesPerMB),
+		IOPS:        int64(iops),
+		Utilization: int32(min(100, busy*100)),
+		Timestamp:   ts,
+		Provenance:  types.ProvenanceAt(ts),
+	}, nil
+}
+
+// PodStorageIO returns the container filesystem I/O of a pod from cAdvisor series
+func (p *PrometheusProvider) PodStorageIO(ctx context.Context, namespace, podName string) (*StorageIO, error) {
+	selector := fmt.Sprintf(`namespace=%q,pod=%q`, namespace, podName)
+
+	read, ts, err := p.query(ctx, fmt.Sprintf(`sum(rate(container_fs_reads_bytes_total{%s}[1m]))`, selector))
+	if err != nil {
+		return nil, err
+	}
+	write, _, err := p.query(ctx, fmt.Sprintf(`sum(rate(container_fs_writes_bytes_total{%s}[1m]))`, selector))
+	if err != nil {
+		return nil, err
+	}
+	iops, _, err := p.query(ctx, fmt.Sprintf(`sum(rate(container_fs_reads_total{%[1]s}[1m])) + sum(rate(container_fs_writes_total{%[1]s}[1m]))`, selector))
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageIO{
+		ReadMBps:   int64(read / bytesPerMB),
+		WriteMBps:  int64(write / bytesPerMB),
+		IOPS:       int64(iops),
+		Timestamp:  ts,
+		Provenance: types.ProvenanceAt(ts),
+	}, nil
+}
+
+// cacheQuery maps a PromQL template onto a CacheStats field
+type cacheQuery struct {
+	promql string
+	scale  float64
+	dest   *int64
+}
+
+// CacheStats returns the counters the Manta exporter reports for a cache
+func (p *PrometheusProvider) CacheStats(ctx context.Context, namespace, cacheID string) (*CacheStats, error) {
+	selector := fmt.Sprintf(`namespace=%q,cache=%q`, namespace, cacheID)
+
+	stats := &CacheStats{}
+	queries := []cacheQuery{
+		{`sum(manta_cache_requests_total{%s})`, 1, &stats.Requests},
+		{`sum(manta_cache_hits_total{%s})`, 1, &stats.Hits},
+		{`sum(rate(manta_cache_read_bytes_total{%s}[1m]))`, 1.0 / bytesPerMB, &stats.ReadMBps},
+		{`sum(rate(manta_cache_written_bytes_total{%s}[1m]))`, 1.0 / bytesPerMB, &stats.WriteMBps},
+		{`sum(rate(manta_cache_operations_total{%s}[1m]))`, 1, &stats.IOPS},
+	}
+
+	for i, q := range queries {
+		value, ts, err := p.query(ctx, fmt.Sprintf(q.promql, selector))
+		if err != nil {
+			return nil, err
+		}
+		*q.dest = int64(value * q.scale)
+		if i == 0 {
+			stats.Timestamp = ts
+		}
+	}
+
+	// 지연 시간은 exporter 버전에 따라 없을 수 있으므로 선택 항목
+	if v, _, err := p.query(ctx, fmt.Sprintf(`avg(manta_cache_read_latency_microseconds{%s})`, selector)); err == nil {
+		stats.AvgReadLatencyUs = int64(v)
+	}
+	if v, _, err := p.query(ctx, fmt.Sprintf(`avg(manta_cache_write_latency_microseconds{%s})`, selector)); err == nil {
+		stats.AvgWriteLatencyUs = int64(v)
+	}
+
+	stats.Provenance = types.ProvenanceAt(stats.Timestamp)
+	return stats, nil
+}
+
+// Query runs an arbitrary PromQL instant query returning a single sample
+func (p *PrometheusProvider) Query(ctx context.Context, promql string) (*Sample, error) {
+	value, ts, err := p.query(ctx, promql)
+	if err != nil {
+		return nil, err
+	}
+	return &Sample{Value: value, Timestamp: ts, Provenance: types.ProvenanceAt(ts)}, nil
+}
+
+// promResponse is the subset of the Prometheus query API response used here
+type promResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Value [2]interface{} `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// query runs an instant query that must return a single-sample vector
+func (p *PrometheusProvider) query(ctx context.Context, promql string) (float64, time.Time, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"/api/v1/query", nil)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to create prometheus request: %w", err)
+	}
+	req.URL.RawQuery = url.Values{"query": []string{promql}}.Encode()
+
+	if p.config.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+p.config.BearerToken)
+	} else if p.config.Username != "" {
+		req.SetBasicAuth(p.config.Username, p.config.Password)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to query prometheus: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to read prometheus response: %w", err)
+	}
+
+	var result promResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return 0, time.Time{}, fmt.Errorf("prometheus returned status %d with invalid body: %w", resp.StatusCode, err)
+	}
+	if result.Status != "success" {
+		return 0, time.Time{}, fmt.Errorf("prometheus query failed (%s): %s", result.ErrorType, result.Error)
+	}
+	if result.Data.ResultType != "vector" {
+		return 0, time.Time{}, fmt.Errorf("unexpected prometheus result type %q", result.Data.ResultType)
+	}
+	if len(result.Data.Result) == 0 {
+		return 0, time.Time{}, unavailable("no prometheus series for %s", promql)
+	}
+	if len(result.Data.Result) > 1 {
+		return 0, time.Time{}, fmt.Errorf("prometheus query %s returned %d series, expected a single sample (aggregate it, e.g. with sum)",
+			promql, len(result.Data.Result))
+	}
+
+	sample := result.Data.Result[0].Value
+	seconds, ok := sample[0].(float64)
+	if !ok {
+		return 0, time.Time{}, fmt.Errorf("invalid prometheus sample timestamp %v", sample[0])
+	}
+	raw, ok := sample[1].(string)
+	if !ok {
+		return 0, time.Time{}, fmt.Errorf("invalid prometheus sample value %v", sample[1])
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("invalid prometheus sample value %q: %w", raw, err)
+	}
+
+	ts := time.Unix(0, int64(seconds*float64(time.Second)))
+	return value, ts, nil
+}
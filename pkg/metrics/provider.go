@@ -0,0 +1,85 @@
+// Package metrics defines the source of utilization metrics used by the orchestrator.
+// 메트릭 출처(Prometheus, metrics-server, 재생용 fake)를 설정으로 선택하고,
+// 데이터가 없으면 임의 값 대신 ErrUnavailable을 반환한다.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"ai-storage-orchestrator/pkg/types"
+)
+
+// ErrUnavailable is returned (wrapped) when a provider has no data for the requested object
+var ErrUnavailable = errors.New("metrics unavailable")
+
+// unavailable wraps ErrUnavailable with the reason
+func unavailable(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrUnavailable, fmt.Sprintf(format, args...))
+}
+
+// Usage is the CPU and memory usage of a node or pod
+type Usage struct {
+	CPUMilli    int64     `json:"cpu_milli"`
+	MemoryBytes int64     `json:"memory_bytes"`
+	Timestamp   time.Time `json:"timestamp"`
+
+	Provenance types.MetricProvenance `json:"provenance,omitempty"`
+}
+
+// StorageIO is the storage I/O rate of a node or pod
+type StorageIO struct {
+	ReadMBps    int64     `json:"read_mbps"`
+	WriteMBps   int64     `json:"write_mbps"`
+	IOPS        int64     `json:"iops"`
+	Utilization int32     `json:"utilization"` // busy time percentage, node level only
+	Timestamp   time.Time `json:"timestamp"`
+
+	Provenance types.MetricProvenance `json:"provenance,omitempty"`
+}
+
+// CacheStats are the counters reported by the cache storage backend for one cache
+type CacheStats struct {
+	Requests          int64     `json:"requests"`
+	Hits              int64     `json:"hits"`
+	ReadMBps          int64     `json:"read_mbps"`
+	WriteMBps         int64     `json:"write_mbps"`
+	IOPS              int64     `json:"iops"`
+	AvgReadLatencyUs  int64     `json:"avg_read_latency_us"`
+	AvgWriteLatencyUs int64     `json:"avg_write_latency_us"`
+	Timestamp         time.Time `json:"timestamp"`
+
+	Provenance types.MetricProvenance `json:"provenance,omitempty"`
+}
+
+// Sample is the result of a PromQL instant query, used for custom autoscaling metrics
+type Sample struct {
+	Value     float64   `json:"value"`
+	Timestamp time.Time `json:"timestamp"`
+
+	Provenance types.MetricProvenance `json:"provenance,omitempty"`
+}
+
+// Provider supplies node, pod and cache metrics
+// Every method returns an error wrapping ErrUnavailable when the provider has no data,
+// and every sample carries its provenance (real, or stale when older than types.MetricsMaxAge)
+type Provider interface {
+	// Name identifies the provider in logs and status messages
+	Name() string
+
+	NodeUsage(ctx context.Context, nodeName string) (*Usage, error)
+	PodUsage(ctx context.Context, namespace, podName string) (*Usage, error)
+	NodeStorageIO(ctx context.Context, nodeName string) (*StorageIO, error)
+	PodStorageIO(ctx context.Context, namespace, podName string) (*StorageIO, error)
+	CacheStats(ctx context.Context, namespace, cacheID string) (*CacheStats, error)
+
+	// Query runs a PromQL instant query that must return a single sample
+	Query(ctx context.Context, promql string) (*Sample, error)
+}
+
+// IsUnavailable reports whether err means the metrics are missing rather than a query failure
+func IsUnavailable(err error) bool {
+	return errors.Is(err, ErrUnavailable)
+}
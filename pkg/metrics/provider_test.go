@@ -0,0 +1,161 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPrometheusProvider tests PromQL response parsing, auth and empty results
+func TestPrometheusProvider(t *testing.T) {
+	var authHeaders []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+		query := r.URL.Query().Get("query")
+
+		value := ""
+		switch {
+		case strings.Contains(query, `node="node-missing"`):
+		case strings.Contains(query, "container_cpu_usage_seconds_total"):
+			value = "1.5"
+		case strings.Contains(query, "container_memory_working_set_bytes"):
+			value = "2147483648"
+		case strings.Contains(query, "vllm_num_requests_waiting"):
+			value = "42"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasPrefix(query, "vllm_num_requests_waiting") {
+			// 집계하지 않은 쿼리는 파드별 시계열을 반환
+			fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[`+
+				`{"metric":{"pod":"a"},"value":[1760000000.5,"1"]},{"metric":{"pod":"b"},"value":[1760000000.5,"2"]}]}}`)
+			return
+		}
+		if value == "" {
+			fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[]}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1760000000.5,"%s"]}]}}`, value)
+	}))
+	defer server.Close()
+
+	provider, err := NewPrometheusProvider(PrometheusConfig{URL: server.URL + "/", BearerToken: "secret"})
+	require.NoError(t, err)
+
+	usage, err := provider.NodeUsage(context.Background(), "node-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1500), usage.CPUMilli)
+	assert.Equal(t, int64(2147483648), usage.MemoryBytes)
+	assert.Equal(t, int64(1760000000), usage.Timestamp.Unix())
+	assert.Equal(t, "Bearer secret", authHeaders[0])
+
+	// 시계열이 없으면 0이 아니라 ErrUnavailable
+	_, err = provider.NodeUsage(context.Background(), "node-missing")
+	assert.True(t, IsUnavailable(err))
+
+	// 커스텀 메트릭 쿼리
+	sample, err := provider.Query(context.Background(), `sum(vllm_num_requests_waiting{namespace="inference"})`)
+	require.NoError(t, err)
+	assert.Equal(t, 42.0, sample.Value)
+	_, err = provider.Query(context.Background(), `sum(up{node="node-missing"})`)
+	assert.True(t, IsUnavailable(err))
+
+	// 여러 시계열 중 임의의 하나를 쓰지 않고 오류
+	_, err = provider.Query(context.Background(), `vllm_num_requests_waiting{namespace="inference"}`)
+	require.Error(t, err)
+	assert.False(t, IsUnavailable(err))
+	assert.Contains(t, err.Error(), "returned 2 series")
+
+	_, err = NewPrometheusProvider(PrometheusConfig{})
+	assert.Error(t, err)
+}
+
+// TestFakeProviderReplay tests that recorded samples are replayed in order and then held
+func TestFakeProviderReplay(t *testing.T) {
+	provider := NewFakeProvider(&ReplayData{
+		NodeStorageIO: map[string][]StorageIO{
+			"node-1": {{ReadMBps: 100}, {ReadMBps: 200}},
+		},
+	})
+	ctx := context.Background()
+
+	for _, want := range []int64{100, 200, 200} {
+		sample, err := provider.NodeStorageIO(ctx, "node-1")
+		require.NoError(t, err)
+		assert.Equal(t, want, sample.ReadMBps)
+	}
+
+	_, err := provider.NodeStorageIO(ctx, "node-2")
+	assert.True(t, IsUnavailable(err))
+	_, err = provider.CacheStats(ctx, "default", "cache-1")
+	assert.True(t, IsUnavailable(err))
+}
+
+// TestChainFallback tests that the chain skips providers without data
+func TestChainFallback(t *testing.T) {
+	base := NewFakeProvider(&ReplayData{
+		NodeUsage: map[string][]Usage{"node-1": {{CPUMilli: 500}}},
+	})
+	storage := NewFakeProvider(&ReplayData{
+		NodeStorageIO: map[string][]StorageIO{"node-1": {{IOPS: 3000}}},
+	})
+	chain := NewChain(base, storage)
+	ctx := context.Background()
+
+	usage, err := chain.NodeUsage(ctx, "node-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), usage.CPUMilli)
+
+	io, err := chain.NodeStorageIO(ctx, "node-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3000), io.IOPS)
+
+	_, err = chain.PodUsage(ctx, "default", "pod-1")
+	assert.True(t, IsUnavailable(err))
+	assert.Equal(t, "fake+fake", chain.Name())
+}
+
+// TestNewProvider tests provider selection from config
+func TestNewProvider(t *testing.T) {
+	p, err := New(Config{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ProviderMetricsServer, p.Name())
+
+	p, err = New(Config{Prometheus: PrometheusConfig{URL: "http://prometheus:9090"}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "metrics-server+prometheus", p.Name())
+
+	p, err = New(Config{Provider: ProviderFake}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ProviderFake, p.Name())
+
+	_, err = New(Config{Provider: "datadog"}, nil)
+	assert.Error(t, err)
+}
+
+// TestFakeProviderProvenance tests that replayed samples keep a recorded provenance
+func TestFakeProviderProvenance(t *testing.T) {
+	provider := NewFakeProvider(&ReplayData{
+		PodUsage: map[string][]Usage{
+			"default/trainer": {{CPUMilli: 100}, {CPUMilli: 200, Provenance: types.ProvenanceSimulated}},
+		},
+	})
+	ctx := context.Background()
+
+	usage, err := provider.PodUsage(ctx, "default", "trainer")
+	require.NoError(t, err)
+	assert.Equal(t, types.ProvenanceReal, usage.Provenance)
+	assert.False(t, usage.Timestamp.IsZero())
+
+	usage, err = provider.PodUsage(ctx, "default", "trainer")
+	require.NoError(t, err)
+	assert.Equal(t, types.ProvenanceSimulated, usage.Provenance)
+}
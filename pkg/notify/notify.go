@@ -0,0 +1,187 @@
+// Package notify delivers job events to webhook targets.
+// 작업 이벤트를 generic JSON, Slack, MS Teams 형식으로 만들고 HMAC 서명을 붙여 전송한다.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"ai-storage-orchestrator/pkg/eventbus"
+	"ai-storage-orchestrator/pkg/types"
+)
+
+// Delivery headers
+const (
+	HeaderSignature = "X-Orchestrator-Signature"
+	HeaderTimestamp = "X-Orchestrator-Timestamp"
+	HeaderEvent     = "X-Orchestrator-Event"
+	HeaderDelivery  = "X-Orchestrator-Delivery"
+)
+
+// source identifies the orchestrator in generic payloads
+const source = "ai-storage-orchestrator"
+
+// GenericPayload is the body posted to generic webhook targets
+type GenericPayload struct {
+	Source    string         `json:"source"`
+	WebhookID string         `json:"webhook_id"`
+	Event     eventbus.Event `json:"event"`
+}
+
+// Payload builds the body of a delivery in the format of the target
+func Payload(format types.WebhookFormat, webhookID string, event *eventbus.Event) ([]byte, error) {
+	switch format {
+	case types.WebhookFormatSlack:
+		return json.Marshal(map[string]string{"text": Summary(event)})
+	case types.WebhookFormatTeams:
+		return json.Marshal(map[string]interface{}{
+			"@type":      "MessageCard",
+			"@context":   "http://schema.org/extensions",
+			"summary":    Title(event),
+			"themeColor": themeColor(event),
+			"title":      Title(event),
+			"text":       Summary(event),
+		})
+	case types.WebhookFormatGeneric, "":
+		return json.Marshal(&GenericPayload{Source: source, WebhookID: webhookID, Event: *event})
+	default:
+		return nil, fmt.Errorf("unsupported webhook format %s", format)
+	}
+}
+
+// Title returns a short title such as "migration migration-1a2b3c4d failed"
+func Title(event *eventbus.Event) string {
+	state := event.Status
+	if event.Reason != "" {
+		state = event.Reason
+	}
+	if state == "" {
+		state = string(event.Type)
+	}
+	return fmt.Sprintf("%s %s %s", event.Kind, event.JobID, state)
+}
+
+// Summary returns the one-line chat message of an event
+func Summary(event *eventbus.Event) string {
+	text := "[" + source + "] " + Title(event)
+	if event.Namespace != "" {
+		text += " (namespace " + event.Namespace + ")"
+	}
+	if event.Message != "" {
+		text += ": " + event.Message
+	}
+	return text
+}
+
+// themeColor colors Teams cards red for failures, orange for limits and green otherwise
+func themeColor(event *eventbus.Event) string {
+	switch {
+	case event.Status == "failed" || event.Status == "cancelled" || strings.HasSuffix(event.Reason, "Failed"):
+		return "D9534F"
+	case event.Reason == eventbus.ReasonMaxReplicasReached:
+		return "F0AD4E"
+	default:
+		return "2EB886"
+	}
+}
+
+// Sign returns the signature of a delivery: "sha256=" followed by the hex HMAC-SHA256
+// of "<timestamp>.<body>". Receivers recompute it and reject stale timestamps to stop replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// DeliveryError is returned when a target rejected or could not receive a delivery
+type DeliveryError struct {
+	StatusCode int // 0 when no response was received
+	Err        error
+}
+
+func (e *DeliveryError) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("webhook returned HTTP %d: %v", e.StatusCode, e.Err)
+	}
+	return e.Err.Error()
+}
+
+func (e *DeliveryError) Unwrap() error { return e.Err }
+
+// Retryable reports whether the delivery may succeed later: network errors, timeouts,
+// 408, 429 and 5xx responses are retried, other 4xx responses are not
+func (e *DeliveryError) Retryable() bool {
+	switch {
+	case e.StatusCode == 0:
+		return true
+	case e.StatusCode == http.StatusRequestTimeout, e.StatusCode == http.StatusTooManyRequests:
+		return true
+	default:
+		return e.StatusCode >= 500
+	}
+}
+
+// Sender posts deliveries to webhook targets
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender creates a sender; each attempt is bounded by timeout
+func NewSender(timeout time.Duration) *Sender {
+	return &Sender{client: &http.Client{Timeout: timeout}}
+}
+
+// Send posts a signed delivery. A nil error means the target answered 2xx.
+func (s *Sender) Send(ctx context.Context, url, secret, deliveryID string, event *eventbus.Event, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return &DeliveryError{Err: fmt.Errorf("invalid webhook request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", source)
+	req.Header.Set(HeaderEvent, event.Kind+"."+string(event.Type))
+	req.Header.Set(HeaderDelivery, deliveryID)
+	if secret != "" {
+		timestamp := time.Now().Unix()
+		req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(HeaderSignature, Sign(secret, timestamp, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return &DeliveryError{Err: err}
+	}
+	defer resp.Body.Close()
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &DeliveryError{StatusCode: resp.StatusCode, Err: fmt.Errorf("%s", bytes.TrimSpace(snippet))}
+	}
+	return nil
+}
+
+// Backoff returns the wait before retry number attempt (1-based): base doubled per attempt,
+// capped at max, with up to 20% jitter so that retries to one target do not align
+func Backoff(attempt int, base, max time.Duration) time.Duration {
+	wait := base
+	for i := 1; i < attempt && wait < max; i++ {
+		wait *= 2
+	}
+	if wait > max {
+		wait = max
+	}
+	return wait + time.Duration(rand.Int63n(int64(wait)/5+1))
+}
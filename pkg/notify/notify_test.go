@@ -0,0 +1,91 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"ai-storage-orchestrator/pkg/eventbus"
+	"ai-storage-orchestrator/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayload(t *testing.T) {
+	event := &eventbus.Event{ID: 7, Kind: eventbus.KindMigration, Type: eventbus.TypeStatus,
+		JobID: "migration-1a2b3c4d", Namespace: "ml", Status: "failed", Message: "checkpoint failed"}
+
+	body, err := Payload(types.WebhookFormatGeneric, "webhook-1", event)
+	require.NoError(t, err)
+	var generic GenericPayload
+	require.NoError(t, json.Unmarshal(body, &generic))
+	assert.Equal(t, "webhook-1", generic.WebhookID)
+	assert.Equal(t, uint64(7), generic.Event.ID)
+
+	body, err = Payload(types.WebhookFormatSlack, "webhook-1", event)
+	require.NoError(t, err)
+	var slack map[string]string
+	require.NoError(t, json.Unmarshal(body, &slack))
+	assert.Equal(t, "[ai-storage-orchestrator] migration migration-1a2b3c4d failed (namespace ml): checkpoint failed", slack["text"])
+
+	body, err = Payload(types.WebhookFormatTeams, "webhook-1", event)
+	require.NoError(t, err)
+	var teams map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &teams))
+	assert.Equal(t, "MessageCard", teams["@type"])
+	assert.Equal(t, "D9534F", teams["themeColor"])
+
+	_, err = Payload("pager", "webhook-1", event)
+	assert.Error(t, err)
+}
+
+func TestSend(t *testing.T) {
+	var status int
+	var got *http.Request
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	event := &eventbus.Event{ID: 3, Kind: eventbus.KindPreemption, Type: eventbus.TypeOutcome}
+	body := []byte(`{"test":true}`)
+	sender := NewSender(time.Second)
+
+	status = http.StatusOK
+	require.NoError(t, sender.Send(context.Background(), server.URL, "s3cret", "webhook-1-3", event, body))
+	assert.Equal(t, "preemption.outcome", got.Header.Get(HeaderEvent))
+	assert.Equal(t, "webhook-1-3", got.Header.Get(HeaderDelivery))
+	timestamp, err := strconv.ParseInt(got.Header.Get(HeaderTimestamp), 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, Sign("s3cret", timestamp, gotBody), got.Header.Get(HeaderSignature))
+
+	status = http.StatusServiceUnavailable
+	err = sender.Send(context.Background(), server.URL, "", "webhook-1-3", event, body)
+	var deliveryErr *DeliveryError
+	require.ErrorAs(t, err, &deliveryErr)
+	assert.Equal(t, http.StatusServiceUnavailable, deliveryErr.StatusCode)
+	assert.True(t, deliveryErr.Retryable())
+	assert.Empty(t, got.Header.Get(HeaderSignature), "unsigned without secret")
+
+	status = http.StatusBadRequest
+	err = sender.Send(context.Background(), server.URL, "", "webhook-1-3", event, body)
+	require.ErrorAs(t, err, &deliveryErr)
+	assert.False(t, deliveryErr.Retryable())
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 4: 8 * time.Second, 10: time.Minute} {
+		wait := Backoff(attempt, time.Second, time.Minute)
+		assert.GreaterOrEqual(t, wait, want)
+		assert.LessOrEqual(t, wait, want+want/5)
+	}
+}
@@ -0,0 +1,281 @@
+// Package schedule evaluates cron-style time windows for scheduled scaling.
+// 야간 학습과 업무 시간 추론 피크처럼 시간대별로 다른 레플리카 범위를 적용하기 위해 사용한다.
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// 컨테이너 이미지에 zoneinfo가 없어도 IANA 타임존을 사용할 수 있도록 내장
+	_ "time/tzdata"
+)
+
+// maxLookback bounds the search for the last start or end of a window; monthly schedules fit
+const maxLookback = 32 * 24 * time.Hour
+
+// Cron is a parsed five-field cron expression: minute hour day-of-month month day-of-week
+type Cron struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+}
+
+// field describes the range and names of one cron field
+type field struct {
+	name     string
+	min, max int
+	names    []string // names[i] is value min+i
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12,
+		names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}}
+	dowField = field{name: "day of week", min: 0, max: 7,
+		names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}}
+)
+
+// ParseCron parses a five-field cron expression such as "0 9 * * 1-5". Fields accept *, lists,
+// ranges, steps and, for months and days of week, three-letter names. Day of week 7 is Sunday.
+func ParseCron(expr string) (*Cron, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields (minute hour day-of-month month day-of-week)", expr)
+	}
+
+	c := &Cron{}
+	var err error
+	if c.minute, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if c.hour, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if c.dom, err = domField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if c.month, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if c.dow, err = dowField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	// 7과 0은 모두 일요일
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	c.domStar = fields[2] == "*"
+	c.dowStar = fields[4] == "*"
+	return c, nil
+}
+
+// parse returns the bitmask of the values selected by a field expression
+func (f field) parse(expr string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(expr, ",") {
+		rangeExpr, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid step in %s field %q", f.name, part)
+			}
+			rangeExpr, step = part[:i], n
+		}
+
+		var lo, hi int
+		switch {
+		case rangeExpr == "*":
+			lo, hi = f.min, f.max
+		case strings.Contains(rangeExpr, "-"):
+			bounds := strings.SplitN(rangeExpr, "-", 2)
+			var err error
+			if lo, err = f.value(bounds[0]); err != nil {
+				return 0, err
+			}
+			if hi, err = f.value(bounds[1]); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range in %s field %q", f.name, part)
+			}
+		default:
+			v, err := f.value(rangeExpr)
+			if err != nil {
+				return 0, err
+			}
+			lo, hi = v, v
+			if step > 1 {
+				hi = f.max
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// value parses a single number or name of a field
+func (f field) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid %s %q (must be %d-%d)", f.name, s, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Matches reports whether the cron expression fires in the minute of t, in t's location.
+// As in standard cron, a restricted day of month and day of week match when either matches.
+func (c *Cron) Matches(t time.Time) bool {
+	return c.minute&(1<<uint(t.Minute())) != 0 && c.hour&(1<<uint(t.Hour())) != 0 && c.dayMatches(t)
+}
+
+// dayMatches reports whether the month and day of t match
+func (c *Cron) dayMatches(t time.Time) bool {
+	if c.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// next returns the first minute after t that the expression fires in, evaluated in loc, if it is before limit.
+// Days and hours that cannot match are skipped whole instead of minute by minute.
+func (c *Cron) next(t time.Time, loc *time.Location, limit time.Time) (time.Time, bool) {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for t.Before(limit) {
+		local := t.In(loc)
+		var skipTo time.Time
+		switch {
+		case !c.dayMatches(local):
+			skipTo = time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, loc)
+		case c.hour&(1<<uint(local.Hour())) == 0:
+			skipTo = t.Add(time.Duration(60-local.Minute()) * time.Minute)
+		case c.minute&(1<<uint(local.Minute())) == 0:
+			skipTo = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+		// 서머타임 전환으로 같은 시각이 반복되어도 항상 앞으로 진행
+		if !skipTo.After(t) {
+			skipTo = t.Add(time.Minute)
+		}
+		t = skipTo
+	}
+	return time.Time{}, false
+}
+
+// prev returns the last minute at or before t that the expression fires in, evaluated in loc,
+// if it is not before limit
+func (c *Cron) prev(t time.Time, loc *time.Location, limit time.Time) (time.Time, bool) {
+	t = t.Truncate(time.Minute)
+	for !t.Before(limit) {
+		local := t.In(loc)
+		var skipTo time.Time
+		switch {
+		case !c.dayMatches(local):
+			skipTo = time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc).Add(-time.Minute)
+		case c.hour&(1<<uint(local.Hour())) == 0:
+			skipTo = t.Add(-time.Duration(local.Minute()+1) * time.Minute)
+		case c.minute&(1<<uint(local.Minute())) == 0:
+			skipTo = t.Add(-time.Minute)
+		default:
+			return t, true
+		}
+		if !skipTo.Before(t) {
+			skipTo = t.Add(-time.Minute)
+		}
+		t = skipTo
+	}
+	return time.Time{}, false
+}
+
+// Window is a recurring time window that opens when Start fires and closes when End fires
+type Window struct {
+	start, end *Cron
+	location   *time.Location
+}
+
+// NewWindow parses the start and end cron expressions of a window in an IANA timezone, UTC when empty
+func NewWindow(start, end, timezone string) (*Window, error) {
+	startCron, err := ParseCron(start)
+	if err != nil {
+		return nil, fmt.Errorf("start: %w", err)
+	}
+	endCron, err := ParseCron(end)
+	if err != nil {
+		return nil, fmt.Errorf("end: %w", err)
+	}
+	location := time.UTC
+	if timezone != "" {
+		if location, err = time.LoadLocation(timezone); err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+		}
+	}
+	return &Window{start: startCron, end: endCron, location: location}, nil
+}
+
+// Active reports whether the window is open at t: its start fired more recently than its end.
+// The start minute is inside the window and the end minute outside; windows that fired neither
+// within the last 32 days are closed.
+func (w *Window) Active(t time.Time) bool {
+	limit := t.Truncate(time.Minute).Add(-maxLookback)
+	lastStart, started := w.start.prev(t, w.location, limit)
+	if !started {
+		return false
+	}
+	lastEnd, ended := w.end.prev(t, w.location, limit)
+	return !ended || lastStart.After(lastEnd)
+}
+
+// Change is the opening or closing of a window
+type Change struct {
+	At     time.Time
+	Active bool
+}
+
+// Changes returns whether the window is open at from and every opening or closing after from
+// within d, in order
+func (w *Window) Changes(from time.Time, d time.Duration) (bool, []Change) {
+	active := w.Active(from)
+	state := active
+	var changes []Change
+	at := from.Truncate(time.Minute)
+	limit := at.Add(d)
+	for {
+		var ok bool
+		if state {
+			at, ok = w.end.next(at, w.location, limit)
+		} else {
+			at, ok = w.nextOpening(at, limit)
+		}
+		if !ok {
+			return active, changes
+		}
+		state = !state
+		changes = append(changes, Change{At: at.In(from.Location()), Active: state})
+	}
+}
+
+// nextOpening returns the first minute after t the window opens in: its start fires and its end does not
+func (w *Window) nextOpening(t, limit time.Time) (time.Time, bool) {
+	for {
+		at, ok := w.start.next(t, w.location, limit)
+		if !ok || !w.end.Matches(at.In(w.location)) {
+			return at, ok
+		}
+		t = at
+	}
+}
@@ -0,0 +1,135 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseCron tests cron fields, names and the day-of-month/day-of-week rule
+func TestParseCron(t *testing.T) {
+	c, err := ParseCron("*/15 9-17 * * mon-fri")
+	require.NoError(t, err)
+	assert.True(t, c.Matches(time.Date(2026, 10, 16, 9, 45, 0, 0, time.UTC)))  // Friday
+	assert.False(t, c.Matches(time.Date(2026, 10, 16, 9, 50, 0, 0, time.UTC))) // not a quarter hour
+	assert.False(t, c.Matches(time.Date(2026, 10, 17, 9, 45, 0, 0, time.UTC))) // Saturday
+	assert.False(t, c.Matches(time.Date(2026, 10, 16, 18, 0, 0, 0, time.UTC))) // after hours
+
+	// Day of month and day of week both restricted: either matches
+	c, err = ParseCron("0 0 1 * 7")
+	require.NoError(t, err)
+	assert.True(t, c.Matches(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)))  // Thursday the 1st
+	assert.True(t, c.Matches(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC))) // Sunday
+	assert.False(t, c.Matches(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)))
+
+	for _, expr := range []string{"0 9 * *", "60 9 * * *", "0 9 * * 1-8", "0 18-9 * * *", "*/0 * * * *", "0 9 * foo *"} {
+		_, err := ParseCron(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+// TestWindow tests an overnight window in a timezone and its changes over a day
+func TestWindow(t *testing.T) {
+	_, err := NewWindow("0 22 * * *", "0 6 * * *", "Mars/Olympus_Mons")
+	assert.Error(t, err)
+
+	w, err := NewWindow("0 22 * * *", "0 6 * * *", "Asia/Seoul")
+	require.NoError(t, err)
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+
+	assert.True(t, w.Active(time.Date(2026, 10, 16, 23, 30, 0, 0, seoul)))
+	assert.True(t, w.Active(time.Date(2026, 10, 16, 22, 0, 0, 0, seoul)), "start minute is inside")
+	assert.True(t, w.Active(time.Date(2026, 10, 17, 5, 59, 0, 0, seoul)))
+	assert.False(t, w.Active(time.Date(2026, 10, 17, 6, 0, 0, 0, seoul)), "end minute is outside")
+	// 13:00 UTC is 22:00 in Seoul
+	assert.True(t, w.Active(time.Date(2026, 10, 16, 13, 0, 0, 0, time.UTC)))
+
+	active, changes := w.Changes(time.Date(2026, 10, 16, 12, 0, 0, 0, seoul), 24*time.Hour)
+	assert.False(t, active)
+	require.Len(t, changes, 2)
+	assert.True(t, changes[0].At.Equal(time.Date(2026, 10, 16, 22, 0, 0, 0, seoul)))
+	assert.True(t, changes[0].Active)
+	assert.True(t, changes[1].At.Equal(time.Date(2026, 10, 17, 6, 0, 0, 0, seoul)))
+	assert.False(t, changes[1].Active)
+
+	// A window that never fired is closed
+	w, err = NewWindow("0 0 30 2 *", "0 1 30 2 *", "")
+	require.NoError(t, err)
+	assert.False(t, w.Active(time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)))
+}
+
+// activeByScan is the reference for Window.Active: it walks back minute by minute
+func activeByScan(w *Window, t time.Time) bool {
+	m := t.Truncate(time.Minute)
+	for elapsed := time.Duration(0); elapsed <= maxLookback; elapsed += time.Minute {
+		at := m.Add(-elapsed).In(w.location)
+		if w.end.Matches(at) {
+			return false
+		}
+		if w.start.Matches(at) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestWindowMatchesScan tests that the computed window boundaries agree with a minute-by-minute scan,
+// across daylight saving changes and for windows that open once a month or overlap their end
+func TestWindowMatchesScan(t *testing.T) {
+	windows := []struct{ start, end, timezone string }{
+		{"0 22 * * *", "0 6 * * *", "Asia/Seoul"},
+		{"30 1 * * *", "30 3 * * *", "America/New_York"}, // inside the DST gap and the repeated hour
+		{"0 9 * * 1-5", "0 18 * * 1-5", "Europe/Berlin"},
+		{"0 0 1 * *", "0 0 15 * *", ""},                      // monthly
+		{"*/15 * * * *", "0 * * * *", "Australia/Lord_Howe"}, // 30 minute DST shift, start and end coincide
+		{"0 * * * *", "0,30 * * * *", ""},                    // end fires with every start, never opens
+		{"0 0 30 2 *", "0 1 30 2 *", ""},                     // never fires
+	}
+	// Hours before the 2026 DST changes in the US, Europe and on Lord Howe Island, and a month without them
+	starts := []time.Time{
+		time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 4, 4, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 3, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 6, 14, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, spec := range windows {
+		w, err := NewWindow(spec.start, spec.end, spec.timezone)
+		require.NoError(t, err)
+		for _, from := range starts {
+			// 하루 동안 13분 간격으로 비교
+			for at := from; at.Before(from.Add(24 * time.Hour)); at = at.Add(13 * time.Minute) {
+				require.Equal(t, activeByScan(w, at), w.Active(at), "%s-%s %s at %s", spec.start, spec.end, spec.timezone, at)
+			}
+
+			active, changes := w.Changes(from, 48*time.Hour)
+			state := activeByScan(w, from)
+			assert.Equal(t, state, active)
+			next := 0
+			for elapsed := time.Minute; elapsed < 48*time.Hour; elapsed += time.Minute {
+				at := from.Add(elapsed)
+				local := at.In(w.location)
+				s := state
+				switch {
+				case w.end.Matches(local):
+					s = false
+				case w.start.Matches(local):
+					s = true
+				}
+				if s != state {
+					require.Less(t, next, len(changes), "%s-%s %s: missing change at %s", spec.start, spec.end, spec.timezone, at)
+					assert.True(t, changes[next].At.Equal(at), "%s-%s %s: change at %s, want %s", spec.start, spec.end, spec.timezone, changes[next].At, at)
+					assert.Equal(t, s, changes[next].Active)
+					state = s
+					next++
+				}
+			}
+			assert.Len(t, changes, next, "%s-%s %s from %s", spec.start, spec.end, spec.timezone, from)
+		}
+	}
+}
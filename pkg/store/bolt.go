@@ -0,0 +1,79 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// BoltStore is an embedded, single-file JobStore backed by BoltDB
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens (or creates) the BoltDB file at path
+func NewBoltStore(path string) (*BoltStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create store directory: %w", err)
+	}
+
+	// Timeout prevents blocking forever when another process holds the file lock
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open job store %s: %w", path, err)
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// Save creates or replaces a record
+func (s *BoltStore) Save(kind, id string, record interface{}) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s/%s: %w", kind, id, err)
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(kind))
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte(id), data)
+	})
+}
+
+// Delete removes a record
+func (s *BoltStore) Delete(kind, id string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(kind))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.Delete([]byte(id))
+	})
+}
+
+// ForEach iterates over all records of a kind
+func (s *BoltStore) ForEach(kind string, fn func(id string, data []byte) error) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(kind))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			// Values are only valid for the lifetime of the transaction
+			data := make([]byte, len(v))
+			copy(data, v)
+			return fn(string(k), data)
+		})
+	})
+}
+
+// Close closes the underlying database file
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,105 @@
+package store
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+type testRecord struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// readAll returns the records of a kind, decoded
+func readAll(t *testing.T, s JobStore, kind string) map[string]testRecord {
+	records := map[string]testRecord{}
+	require.NoError(t, s.ForEach(kind, func(id string, data []byte) error {
+		var rec testRecord
+		require.NoError(t, json.Unmarshal(data, &rec))
+		records[id] = rec
+		return nil
+	}))
+	return records
+}
+
+func TestBoltStoreRoundTrip(t *testing.T) {
+	s, err := NewBoltStore(filepath.Join(t.TempDir(), "state", "jobs.db"))
+	require.NoError(t, err)
+	defer s.Close()
+
+	// A kind without records has no bucket yet
+	assert.Empty(t, readAll(t, s, KindMigration))
+
+	require.NoError(t, s.Save(KindMigration, "migration-1", &testRecord{ID: "migration-1", Status: "pending"}))
+	require.NoError(t, s.Save(KindMigration, "migration-2", &testRecord{ID: "migration-2", Status: "running"}))
+	require.NoError(t, s.Save(KindCache, "cache-1", &testRecord{ID: "cache-1", Status: "active"}))
+
+	// Save replaces the record
+	require.NoError(t, s.Save(KindMigration, "migration-1", &testRecord{ID: "migration-1", Status: "completed"}))
+	assert.Equal(t, map[string]testRecord{
+		"migration-1": {ID: "migration-1", Status: "completed"},
+		"migration-2": {ID: "migration-2", Status: "running"},
+	}, readAll(t, s, KindMigration))
+
+	// Delete removes only the record, and ignores missing records and kinds
+	require.NoError(t, s.Delete(KindMigration, "migration-2"))
+	require.NoError(t, s.Delete(KindMigration, "migration-2"))
+	require.NoError(t, s.Delete(KindWebhook, "webhook-1"))
+	assert.Equal(t, map[string]testRecord{"migration-1": {ID: "migration-1", Status: "completed"}}, readAll(t, s, KindMigration))
+	assert.Equal(t, map[string]testRecord{"cache-1": {ID: "cache-1", Status: "active"}}, readAll(t, s, KindCache))
+}
+
+func TestBoltStoreRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.db")
+
+	s, err := NewBoltStore(path)
+	require.NoError(t, err)
+	require.NoError(t, s.Save(KindPreemption, "preemption-1", &testRecord{ID: "preemption-1", Status: "running"}))
+	require.NoError(t, s.Save(KindPreemption, "preemption-2", &testRecord{ID: "preemption-2", Status: "completed"}))
+	require.NoError(t, s.Delete(KindPreemption, "preemption-2"))
+	require.NoError(t, s.Close())
+
+	// The records written before the restart are read back by the next process
+	s, err = NewBoltStore(path)
+	require.NoError(t, err)
+	defer s.Close()
+	assert.Equal(t, map[string]testRecord{"preemption-1": {ID: "preemption-1", Status: "running"}}, readAll(t, s, KindPreemption))
+}
+
+func TestBoltStoreCorruptRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.db")
+	s, err := NewBoltStore(path)
+	require.NoError(t, err)
+	defer s.Close()
+
+	require.NoError(t, s.Save(KindCache, "cache-1", &testRecord{ID: "cache-1", Status: "active"}))
+	require.NoError(t, s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(KindCache)).Put([]byte("cache-0"), []byte("{not json"))
+	}))
+
+	// ForEach hands out the raw bytes, so the controllers decide to skip the corrupt record
+	// while the others are still visited
+	raw := map[string]string{}
+	require.NoError(t, s.ForEach(KindCache, func(id string, data []byte) error {
+		raw[id] = string(data)
+		return nil
+	}))
+	assert.Equal(t, "{not json", raw["cache-0"])
+	assert.JSONEq(t, `{"id":"cache-1","status":"active"}`, raw["cache-1"])
+
+	// Records that cannot be encoded are rejected without touching the stored one
+	assert.Error(t, s.Save(KindCache, "cache-1", map[string]interface{}{"bad": func() {}}))
+	var rec testRecord
+	require.NoError(t, s.ForEach(KindCache, func(id string, data []byte) error {
+		if id == "cache-1" {
+			return json.Unmarshal(data, &rec)
+		}
+		return nil
+	}))
+	assert.Equal(t, "active", rec.Status)
+}
@@ -0,0 +1,46 @@
+package store
+
+// Job kinds used as bucket names by the controllers
+const (
+	KindMigration     = "migrations"
+	KindAutoscaling   = "autoscalers"
+	KindLoadbalancing = "loadbalancing"
+	KindProvisioning  = "provisionings"
+	KindPreemption    = "preemptions"
+	KindCache         = "caches"
+	KindSignature     = "signatures"
+	KindWebhook       = "webhooks"
+	KindDeadLetter    = "webhook_deadletters"
+)
+
+// JobStore persists controller jobs so that they survive orchestrator restarts.
+// Records are stored as JSON documents grouped by kind and keyed by job ID.
+type JobStore interface {
+	// Save creates or replaces the record with the given kind and ID
+	Save(kind, id string, record interface{}) error
+
+	// Delete removes the record with the given kind and ID (no error if absent)
+	Delete(kind, id string) error
+
+	// ForEach calls fn with the raw JSON of every record of the given kind
+	ForEach(kind string, fn func(id string, data []byte) error) error
+
+	// Close releases resources held by the store
+	Close() error
+}
+
+// nopStore is used when persistence is disabled
+type nopStore struct{}
+
+// NewNopStore returns a JobStore that discards all writes
+func NewNopStore() JobStore {
+	return nopStore{}
+}
+
+func (nopStore) Save(kind, id string, record interface{}) error { return nil }
+
+func (nopStore) Delete(kind, id string) error { return nil }
+
+func (nopStore) ForEach(kind string, fn func(id string, data []byte) error) error { return nil }
+
+func (nopStore) Close() error { return nil }
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// AuditOutcome is the result of an audited action
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+	// AuditOutcomeDenied is recorded when authentication or authorization rejected the request
+	AuditOutcomeDenied AuditOutcome = "denied"
+)
+
+// AuditTarget is an object affected by an action
+type AuditTarget struct {
+	Kind      string `json:"kind"` // Pod, PersistentVolumeClaim, Deployment, StatefulSet, Node, ...
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// String returns kind/namespace/name
+func (t AuditTarget) String() string {
+	if t.Namespace == "" {
+		return t.Kind + "/" + t.Name
+	}
+	return t.Kind + "/" + t.Namespace + "/" + t.Name
+}
+
+// AuditEntry is one audit record
+type AuditEntry struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+
+	// Who
+	Actor    string   `json:"actor"`
+	Groups   []string `json:"groups,omitempty"`
+	Provider string   `json:"auth_provider,omitempty"`
+
+	// What
+	Action     string          `json:"action"` // e.g. migration.create, preemption.evict
+	Method     string          `json:"method,omitempty"`
+	Endpoint   string          `json:"endpoint,omitempty"`
+	Namespace  string          `json:"namespace,omitempty"`
+	JobID      string          `json:"job_id,omitempty"`
+	Reason     string          `json:"reason,omitempty"`
+	Request    json.RawMessage `json:"request,omitempty"`
+	Targets    []AuditTarget   `json:"targets,omitempty"`
+	SourceAddr string          `json:"source_addr,omitempty"`
+
+	// Outcome
+	Outcome    AuditOutcome `json:"outcome"`
+	StatusCode int          `json:"status_code,omitempty"`
+	Message    string       `json:"message,omitempty"`
+}
+
+// Filter selects entries in Query; zero fields match everything
+type AuditFilter struct {
+	Namespace string
+	Action    string // exact action, or a prefix ending in "." such as "preemption."
+	Actor     string
+	JobID     string
+	Since     time.Time
+	Until     time.Time
+	Limit     int // newest entries first; 0 means DefaultAuditQueryLimit
+}
+
+// DefaultAuditQueryLimit is the number of entries returned when AuditFilter.Limit is not set
+const DefaultAuditQueryLimit = 100
+
+// Matches reports whether the entry passes the filter
+func (f AuditFilter) Matches(e *AuditEntry) bool {
+	if f.Namespace != "" && e.Namespace != f.Namespace && !targetsNamespace(e.Targets, f.Namespace) {
+		return false
+	}
+	if f.Action != "" && e.Action != f.Action && !(strings.HasSuffix(f.Action, ".") && strings.HasPrefix(e.Action, f.Action)) {
+		return false
+	}
+	if f.Actor != "" && e.Actor != f.Actor {
+		return false
+	}
+	if f.JobID != "" && e.JobID != f.JobID {
+		return false
+	}
+	if !f.Since.IsZero() && e.Timestamp.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && e.Timestamp.After(f.Until) {
+		return false
+	}
+	return true
+}
+
+func targetsNamespace(targets []AuditTarget, namespace string) bool {
+	for _, t := range targets {
+		if t.Namespace == namespace {
+			return true
+		}
+	}
+	return false
+}
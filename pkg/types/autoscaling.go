@@ -24,6 +24,86 @@ type AutoscalingRequest struct {
 	// Advanced settings
 	ScaleUpPolicy   *ScalingPolicy `json:"scale_up_policy,omitempty"`
 	ScaleDownPolicy *ScalingPolicy `json:"scale_down_policy,omitempty"`
+
+	// Predictive scaling: scale ahead of forecast GPU and storage read demand
+	Predictive *PredictiveScaling `json:"predictive,omitempty"`
+
+	// Scale to zero: release the GPUs of idle inference servers until they are woken
+	ScaleToZero *ScaleToZero `json:"scale_to_zero,omitempty"`
+
+	// Custom metrics from Prometheus, e.g. training queue length or tokens per second
+	CustomMetrics []CustomMetricTarget `json:"custom_metrics,omitempty"`
+
+	// Scheduled scaling windows overriding min/max replicas, e.g. business hours for inference
+	Schedules []ScalingSchedule `json:"schedules,omitempty"`
+}
+
+// ScalingSchedule overrides min_replicas and max_replicas while its window is open. The window opens
+// when the start cron expression fires and closes when the end expression fires; when several windows
+// are open the first one in the list applies.
+type ScalingSchedule struct {
+	Name        string `json:"name"`
+	Start       string `json:"start"`              // Cron expression, e.g. "0 9 * * 1-5"
+	End         string `json:"end"`                // Cron expression, e.g. "0 18 * * 1-5"
+	Timezone    string `json:"timezone,omitempty"` // IANA timezone of the cron expressions (default UTC)
+	MinReplicas int32  `json:"min_replicas"`
+	MaxReplicas int32  `json:"max_replicas"`
+}
+
+// SchedulePreview shows the effective replica bounds of an autoscaler over the coming hours
+type SchedulePreview struct {
+	AutoscalingID string                  `json:"autoscaling_id"`
+	From          time.Time               `json:"from"`
+	To            time.Time               `json:"to"`
+	Periods       []SchedulePreviewPeriod `json:"periods"`
+}
+
+// SchedulePreviewPeriod is a period with constant replica bounds
+type SchedulePreviewPeriod struct {
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	Schedule    string    `json:"schedule,omitempty"` // Open schedule, empty for the default bounds
+	MinReplicas int32     `json:"min_replicas"`
+	MaxReplicas int32     `json:"max_replicas"`
+}
+
+// Custom metric target types, as in the Kubernetes HPA external metrics
+const (
+	// MetricTargetAverageValue: the query returns a total for the workload, the target is per replica
+	MetricTargetAverageValue = "AverageValue"
+	// MetricTargetValue: the query returns a value for the whole workload, scaled proportionally
+	MetricTargetValue = "Value"
+)
+
+// CustomMetricTarget scales a workload on the result of a PromQL instant query.
+// ${namespace} and ${workload} in the query are replaced by the workload namespace and name.
+// A query can read any namespace, so creating an autoscaler with custom metrics requires the admin role.
+type CustomMetricTarget struct {
+	Name        string  `json:"name"`         // Identifies the metric in the details, e.g. queue_length
+	Query       string  `json:"query"`        // PromQL returning a single sample
+	TargetType  string  `json:"target_type"`  // AverageValue or Value
+	TargetValue float64 `json:"target_value"` // Per replica for AverageValue
+}
+
+// ScaleToZero scales an idle workload to zero replicas; POST /autoscaling/:id/wake scales it back to min_replicas
+type ScaleToZero struct {
+	IdleTimeoutSeconds   int32 `json:"idle_timeout_seconds"`             // No traffic/IO for this long scales to zero
+	ActivationQueueDepth int64 `json:"activation_queue_depth,omitempty"` // Queue depth that wakes the workload (default 1)
+}
+
+// WakeRequest activates a workload scaled to zero. Without queue_depth the workload is woken unconditionally;
+// with it, only when the depth reaches the activation_queue_depth of the autoscaler.
+// A wake for a running workload counts as traffic and restarts its idle timeout.
+type WakeRequest struct {
+	QueueDepth *int64 `json:"queue_depth,omitempty"` // Pending requests reported by the queue
+	Reason     string `json:"reason,omitempty"`
+}
+
+// PredictiveScaling selects a forecasting mode for periodic demand such as data loading at epoch boundaries
+type PredictiveScaling struct {
+	Mode                string `json:"mode"`                      // holt_winters, seasonal_moving_average
+	SeasonLengthSeconds int32  `json:"season_length_seconds"`     // Period of the demand pattern, e.g. one training epoch
+	HorizonSeconds      int32  `json:"horizon_seconds,omitempty"` // How far ahead to scale (default 60)
 }
 
 // ScalingPolicy defines the policy for scaling operations
@@ -31,6 +111,28 @@ type ScalingPolicy struct {
 	StabilizationWindowSeconds int32 `json:"stabilization_window_seconds,omitempty"` // Time to wait before scaling
 	SelectPolicy               string `json:"select_policy,omitempty"`                // Max, Min, Disabled
 	MaxScaleChange             int32  `json:"max_scale_change,omitempty"`             // Maximum number of replicas to change at once
+
+	// Rate limits per period, as in the Kubernetes HPA v2 behavior; select_policy picks among them
+	Policies []ScalingPolicyRule `json:"policies,omitempty"`
+	// Relative change of the desired replicas that is ignored, e.g. 0.1 for 10%
+	Tolerance float64 `json:"tolerance,omitempty"`
+}
+
+// Scaling policy rule types and select policies
+const (
+	ScalingPolicyPods    = "Pods"    // Value is a number of replicas
+	ScalingPolicyPercent = "Percent" // Value is a percentage of the replicas at the start of the period
+
+	SelectPolicyMax      = "Max"      // Apply the rule allowing the largest change (default)
+	SelectPolicyMin      = "Min"      // Apply the rule allowing the smallest change
+	SelectPolicyDisabled = "Disabled" // Never scale in this direction
+)
+
+// ScalingPolicyRule limits the replicas added or removed within a period
+type ScalingPolicyRule struct {
+	Type          string `json:"type"`           // Pods or Percent
+	Value         int32  `json:"value"`          // Replicas or percent per period
+	PeriodSeconds int32  `json:"period_seconds"` // Length of the period (1-1800)
 }
 
 // AutoscalingResponse represents the response for an autoscaling request
@@ -67,6 +169,10 @@ type AutoscalingDetails struct {
 	CurrentStorageWriteThroughput int64 `json:"current_storage_write_throughput_mbps,omitempty"`
 	CurrentStorageIOPS            int64 `json:"current_storage_iops,omitempty"`
 
+	// Provenance of the current metrics; scaling is skipped unless they are real
+	MetricsProvenance MetricProvenance `json:"metrics_provenance,omitempty"`
+	Conditions        []Condition      `json:"conditions,omitempty"`
+
 	// Scaling events
 	LastScaleTime    *time.Time         `json:"last_scale_time,omitempty"`
 	ScaleUpCount     int64              `json:"scale_up_count"`
@@ -74,13 +180,79 @@ type AutoscalingDetails struct {
 
 	// HPA name (if using Kubernetes HPA)
 	HPAName          string             `json:"hpa_name,omitempty"`
+
+	// Forecast vs actual demand (predictive scaling only)
+	Forecast *AutoscalingForecast `json:"forecast,omitempty"`
+
+	// Idle and wake state (scale to zero only)
+	ScaleToZero *ScaleToZeroStatus `json:"scale_to_zero,omitempty"`
+
+	// Current values of the custom metrics
+	CustomMetrics []CustomMetricStatus `json:"custom_metrics,omitempty"`
+
+	// Replica bounds in effect and the schedule that sets them (schedules only)
+	ActiveSchedule       string `json:"active_schedule,omitempty"`
+	EffectiveMinReplicas int32  `json:"effective_min_replicas,omitempty"`
+	EffectiveMaxReplicas int32  `json:"effective_max_replicas,omitempty"`
+}
+
+// CustomMetricStatus is the latest evaluation of a custom metric
+type CustomMetricStatus struct {
+	Name            string           `json:"name"`
+	TargetType      string           `json:"target_type"`
+	TargetValue     float64          `json:"target_value"`
+	CurrentValue    float64          `json:"current_value"`
+	DesiredReplicas int32            `json:"desired_replicas"` // Recommendation of this metric
+	Provenance      MetricProvenance `json:"provenance,omitempty"`
+	Error           string           `json:"error,omitempty"` // Why the metric has no usable value; scale-down is held
+}
+
+// ScaleToZeroStatus reports the idle and wake state of an autoscaler with scale to zero
+type ScaleToZeroStatus struct {
+	ScaledToZero     bool       `json:"scaled_to_zero"`
+	Waking           bool       `json:"waking"`                      // Scaled back up, waiting for the first ready replica
+	IdleSince        *time.Time `json:"idle_since,omitempty"`        // Start of the current idle period
+	ScaledToZeroAt   *time.Time `json:"scaled_to_zero_at,omitempty"` // Last scale to zero
+	ScaleToZeroCount int64      `json:"scale_to_zero_count"`
+
+	// Last activation
+	WakeCount      int64      `json:"wake_count"`
+	LastWakeTime   *time.Time `json:"last_wake_time,omitempty"`
+	LastWakeReason string     `json:"last_wake_reason,omitempty"`
+
+	// Time from the wake to the first ready replica
+	LastColdStartSeconds float64 `json:"last_cold_start_seconds,omitempty"`
+}
+
+// AutoscalingForecast compares the forecast of a predictive autoscaler with the measured metrics.
+// Values are per replica at the current replica count, like the current_* metrics.
+type AutoscalingForecast struct {
+	Mode           string `json:"mode"`
+	HorizonSeconds int32  `json:"horizon_seconds"`
+	Samples        int    `json:"samples"` // Samples in the metric history
+	Ready          bool   `json:"ready"`   // Enough history to forecast; scaling is reactive until then
+
+	// Forecast for one horizon ahead
+	PredictedGPU                   int32 `json:"predicted_gpu_percent"`
+	PredictedStorageReadThroughput int64 `json:"predicted_storage_read_throughput_mbps"`
+
+	// Forecast made one horizon ago for the latest sample, to compare with the current metrics
+	ExpectedGPU                   *int32 `json:"expected_gpu_percent,omitempty"`
+	ExpectedStorageReadThroughput *int64 `json:"expected_storage_read_throughput_mbps,omitempty"`
+
+	// Exponentially weighted absolute percentage error of past forecasts
+	GPUErrorPercent         float64 `json:"gpu_error_percent"`
+	StorageReadErrorPercent float64 `json:"storage_read_error_percent"`
 }
 
 // AutoscalingMetrics represents metrics for autoscaling operations
 type AutoscalingMetrics struct {
-	TotalAutoscalers      int64   `json:"total_autoscalers"`
-	ActiveAutoscalers     int64   `json:"active_autoscalers"`
-	TotalScaleUps         int64   `json:"total_scale_ups"`
-	TotalScaleDowns       int64   `json:"total_scale_downs"`
-	AverageCPUUtilization float64 `json:"average_cpu_utilization"`
+	TotalAutoscalers        int64   `json:"total_autoscalers"`
+	ActiveAutoscalers       int64   `json:"active_autoscalers"`
+	TotalScaleUps           int64   `json:"total_scale_ups"`
+	TotalScaleDowns         int64   `json:"total_scale_downs"`
+	TotalScaleToZero        int64   `json:"total_scale_to_zero"`
+	TotalWakes              int64   `json:"total_wakes"`
+	AverageColdStartSeconds float64 `json:"average_cold_start_seconds"`
+	AverageCPUUtilization   float64 `json:"average_cpu_utilization"`
 }
@@ -160,6 +160,10 @@ type CacheStats struct {
 	// Time statistics
 	LastAccessTime    *time.Time `json:"last_access_time,omitempty"`
 	LastEvictionTime  *time.Time `json:"last_eviction_time,omitempty"`
+
+	// UnavailableReason is set while the metrics provider has no data for the cache;
+	// the statistics above then keep their last collected values
+	UnavailableReason string `json:"unavailable_reason,omitempty"`
 }
 
 // CachingMetrics contains overall caching system metrics
@@ -28,11 +28,17 @@ type LoadbalancingRequest struct {
 	// MaxMigrationsPerCycle limits how many pods can be migrated in one cycle
 	MaxMigrationsPerCycle int32 `json:"max_migrations_per_cycle,omitempty"` // default: 5
 
+	// MaxConcurrentMigrations limits how many migrations of a cycle run at the same time
+	MaxConcurrentMigrations int32 `json:"max_concurrent_migrations,omitempty"` // default: 2
+
 	// Interval for periodic loadbalancing (in seconds, 0 means one-time)
 	Interval int32 `json:"interval,omitempty"`
 
 	// PreservePV indicates whether to preserve PersistentVolumes during migration
 	PreservePV bool `json:"preserve_pv,omitempty"`
+
+	// DryRun computes the migration plan and predicted balance without migrating any pods
+	DryRun bool `json:"dry_run,omitempty"`
 }
 
 // LoadbalancingResponse represents the response after initiating loadbalancing
@@ -79,16 +85,38 @@ type LoadbalancingDetails struct {
 	// Resource metrics improvement
 	ResourceImprovement *ResourceImprovement   `json:"resource_improvement,omitempty"`
 
+	// Predicted cluster state after the planned migrations
+	PredictedState *ClusterState `json:"predicted_state,omitempty"`
+
+	// Conditions such as MetricsUnavailable
+	Conditions []Condition `json:"conditions,omitempty"`
+
 	// Error message if failed
 	ErrorMessage    string                     `json:"error_message,omitempty"`
 }
 
+// LoadbalancingPlan is the result of a plan-only loadbalancing run: what would be migrated
+// and how balanced the cluster is expected to be afterwards. No pods are touched.
+type LoadbalancingPlan struct {
+	Strategy              string               `json:"strategy"`
+	ClusterState          ClusterState         `json:"cluster_state"`
+	PlannedMigrations     []MigrationPlan      `json:"planned_migrations"`
+	PredictedState        ClusterState         `json:"predicted_state"`
+	PredictedBalanceScore float64              `json:"predicted_balance_score"`
+	PredictedImprovement  *ResourceImprovement `json:"predicted_improvement,omitempty"`
+	Conditions            []Condition          `json:"conditions,omitempty"`
+}
+
 // ClusterState represents the resource utilization state of the cluster
 type ClusterState struct {
 	Timestamp     time.Time           `json:"timestamp"`
 	Nodes         []NodeState         `json:"nodes"`
 	TotalPods     int32               `json:"total_pods"`
 	BalanceScore  float64             `json:"balance_score"` // 0-100, higher is more balanced
+
+	// MetricsProvenance is the least trustworthy provenance over all nodes;
+	// no migrations are planned unless it is real
+	MetricsProvenance MetricProvenance `json:"metrics_provenance,omitempty"`
 }
 
 // NodeState represents the resource state of a single node
@@ -108,6 +136,17 @@ type NodeState struct {
 	StorageWriteMBps int64 `json:"storage_write_mbps"` // Current write throughput in MB/s
 	StorageIOPS      int64 `json:"storage_iops"`       // Current I/O operations per second
 	StorageUtilization int32 `json:"storage_utilization"` // Storage utilization percentage (0-100)
+
+	// MissingMetrics lists the metric groups the provider had no data for (e.g. "gpu", "storage");
+	// their values above are zero rather than estimates
+	MissingMetrics []string `json:"missing_metrics,omitempty"`
+
+	// MetricsProvenance is the least trustworthy provenance of the node's metrics
+	MetricsProvenance MetricProvenance `json:"metrics_provenance,omitempty"`
+
+	// StorageMetricsProvenance is the provenance of the storage I/O values; it is unavailable when
+	// they could not be read, which storage-based strategies treat like unreal node metrics
+	StorageMetricsProvenance MetricProvenance `json:"storage_metrics_provenance,omitempty"`
 }
 
 // MigrationPlan represents a planned pod migration
@@ -128,7 +167,7 @@ type MigrationResult struct {
 	PodNamespace      string    `json:"pod_namespace"`
 	SourceNode        string    `json:"source_node"`
 	TargetNode        string    `json:"target_node"`
-	Status            string    `json:"status"` // success, failed
+	Status            string    `json:"status"` // success, failed, cancelled, timeout
 	StartTime         time.Time `json:"start_time"`
 	EndTime           time.Time `json:"end_time"`
 	Duration          float64   `json:"duration_seconds"`
@@ -152,6 +191,12 @@ type ResourceImprovement struct {
 	StorageWriteVarianceAfter  float64 `json:"storage_write_variance_after"`
 	StorageIOPSVarianceBefore  float64 `json:"storage_iops_variance_before"`
 	StorageIOPSVarianceAfter   float64 `json:"storage_iops_variance_after"`
+
+	// Values predicted by the cluster model before migrating, to compare with the measured ones
+	PredictedBalanceScoreImprovement float64 `json:"predicted_balance_score_improvement"`
+	PredictedCPUVarianceAfter        float64 `json:"predicted_cpu_variance_after"`
+	PredictedMemoryVarianceAfter     float64 `json:"predicted_memory_variance_after"`
+	PredictedGPUVarianceAfter        float64 `json:"predicted_gpu_variance_after"`
 }
 
 // LoadbalancingMetrics contains overall loadbalancing metrics
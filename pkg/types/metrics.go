@@ -0,0 +1,149 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// MetricProvenance tells where a metric value came from
+type MetricProvenance string
+
+const (
+	// ProvenanceReal is a value measured by the metrics provider within MetricsMaxAge
+	ProvenanceReal MetricProvenance = "real"
+	// ProvenanceStale is a measured value older than MetricsMaxAge
+	ProvenanceStale MetricProvenance = "stale"
+	// ProvenanceUnavailable is a value that could not be read at all; it is reported as zero
+	ProvenanceUnavailable MetricProvenance = "unavailable"
+	// ProvenanceSimulated is a generated value that was not measured at all
+	ProvenanceSimulated MetricProvenance = "simulated"
+)
+
+// MetricsMaxAge is how old a sample may be before it is considered stale
+const MetricsMaxAge = 2 * time.Minute
+
+// ConditionMetricsUnavailable is reported while a controller skips its scaling, migration or
+// preemption decisions because its input metrics are not real
+const ConditionMetricsUnavailable = "MetricsUnavailable"
+
+// ProvenanceAt returns real for a sample taken within MetricsMaxAge and stale otherwise
+func ProvenanceAt(timestamp time.Time) MetricProvenance {
+	if timestamp.IsZero() || time.Since(timestamp) > MetricsMaxAge {
+		return ProvenanceStale
+	}
+	return ProvenanceReal
+}
+
+// IsReal reports whether decisions may be based on the value
+func (p MetricProvenance) IsReal() bool {
+	return p == ProvenanceReal
+}
+
+// rank orders provenances from most to least trustworthy; unknown values rank as simulated
+func (p MetricProvenance) rank() int {
+	switch p {
+	case ProvenanceReal:
+		return 0
+	case ProvenanceStale:
+		return 1
+	case ProvenanceUnavailable:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// WorstProvenance returns the least trustworthy of the given provenances (real if none are given)
+func WorstProvenance(provenances ...MetricProvenance) MetricProvenance {
+	worst := ProvenanceReal
+	for _, p := range provenances {
+		if p.rank() > worst.rank() {
+			worst = p
+		}
+		if worst.rank() == 3 {
+			return ProvenanceSimulated
+		}
+	}
+	return worst
+}
+
+// WorkloadMetricsProvenance is the provenance of each signal of a workload's pod metrics,
+// so a decision only depends on the signals it actually uses
+type WorkloadMetricsProvenance struct {
+	Resource MetricProvenance // CPU and memory usage
+	GPU      MetricProvenance
+	Storage  MetricProvenance // storage read/write throughput and IOPS
+}
+
+// Of returns the least trustworthy provenance of the selected signals (real if none are selected)
+func (p WorkloadMetricsProvenance) Of(resource, gpu, storage bool) MetricProvenance {
+	worst := ProvenanceReal
+	if resource {
+		worst = WorstProvenance(worst, p.Resource)
+	}
+	if gpu {
+		worst = WorstProvenance(worst, p.GPU)
+	}
+	if storage {
+		worst = WorstProvenance(worst, p.Storage)
+	}
+	return worst
+}
+
+// String describes the provenance of each signal, e.g. "resource=real gpu=real storage=unavailable"
+func (p WorkloadMetricsProvenance) String() string {
+	return fmt.Sprintf("resource=%s gpu=%s storage=%s", p.Resource, p.GPU, p.Storage)
+}
+
+// Condition is a status condition of a REST job, shaped like the CRD conditions
+type Condition struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"` // "True" or "False"
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"last_transition_time"`
+}
+
+// SetCondition adds or updates the condition of the same type.
+// LastTransitionTime only changes when the status changes.
+func SetCondition(conditions []Condition, condition Condition) []Condition {
+	if condition.LastTransitionTime.IsZero() {
+		condition.LastTransitionTime = time.Now()
+	}
+	for i := range conditions {
+		if conditions[i].Type != condition.Type {
+			continue
+		}
+		if conditions[i].Status == condition.Status {
+			condition.LastTransitionTime = conditions[i].LastTransitionTime
+		}
+		conditions[i] = condition
+		return conditions
+	}
+	return append(conditions, condition)
+}
+
+// MetricsCondition builds the MetricsUnavailable condition for the given input provenance
+func MetricsCondition(provenance MetricProvenance, message string) Condition {
+	if provenance.IsReal() {
+		return Condition{
+			Type:    ConditionMetricsUnavailable,
+			Status:  "False",
+			Reason:  "MetricsReal",
+			Message: message,
+		}
+	}
+	reason := "SimulatedMetrics"
+	switch provenance {
+	case ProvenanceStale:
+		reason = "StaleMetrics"
+	case ProvenanceUnavailable:
+		reason = "UnavailableMetrics"
+	}
+	return Condition{
+		Type:    ConditionMetricsUnavailable,
+		Status:  "True",
+		Reason:  reason,
+		Message: message,
+	}
+}
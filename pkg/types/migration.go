@@ -60,9 +60,24 @@ type MigrationDetails struct {
 	// PV checkpoint information
 	CheckpointPath  string             `json:"checkpoint_path,omitempty"`
 	PVClaimName     string             `json:"pv_claim_name,omitempty"`
+
+	// Container checkpoint/restore (CRIU) information
+	CheckpointMode   string            `json:"checkpoint_mode,omitempty"`   // "criu" or "restart"
+	CheckpointImages map[string]string `json:"checkpoint_images,omitempty"` // container -> checkpoint image restored on the target node
+	Conditions       []Condition       `json:"conditions,omitempty"`        // e.g. Checkpointed, explaining a restart-based fallback
 	
+	// Owning workload the pod was migrated through (empty for unowned pods)
+	WorkloadKind    string             `json:"workload_kind,omitempty"`
+	WorkloadName    string             `json:"workload_name,omitempty"`
+
 	// New pod information after migration
 	NewPodName      string             `json:"new_pod_name,omitempty"`
+
+	// Error message if failed
+	ErrorMessage    string             `json:"error_message,omitempty"`
+
+	// Whether a failed or cancelled migration was rolled back cleanly
+	RolledBack      bool               `json:"rolled_back,omitempty"`
 }
 
 // ResourceUsage represents CPU and memory usage
@@ -89,3 +104,25 @@ type MigrationMetrics struct {
 	CPUSavings         float64       `json:"cpu_savings_percentage"`
 	MemorySavings      float64       `json:"memory_savings_percentage"`
 }
+
+// Checkpoint modes recorded in MigrationDetails.CheckpointMode
+const (
+	CheckpointModeCRIU    = "criu"
+	CheckpointModeRestart = "restart"
+)
+
+// ConditionCheckpointed reports whether the migrated containers were checkpointed; when it is
+// False the reason says why the migration fell back to restarting them on the target node
+const ConditionCheckpointed = "Checkpointed"
+
+// CheckpointTransfer describes the helper pod that turns checkpoint archives on the source node into images
+type CheckpointTransfer struct {
+	Namespace      string // orchestrator namespace the helper pods run in
+	PodName        string
+	NodeName       string
+	Image          string            // buildah image
+	CleanupImage   string            // busybox-like image that deletes the archives from the node
+	Archives       map[string]string // container -> archive path reported by the kubelet
+	Images         map[string]string // container -> checkpoint image to build and push
+	RegistrySecret string            // optional docker config secret used to push images
+}
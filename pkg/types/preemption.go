@@ -87,6 +87,9 @@ type PreemptionDetails struct {
 	// Whether target was achieved
 	TargetAchieved bool `json:"target_achieved"`
 
+	// Conditions such as MetricsUnavailable
+	Conditions []Condition `json:"conditions,omitempty"`
+
 	// Error message if failed
 	ErrorMessage string `json:"error_message,omitempty"`
 }
@@ -104,6 +107,9 @@ type NodeResourceState struct {
 	MemoryPercent    int32  `json:"memory_percent"`
 	GPUPercent       int32  `json:"gpu_percent"`
 	PodCount         int32  `json:"pod_count"`
+
+	// MetricsProvenance of the CPU/memory utilization above
+	MetricsProvenance MetricProvenance `json:"metrics_provenance,omitempty"`
 }
 
 // PreemptionCandidate represents a pod candidate for preemption
@@ -118,6 +124,9 @@ type PreemptionCandidate struct {
 	PreemptionScore  float64        `json:"preemption_score"` // Lower score = preempt first
 	PreemptionReason string         `json:"preemption_reason"`
 	Selected         bool           `json:"selected"` // Whether this pod is selected for preemption
+
+	// StorageMetricsProvenance is unavailable when no storage I/O data was available for the pod
+	StorageMetricsProvenance MetricProvenance `json:"storage_metrics_provenance,omitempty"`
 }
 
 // PreemptedPodInfo contains information about a preempted pod
@@ -199,4 +208,7 @@ type PodResourceInfo struct {
 	StorageIOPS      int64 // Current I/O operations per second
 	PVCCount         int32 // Number of PVCs attached
 	TotalPVCSize     int64 // Total PVC size in bytes
+
+	// StorageMetricsProvenance is unavailable when no storage I/O data was available for the pod
+	StorageMetricsProvenance MetricProvenance
 }
@@ -57,6 +57,7 @@ type ProvisioningDetails struct {
 	PVName          string `json:"pv_name,omitempty"`
 	ActualSize      string `json:"actual_size"`
 	ActualClass     string `json:"actual_class"`
+	StorageClassName string `json:"storage_class_name,omitempty"` // Kubernetes StorageClass backing ActualClass
 
 	// Performance profile
 	EstimatedReadThroughput  int64 `json:"estimated_read_throughput_mbps,omitempty"`
@@ -66,6 +67,29 @@ type ProvisioningDetails struct {
 	// Workload binding
 	BoundToWorkload bool   `json:"bound_to_workload"`
 	MountedPods     []string `json:"mounted_pods,omitempty"`
+
+	// Error information
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// PVCSpec describes a PersistentVolumeClaim to create for a provisioning job
+type PVCSpec struct {
+	Namespace    string
+	Name         string
+	Size         string
+	StorageClass string // Kubernetes StorageClass name (empty uses the cluster default)
+	AccessMode   string
+	VolumeMode   string
+	Labels       map[string]string
+	Annotations  map[string]string
+}
+
+// PVCStatus represents the binding state of a PersistentVolumeClaim
+type PVCStatus struct {
+	Phase                string // Pending, Bound, Lost
+	VolumeName           string
+	Capacity             string
+	WaitForFirstConsumer bool // StorageClass delays binding until a pod uses the claim
 }
 
 // ProvisioningMetrics represents metrics for provisioning operations
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// WebhookFormat selects the payload layout sent to a webhook target
+type WebhookFormat string
+
+const (
+	// WebhookFormatGeneric posts the job event as JSON
+	WebhookFormatGeneric WebhookFormat = "generic"
+	// WebhookFormatSlack posts a Slack incoming webhook message
+	WebhookFormatSlack WebhookFormat = "slack"
+	// WebhookFormatTeams posts a Microsoft Teams MessageCard
+	WebhookFormatTeams WebhookFormat = "teams"
+)
+
+// WebhookFilter selects the job events delivered to a webhook; empty fields match every event
+type WebhookFilter struct {
+	Kinds      []string `json:"kinds,omitempty"`      // migration, autoscaling, loadbalancing, provisioning, preemption
+	Types      []string `json:"types,omitempty"`      // status, step, cycle, outcome
+	Statuses   []string `json:"statuses,omitempty"`   // e.g. failed, cancelled
+	Reasons    []string `json:"reasons,omitempty"`    // e.g. Preempted, MaxReplicasReached
+	Namespaces []string `json:"namespaces,omitempty"` // events of cluster-wide jobs only match an empty list
+}
+
+// WebhookRequest registers or replaces a webhook target
+type WebhookRequest struct {
+	Name   string        `json:"name" binding:"required"`
+	URL    string        `json:"url" binding:"required"`
+	Format WebhookFormat `json:"format,omitempty"` // default generic
+
+	// Secret signs every delivery with HMAC-SHA256 (X-Orchestrator-Signature header)
+	Secret string `json:"secret,omitempty"`
+
+	Filter     WebhookFilter `json:"filter,omitempty"`
+	MaxRetries *int          `json:"max_retries,omitempty"` // retries after the first attempt, default 5
+	Disabled   bool          `json:"disabled,omitempty"`
+}
+
+// WebhookResponse describes a registered webhook target; the secret is never returned
+type WebhookResponse struct {
+	WebhookID  string        `json:"webhook_id"`
+	Name       string        `json:"name"`
+	URL        string        `json:"url"`
+	Format     WebhookFormat `json:"format"`
+	HasSecret  bool          `json:"has_secret"`
+	Filter     WebhookFilter `json:"filter"`
+	MaxRetries int           `json:"max_retries"`
+	Disabled   bool          `json:"disabled"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  *time.Time    `json:"updated_at,omitempty"`
+	Stats      WebhookStats  `json:"stats"`
+}
+
+// WebhookStats counts the deliveries of a webhook target
+type WebhookStats struct {
+	Delivered      int64      `json:"delivered"`
+	DeadLettered   int64      `json:"dead_lettered"`
+	LastDeliveryAt *time.Time `json:"last_delivery_at,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+}
+
+// WebhookDeadLetter records a delivery that failed after all retries
+type WebhookDeadLetter struct {
+	ID             string          `json:"id"`
+	WebhookID      string          `json:"webhook_id"`
+	EventID        uint64          `json:"event_id"`
+	Kind           string          `json:"kind"`
+	JobID          string          `json:"job_id"`
+	Payload        json.RawMessage `json:"payload"`
+	Attempts       int             `json:"attempts"`
+	LastStatusCode int             `json:"last_status_code,omitempty"`
+	LastError      string          `json:"last_error"`
+	FailedAt       time.Time       `json:"failed_at"`
+}